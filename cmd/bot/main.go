@@ -2,19 +2,24 @@ package main
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/kyi000/upbit-auto-trading-bot/internal/api"
 	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
 	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/metrics"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
 	"github.com/kyi000/upbit-auto-trading-bot/internal/risk"
 	"github.com/kyi000/upbit-auto-trading-bot/internal/storage"
 	"github.com/kyi000/upbit-auto-trading-bot/internal/strategy"
 	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 )
 
 func main() {
@@ -27,6 +32,39 @@ func main() {
 	if err != nil {
 		logger.Fatal("설정 로드 실패:", err)
 	}
+	if err := cfg.Validate(); err != nil {
+		logger.Fatal("설정 검증 실패:", err)
+	}
+	if err := utils.Configure(utils.LogOptions{
+		Format:     cfg.Logging.Format,
+		Level:      cfg.Logging.Level,
+		File:       cfg.Logging.File,
+		MaxSize:    cfg.Logging.MaxSize,
+		MaxBackups: cfg.Logging.MaxBackups,
+		MaxAge:     cfg.Logging.MaxAge,
+		Compress:   cfg.Logging.Compress,
+	}); err != nil {
+		logger.Fatal("로거 설정 실패:", err)
+	}
+
+	// 알림 설정 (켜 둔 모든 채널로 전송, 치명적 오류는 묶지 않고 바로 전송)
+	var channel notify.Multi
+	if cfg.Notify.Telegram.Enabled {
+		channel = append(channel, notify.NewTelegram(cfg.Notify.Telegram.Token, cfg.Notify.Telegram.ChatID))
+	}
+	if cfg.Notify.Discord.Enabled {
+		channel = append(channel, notify.NewDiscord(cfg.Notify.Discord.WebhookURL))
+	}
+	notifier := notify.NewBatcher(channel, time.Duration(cfg.Notify.BatchSeconds)*time.Second)
+	notifier.Start(context.Background())
+	fatal := func(args ...interface{}) {
+		sendCtx, sendCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := channel.Send(sendCtx, notify.LevelError, strings.TrimSuffix(fmt.Sprintln(args...), "\n")); err != nil {
+			logger.Error("알림 전송 실패:", err)
+		}
+		sendCancel()
+		logger.Fatal(args...)
+	}
 
 	// 컨텍스트 생성 (종료 시그널 처리용)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -35,42 +73,138 @@ func main() {
 	// 데이터베이스 연결
 	db, err := storage.NewDatabase(cfg.Database)
 	if err != nil {
-		logger.Fatal("데이터베이스 연결 실패:", err)
+		fatal("데이터베이스 연결 실패:", err)
 	}
 	defer db.Close()
 
+	// 테이블 마이그레이션
+	if err := db.Migrate(); err != nil {
+		fatal("데이터베이스 마이그레이션 실패:", err)
+	}
+
+	// 운영 지표 설정 (꺼져 있으면 nil이며 지표를 기록하지 않음)
+	var botMetrics *metrics.Metrics
+	serverOpts := []api.ServerOption{api.WithFeeRate(cfg.Trading.FeeRate), api.WithAuth(cfg.Server.Auth)}
+	if cfg.Server.Metrics {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+		botMetrics = metrics.New(registry)
+		serverOpts = append(serverOpts, api.WithMetrics(registry))
+	}
+
 	// 업비트 클라이언트 생성
-	upbitClient := exchange.NewUpbitClient(cfg.Upbit.AccessKey, cfg.Upbit.SecretKey)
+	clientOpts := []exchange.ClientOption{exchange.WithClientMetrics(botMetrics)}
+	if cfg.Upbit.WSMarketsPerConnection > 0 {
+		clientOpts = append(clientOpts, exchange.WithWebSocketMarketsPerConnection(cfg.Upbit.WSMarketsPerConnection))
+	}
+	if cfg.Upbit.WSPongTimeoutSeconds > 0 {
+		clientOpts = append(clientOpts, exchange.WithWebSocketPongTimeout(time.Duration(cfg.Upbit.WSPongTimeoutSeconds)*time.Second))
+	}
+	if cfg.Upbit.WSSubscribeTimeoutSeconds > 0 {
+		clientOpts = append(clientOpts, exchange.WithWebSocketSubscribeTimeout(time.Duration(cfg.Upbit.WSSubscribeTimeoutSeconds)*time.Second))
+	}
+	if cfg.Upbit.WSFormat != "" {
+		clientOpts = append(clientOpts, exchange.WithWebSocketFormat(cfg.Upbit.WSFormat))
+	}
+	if cfg.Upbit.OrderRequestsPerSecond > 0 {
+		clientOpts = append(clientOpts, exchange.WithOrderRateLimit(cfg.Upbit.OrderRequestsPerSecond))
+	}
+	if cfg.Upbit.QuotationRequestsPerSecond > 0 {
+		clientOpts = append(clientOpts, exchange.WithQuotationRateLimit(cfg.Upbit.QuotationRequestsPerSecond))
+	}
+	if cfg.Upbit.MaxRetries > 0 || cfg.Upbit.RetryBackoffMillis > 0 {
+		clientOpts = append(clientOpts, exchange.WithRetry(cfg.Upbit.MaxRetries, time.Duration(cfg.Upbit.RetryBackoffMillis)*time.Millisecond))
+	}
+	if cfg.Upbit.ListPageSize > 0 {
+		clientOpts = append(clientOpts, exchange.WithListPageSize(cfg.Upbit.ListPageSize))
+	}
+	if cfg.Upbit.DisableCandleChunking {
+		clientOpts = append(clientOpts, exchange.WithCandleChunking(false))
+	}
+	if cfg.Upbit.DisableOrderPriority {
+		clientOpts = append(clientOpts, exchange.WithOrderPriority(false))
+	}
+	if cfg.Risk.APIErrorPause.WindowSeconds > 0 {
+		clientOpts = append(clientOpts, exchange.WithErrorRateWindow(time.Duration(cfg.Risk.APIErrorPause.WindowSeconds)*time.Second))
+	}
+	upbitClient := exchange.NewUpbitClient(cfg.Upbit.AccessKey, cfg.Upbit.SecretKey, clientOpts...)
+	
+	// 주문 경로 선택 (모의 거래는 시세만 실제 API로 받고 주문은 가상 계좌로 처리)
+	var orderClient exchange.Exchange = upbitClient
+	var riskClient risk.Client = upbitClient
+	switch cfg.Trading.Mode {
+	case "", exchange.TradingModeLive:
+	case exchange.TradingModePaper:
+		paperClient := exchange.NewPaperClient(upbitClient, cfg.Trading.Paper.InitialBalance, cfg.Trading.FeeRate)
+		orderClient, riskClient = paperClient, paperClient
+		logger.Info("모의 거래 모드, 시작 잔고:", cfg.Trading.Paper.InitialBalance)
+	default:
+		fatal("알 수 없는 거래 모드:", cfg.Trading.Mode)
+	}
 	
-	// 채널 생성
+	// 채널 생성 (수집기와 전략 관리자의 출력은 대시보드 실시간 전송기를 거쳐 전달)
+	collectedDataCh := make(chan exchange.MarketData, 100)
 	marketDataCh := make(chan exchange.MarketData, 100)
+	strategySignalCh := make(chan strategy.Signal, 100)
 	signalCh := make(chan strategy.Signal, 100)
 	orderCh := make(chan exchange.Order, 100)
 
+	// 대시보드 실시간 이벤트 전송기
+	hub := api.NewHub()
+	go hub.RelayMarketData(ctx, collectedDataCh, marketDataCh)
+	go hub.RelaySignals(ctx, strategySignalCh, signalCh)
+	serverOpts = append(serverOpts, api.WithHub(hub))
+
 	// 위험 관리 모듈 초기화
-	riskManager := risk.NewManager(db.GetDB(), upbitClient, nil)
-	riskManager.Start()
+	riskManager := risk.NewManager(db.GetDB(), riskClient, &cfg.Risk, risk.WithNotifier(notifier), risk.WithMetrics(botMetrics))
+	riskManager.Start(ctx)
 
 	// 전략 관리자 초기화
-	strategyManager := strategy.NewManager(db.GetDB(), upbitClient, marketDataCh, signalCh)
+	strategyManager := strategy.NewManager(db.GetDB(), upbitClient, marketDataCh, strategySignalCh,
+		strategy.WithSignalPersistence(cfg.Trading.PersistSignals),
+		strategy.WithSellIntoStrength(cfg.Trading.SellIntoStrength),
+		strategy.WithConflictPolicy(cfg.Trading.SignalConflictPolicy),
+		strategy.WithExitGuard(riskManager))
 	if err := strategyManager.LoadStrategies(); err != nil {
 		logger.Error("전략 로드 실패:", err)
 	}
-	strategyManager.Start()
+	if len(strategyManager.Markets()) == 0 {
+		logger.Warn("활성화된 전략이 없습니다 (strategy_configs에서 enabled인 전략이 없으면 신호가 나오지 않음)")
+	}
+	strategyManager.Start(ctx)
 
 	// 주문 실행기 초기화
-	orderExecutor := exchange.NewOrderExecutor(db.GetDB(), upbitClient, riskManager, signalCh, orderCh)
-	orderExecutor.Start()
+	orderExecutor := exchange.NewOrderExecutor(db.GetDB(), orderClient, riskManager, signalCh, orderCh, &cfg.Trading,
+		exchange.WithExecutorNotifier(notifier), exchange.WithEventSink(hub), exchange.WithExecutorMetrics(botMetrics))
+	orderExecutor.Start(ctx)
 
 	// 시장 데이터 수집기 초기화
-	dataCollector := exchange.NewDataCollector(upbitClient, db.GetDB(), marketDataCh)
+	dataCollector := exchange.NewDataCollector(upbitClient, db.GetDB(), collectedDataCh, &cfg.Collector,
+		exchange.WithCandleBatchSize(cfg.Database.CandleBatchSize))
 	dataCollector.Start(ctx)
 
 	// API 서버 시작
-	server := api.NewServer(db.GetDB(), upbitClient, strategyManager, riskManager, orderCh)
+	server := api.NewServer(db.GetDB(), upbitClient, strategyManager, riskManager, orderCh,
+		serverOpts...)
 	go func() {
 		if err := server.Start(cfg.Server.Host + ":" + cfg.Server.Port); err != nil {
-			logger.Fatal("API 서버 시작 실패:", err)
+			fatal("API 서버 시작 실패:", err)
+		}
+	}()
+
+	// SIGHUP을 받으면 재시작 없이 전략 설정 다시 읽기
+	hupCh := make(chan os.Signal, 1)
+	signal.Notify(hupCh, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hupCh:
+				if _, err := strategyManager.Reload(); err != nil {
+					logger.Error("전략 설정 다시 읽기 실패:", err)
+				}
+			}
 		}
 	}()
 
@@ -90,8 +224,20 @@ func main() {
 
 	// 모듈 정상 종료
 	strategyManager.Stop()
+	riskManager.Stop()
 	orderExecutor.Stop()
+	if cfg.Trading.CancelOrdersOnShutdown && cfg.Trading.Mode != exchange.TradingModePaper {
+		cancelled, err := upbitClient.CancelAllOrders(shutdownCtx, "")
+		if err != nil {
+			logger.Error("미체결 주문 취소 실패:", err)
+		}
+		logger.Info("미체결 주문 취소:", len(cancelled))
+	}
+	if _, err := orderExecutor.WriteSnapshot("shutdown"); err != nil {
+		logger.Error("상태 스냅샷 저장 실패:", err)
+	}
 	server.Stop(shutdownCtx)
+	notifier.Stop()
 
 	logger.Info("업비트 트레이딩 봇 종료")
 }
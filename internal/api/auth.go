@@ -0,0 +1,152 @@
+package api
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/websocket"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+const (
+	defaultTokenTTL = 30 * time.Minute
+	tokenSubject    = "api"
+)
+
+// authenticator API 인증 (로그인 시 API 비밀 키를 확인하고 그 키로 서명한 JWT 발급)
+type authenticator struct {
+	secret         []byte
+	ttl            time.Duration
+	publicReadOnly bool
+	now            func() time.Time
+}
+
+// newAuthenticator 새로운 API 인증 생성 (비밀 키가 없으면 nil, 인증 없음)
+func newAuthenticator(cfg config.AuthConfig) *authenticator {
+	if cfg.Secret == "" {
+		return nil
+	}
+
+	ttl := time.Duration(cfg.TokenMinutes) * time.Minute
+	if ttl <= 0 {
+		ttl = defaultTokenTTL
+	}
+
+	return &authenticator{
+		secret:         []byte(cfg.Secret),
+		ttl:            ttl,
+		publicReadOnly: cfg.PublicReadOnly,
+		now:            time.Now,
+	}
+}
+
+// loginRequest 로그인 요청
+type loginRequest struct {
+	Secret string `json:"secret" binding:"required"`
+}
+
+// loginResponse 로그인 응답
+type loginResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// issue API 비밀 키 확인 후 토큰 발급
+func (a *authenticator) issue(secret string) (*loginResponse, error) {
+	if subtle.ConstantTimeCompare([]byte(secret), a.secret) != 1 {
+		return nil, errors.New("API 비밀 키가 올바르지 않습니다")
+	}
+
+	now := a.now()
+	expiresAt := now.Add(a.ttl)
+	claims := jwt.RegisteredClaims{
+		Subject:   tokenSubject,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(a.secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loginResponse{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// verify Authorization 헤더의 Bearer 토큰 검증 (만료 시각이 없는 토큰은 거부)
+func (a *authenticator) verify(header string) error {
+	raw, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || raw == "" {
+		return errors.New("인증 토큰이 없습니다")
+	}
+
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(*jwt.Token) (interface{}, error) {
+		return a.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithSubject(tokenSubject), jwt.WithTimeFunc(a.now))
+	if err != nil {
+		return err
+	}
+	if claims.ExpiresAt == nil {
+		return errors.New("만료 시각이 없는 토큰입니다")
+	}
+
+	return nil
+}
+
+// login API 비밀 키로 토큰 발급
+func (s *Server) login(c *gin.Context) {
+	if s.auth == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "API 인증이 설정되지 않았습니다"})
+		return
+	}
+
+	var req loginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := s.auth.issue(req.Secret)
+	if err != nil {
+		s.logger.Warn("API 로그인 실패:", c.ClientIP())
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// requireAuth 유효한 토큰이 없는 요청을 401로 거부하는 미들웨어
+func (s *Server) requireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if s.auth == nil {
+			c.Next()
+			return
+		}
+
+		header := c.GetHeader("Authorization")
+		// 브라우저 웹소켓은 헤더를 넣을 수 없으므로 token 쿼리로도 받는다
+		if header == "" && websocket.IsWebSocketUpgrade(c.Request) && c.Query("token") != "" {
+			header = "Bearer " + c.Query("token")
+		}
+		if err := s.auth.verify(header); err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "인증 실패: " + err.Error()})
+			return
+		}
+		c.Next()
+	}
+}
+
+// readAuth 조회 엔드포인트 인증 미들웨어 (공개 설정이면 인증하지 않음)
+func (s *Server) readAuth() gin.HandlerFunc {
+	if s.auth != nil && s.auth.publicReadOnly {
+		return func(c *gin.Context) { c.Next() }
+	}
+	return s.requireAuth()
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/risk"
+)
+
+// serveWithToken Bearer 토큰을 붙여 요청을 라우터로 처리한 응답 (token이 비어 있으면 헤더 없음)
+func serveWithToken(s *Server, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	return w
+}
+
+// newAuthServer API 비밀 키가 설정되고 낙폭 해제 엔드포인트를 쓸 수 있는 테스트 서버
+func newAuthServer(t *testing.T, cfg config.AuthConfig) *Server {
+	t.Helper()
+	s, db := newTestServer(t, nil, WithAuth(cfg))
+	s.riskManager = risk.NewManager(db, nil, &config.RiskConfig{})
+	return s
+}
+
+// login 비밀 키로 발급받은 토큰
+func login(t *testing.T, s *Server, secret string) string {
+	t.Helper()
+	w := serve(s, http.MethodPost, "/api/login", loginRequest{Secret: secret})
+	if w.Code != http.StatusOK {
+		t.Fatalf("로그인 상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+	var resp loginResponse
+	decodeJSON(t, w, &resp)
+	if resp.Token == "" {
+		t.Fatal("발급된 토큰이 없음")
+	}
+	return resp.Token
+}
+
+func TestLoginRejectsWrongSecret(t *testing.T) {
+	s := newAuthServer(t, config.AuthConfig{Secret: "s3cret"})
+
+	if w := serve(s, http.MethodPost, "/api/login", loginRequest{Secret: "wrong"}); w.Code != http.StatusUnauthorized {
+		t.Fatalf("잘못된 비밀 키 상태 코드 = %d, want 401", w.Code)
+	}
+}
+
+func TestWriteEndpointRequiresValidToken(t *testing.T) {
+	s := newAuthServer(t, config.AuthConfig{Secret: "s3cret"})
+
+	if w := serveWithToken(s, http.MethodPost, "/api/risk/reset", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("토큰 없음 상태 코드 = %d, want 401", w.Code)
+	}
+	if w := serveWithToken(s, http.MethodPost, "/api/risk/reset", "not-a-jwt"); w.Code != http.StatusUnauthorized {
+		t.Fatalf("잘못된 토큰 상태 코드 = %d, want 401", w.Code)
+	}
+
+	token := login(t, s, "s3cret")
+	if w := serveWithToken(s, http.MethodPost, "/api/risk/reset", token); w.Code != http.StatusOK {
+		t.Fatalf("유효한 토큰 상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+
+	// 다른 비밀 키로 서명한 토큰은 받지 않는다
+	other := newAuthServer(t, config.AuthConfig{Secret: "other"})
+	if w := serveWithToken(other, http.MethodPost, "/api/risk/reset", token); w.Code != http.StatusUnauthorized {
+		t.Fatalf("다른 키 토큰 상태 코드 = %d, want 401", w.Code)
+	}
+}
+
+func TestExpiredTokenIsRejected(t *testing.T) {
+	s := newAuthServer(t, config.AuthConfig{Secret: "s3cret", TokenMinutes: 5})
+
+	s.auth.now = func() time.Time { return time.Now().Add(-10 * time.Minute) }
+	token := login(t, s, "s3cret")
+	s.auth.now = time.Now
+
+	if w := serveWithToken(s, http.MethodPost, "/api/risk/reset", token); w.Code != http.StatusUnauthorized {
+		t.Fatalf("만료된 토큰 상태 코드 = %d, want 401", w.Code)
+	}
+}
+
+func TestReadEndpointsPublicOnlyWhenConfigured(t *testing.T) {
+	private := newAuthServer(t, config.AuthConfig{Secret: "s3cret"})
+	if w := serveWithToken(private, http.MethodGet, "/api/positions", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("비공개 조회 상태 코드 = %d, want 401", w.Code)
+	}
+
+	public := newAuthServer(t, config.AuthConfig{Secret: "s3cret", PublicReadOnly: true})
+	if w := serveWithToken(public, http.MethodGet, "/api/positions", ""); w.Code != http.StatusOK {
+		t.Fatalf("공개 조회 상태 코드 = %d, want 200", w.Code)
+	}
+	// 공개 설정이어도 변경 엔드포인트는 인증이 필요하다
+	if w := serveWithToken(public, http.MethodPost, "/api/risk/reset", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("공개 설정 변경 요청 상태 코드 = %d, want 401", w.Code)
+	}
+}
+
+func TestLoginUnavailableWithoutSecret(t *testing.T) {
+	s, _ := newTestServer(t, nil)
+	if w := serve(s, http.MethodPost, "/api/login", loginRequest{Secret: "x"}); w.Code != http.StatusNotFound {
+		t.Fatalf("인증 미설정 로그인 상태 코드 = %d, want 404", w.Code)
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/storage"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestDB 모든 모델을 마이그레이션한 테스트용 sqlite 데이터베이스
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("테스트 데이터베이스 열기 실패: %v", err)
+	}
+	if err := db.AutoMigrate(storage.Models()...); err != nil {
+		t.Fatalf("마이그레이션 실패: %v", err)
+	}
+	return db
+}
+
+// redirectTransport 업비트 주소로 가는 요청을 테스트 서버로 보내는 전송기
+type redirectTransport struct {
+	target *url.URL
+}
+
+// RoundTrip 요청 주소의 호스트만 테스트 서버로 바꿔 전송
+func (r redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestUpbitClient handler가 업비트 API 응답을 대신하는 클라이언트 생성
+func newTestUpbitClient(t *testing.T, handler http.Handler) *exchange.UpbitClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("테스트 서버 주소 파싱 실패: %v", err)
+	}
+
+	return exchange.NewUpbitClient("access", "secret",
+		exchange.WithHTTPClient(&http.Client{Transport: redirectTransport{target: target}}))
+}
+
+// newTestServer sqlite를 쓰는 API 서버 (client가 nil이면 현재가를 조회하지 않음)
+func newTestServer(t *testing.T, client *exchange.UpbitClient, opts ...ServerOption) (*Server, *gorm.DB) {
+	t.Helper()
+	db := newTestDB(t)
+	return NewServer(db, client, nil, nil, nil, opts...), db
+}
+
+// serve 요청을 라우터로 처리한 응답
+func serve(s *Server, method, path string, body interface{}) *httptest.ResponseRecorder {
+	var data []byte
+	if body != nil {
+		data, _ = json.Marshal(body)
+	}
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	return w
+}
+
+// decodeJSON 응답 본문 디코딩
+func decodeJSON(t *testing.T, w *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("응답 디코딩 실패: %v (%s)", err, w.Body.String())
+	}
+}
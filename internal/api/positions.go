@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPositionLimit = 50
+	maxPositionLimit     = 500
+)
+
+// positionResponse 포지션 응답
+type positionResponse struct {
+	model.Position
+	BreakEvenPrice float64 `json:"break_even_price"` // 왕복 수수료를 반영한 손익분기 가격
+
+	// 열린 포지션만 현재가로 평가 (현재가 조회에 실패하면 비어 있음)
+	MarkPrice         float64 `json:"mark_price,omitempty"`
+	UnrealizedProfit  float64 `json:"unrealized_profit,omitempty"`  // 평가 손익 (KRW, 매수 수수료와 예상 매도 수수료 차감)
+	UnrealizedPercent float64 `json:"unrealized_percent,omitempty"` // 평가 수익률 (%)
+}
+
+// getPositions 포지션 목록 조회
+// 쿼리: status(OPEN, CLOSED, 기본 OPEN), page(기본 1), limit(기본 50, 최대 500)
+func (s *Server) getPositions(c *gin.Context) {
+	status := c.DefaultQuery("status", "OPEN")
+	if status != "OPEN" && status != "CLOSED" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 status: " + status})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 page: " + c.Query("page")})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultPositionLimit)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 limit: " + c.Query("limit")})
+		return
+	}
+	if limit > maxPositionLimit {
+		limit = maxPositionLimit
+	}
+
+	var positions []model.Position
+	err = s.db.Where("status = ?", status).
+		Order("entry_time").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&positions).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, s.positionResponses(c.Request.Context(), positions))
+}
+
+// getPosition 마켓 포지션 조회
+func (s *Server) getPosition(c *gin.Context) {
+	var position model.Position
+	err := s.db.Where("market_id = ?", c.Param("market")).First(&position).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "포지션이 없습니다: " + c.Param("market")})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, s.positionResponses(c.Request.Context(), []model.Position{position})[0])
+}
+
+// positionResponses 포지션 응답 구성 (열린 포지션은 현재가 한 번 조회로 평가)
+func (s *Server) positionResponses(ctx context.Context, positions []model.Position) []positionResponse {
+	prices := s.markPrices(ctx, positions)
+
+	response := make([]positionResponse, len(positions))
+	for i, position := range positions {
+		response[i] = positionResponse{
+			Position:       position,
+			BreakEvenPrice: position.BreakEvenPrice(s.feeRate),
+		}
+		if price, ok := prices[position.MarketID]; ok {
+			response[i].MarkPrice = price
+			response[i].UnrealizedProfit, response[i].UnrealizedPercent = s.unrealizedProfit(position, price)
+		}
+	}
+
+	return response
+}
+
+// markPrices 열린 포지션 마켓의 현재가
+// 현재가 조회에 실패해도 포지션 조회는 되도록 로그만 남기고 빈 결과를 반환한다.
+func (s *Server) markPrices(ctx context.Context, positions []model.Position) map[string]float64 {
+	var markets []string
+	for _, position := range positions {
+		if position.Status == "OPEN" {
+			markets = append(markets, position.MarketID)
+		}
+	}
+	if len(markets) == 0 || s.client == nil {
+		return nil
+	}
+
+	tickers, err := s.client.GetTickers(ctx, markets)
+	if err != nil {
+		s.logger.Error("포지션 평가용 현재가 조회 실패:", err)
+		return nil
+	}
+
+	prices := make(map[string]float64, len(tickers))
+	for _, ticker := range tickers {
+		prices[ticker.MarketID] = ticker.TradePrice
+	}
+	return prices
+}
+
+// unrealizedProfit 현재가 기준 평가 손익과 수익률
+func (s *Server) unrealizedProfit(position model.Position, price float64) (float64, float64) {
+	cost := position.EntryPrice*position.Quantity + position.EntryFee
+	if cost <= 0 {
+		return 0, 0
+	}
+
+	value := price * position.Quantity
+	profit := value - value*s.feeRate/100 - cost
+	return profit, profit / cost * 100
+}
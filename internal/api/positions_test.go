@@ -0,0 +1,169 @@
+package api
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// tickerHandler /v1/ticker에 prices의 현재가를 응답하는 핸들러 (요청 수를 requests에 셈)
+func tickerHandler(t *testing.T, requests *int32, prices map[string]float64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		if r.URL.Path != "/v1/ticker" {
+			t.Errorf("요청 경로 = %s, want /v1/ticker", r.URL.Path)
+		}
+		var tickers []exchange.Ticker
+		for market, price := range prices {
+			tickers = append(tickers, exchange.Ticker{MarketID: market, TradePrice: price})
+		}
+		json.NewEncoder(w).Encode(tickers)
+	})
+}
+
+// createPositions 테스트 포지션 저장
+func createPositions(t *testing.T, s *Server, positions ...model.Position) {
+	t.Helper()
+	for i := range positions {
+		if err := s.db.Create(&positions[i]).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestGetPositionsIncludesFeeAdjustedBreakEven(t *testing.T) {
+	s, db := newTestServer(t, nil, WithFeeRate(0.05))
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN", LastPrice: 100000}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	w := serve(s, http.MethodGet, "/api/positions", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+
+	var positions []positionResponse
+	decodeJSON(t, w, &positions)
+	if len(positions) != 1 {
+		t.Fatalf("포지션 수 = %d, want 1", len(positions))
+	}
+	// 100,000 * 1.0005 / 0.9995
+	if got, want := positions[0].BreakEvenPrice, 100100.05002501; math.Abs(got-want) > 1e-6 {
+		t.Fatalf("손익분기 가격 = %v, want %v", got, want)
+	}
+}
+
+func TestBreakEvenPriceUsesDefaultFeeRate(t *testing.T) {
+	s, _ := newTestServer(t, nil, WithFeeRate(0))
+	if s.feeRate != defaultFeeRate {
+		t.Fatalf("수수료율 = %v, want 기본값 %v", s.feeRate, defaultFeeRate)
+	}
+
+	position := model.Position{EntryPrice: 100000}
+	if got := position.BreakEvenPrice(0); got != 100000 {
+		t.Fatalf("수수료 0 손익분기 가격 = %v, want 100000", got)
+	}
+}
+
+func TestGetPositionMarksOpenPositionToTicker(t *testing.T) {
+	var requests int32
+	client := newTestUpbitClient(t, tickerHandler(t, &requests, map[string]float64{"KRW-BTC": 110000}))
+	s, _ := newTestServer(t, client, WithFeeRate(0.05))
+	createPositions(t, s, model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, EntryFee: 50, Status: "OPEN", LastPrice: 100000})
+
+	w := serve(s, http.MethodGet, "/api/positions/KRW-BTC", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+	var position positionResponse
+	decodeJSON(t, w, &position)
+
+	// 평가액 110,000 - 예상 매도 수수료 55 - 원가 100,050 = 9,895원
+	if position.MarkPrice != 110000 || math.Abs(position.UnrealizedProfit-9895) > 1e-9 {
+		t.Fatalf("현재가 = %v, 평가 손익 = %v, want 110000, 9895", position.MarkPrice, position.UnrealizedProfit)
+	}
+	if want := 9895.0 / 100050 * 100; math.Abs(position.UnrealizedPercent-want) > 1e-9 {
+		t.Fatalf("평가 수익률 = %v, want %v", position.UnrealizedPercent, want)
+	}
+}
+
+func TestGetPositionsListsClosedWithoutTickerLookup(t *testing.T) {
+	var requests int32
+	client := newTestUpbitClient(t, tickerHandler(t, &requests, map[string]float64{"KRW-BTC": 110000}))
+	s, _ := newTestServer(t, client)
+	createPositions(t, s,
+		model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"},
+		model.Position{MarketID: "KRW-ETH", EntryPrice: 2000000, EntryTime: time.Now(), Status: "CLOSED", ExitPrice: 2100000, ExitReason: "TARGET", CurrentProfit: 98950},
+	)
+
+	w := serve(s, http.MethodGet, "/api/positions?status=CLOSED", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+	var positions []positionResponse
+	decodeJSON(t, w, &positions)
+	if len(positions) != 1 || positions[0].MarketID != "KRW-ETH" || positions[0].ExitReason != "TARGET" || positions[0].CurrentProfit != 98950 {
+		t.Fatalf("청산 포지션 = %+v, want KRW-ETH TARGET", positions)
+	}
+	if positions[0].MarkPrice != 0 || atomic.LoadInt32(&requests) != 0 {
+		t.Fatalf("청산 포지션 현재가 = %v, 현재가 조회 %d번, want 조회 없음", positions[0].MarkPrice, requests)
+	}
+}
+
+func TestGetPositionsPaginates(t *testing.T) {
+	s, _ := newTestServer(t, nil)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	createPositions(t, s,
+		model.Position{MarketID: "KRW-BTC", EntryPrice: 100, EntryTime: start, Quantity: 1, Status: "OPEN"},
+		model.Position{MarketID: "KRW-ETH", EntryPrice: 100, EntryTime: start.Add(time.Minute), Quantity: 1, Status: "OPEN"},
+		model.Position{MarketID: "KRW-XRP", EntryPrice: 100, EntryTime: start.Add(2 * time.Minute), Quantity: 1, Status: "OPEN"},
+	)
+
+	w := serve(s, http.MethodGet, "/api/positions?page=2&limit=2", nil)
+	var positions []positionResponse
+	decodeJSON(t, w, &positions)
+	if w.Code != http.StatusOK || len(positions) != 1 || positions[0].MarketID != "KRW-XRP" {
+		t.Fatalf("2페이지 = %d %+v, want KRW-XRP 1개", w.Code, positions)
+	}
+
+	for _, query := range []string{"status=ALL", "page=0", "page=x", "limit=0", "limit=x"} {
+		if w := serve(s, http.MethodGet, "/api/positions?"+query, nil); w.Code != http.StatusBadRequest {
+			t.Errorf("%s 상태 코드 = %d, want 400", query, w.Code)
+		}
+	}
+}
+
+func TestGetPositionReturnsNotFoundForUnknownMarket(t *testing.T) {
+	s, _ := newTestServer(t, nil)
+
+	w := serve(s, http.MethodGet, "/api/positions/KRW-NONE", nil)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("상태 코드 = %d, want 404 (%s)", w.Code, w.Body.String())
+	}
+}
+
+func TestGetPositionSkipsMarkPriceWhenTickerFails(t *testing.T) {
+	client := newTestUpbitClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]string{"name": "invalid_query", "message": "잘못된 마켓"}})
+	}))
+	s, _ := newTestServer(t, client)
+	createPositions(t, s, model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"})
+
+	w := serve(s, http.MethodGet, "/api/positions/KRW-BTC", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+	var position positionResponse
+	decodeJSON(t, w, &position)
+	if position.MarketID != "KRW-BTC" || position.MarkPrice != 0 || position.UnrealizedProfit != 0 {
+		t.Fatalf("포지션 = %+v, want 현재가 없이 KRW-BTC", position)
+	}
+}
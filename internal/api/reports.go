@@ -0,0 +1,123 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// dateLayout 날짜 쿼리 형식
+const dateLayout = "2006-01-02"
+
+// getExposureReport 포트폴리오 방향성 노출 보고서 조회
+// 쿼리: benchmark(기본 KRW-BTC), timeframe(기본 days), lookback(기본 30)
+func (s *Server) getExposureReport(c *gin.Context) {
+	var lookback int
+	if v := c.Query("lookback"); v != "" {
+		var err error
+		if lookback, err = strconv.Atoi(v); err != nil || lookback < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 lookback: " + v})
+			return
+		}
+	}
+
+	report, err := s.riskManager.ExposureReport(c.Query("benchmark"), c.Query("timeframe"), lookback)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// feeSummary 수수료 집계
+type feeSummary struct {
+	Key         string  `json:"key"`
+	TotalFee    float64 `json:"total_fee"`
+	TradeVolume float64 `json:"trade_volume"` // 거래 금액 합계 (KRW)
+	FeePercent  float64 `json:"fee_percent"`  // 거래 금액 대비 수수료 (%)
+	TradeCount  int     `json:"trade_count"`
+}
+
+// feeReport 거래 비용 보고서
+type feeReport struct {
+	From        time.Time    `json:"from"`
+	To          time.Time    `json:"to"`
+	TotalFee    float64      `json:"total_fee"`
+	TradeVolume float64      `json:"trade_volume"`
+	FeePercent  float64      `json:"fee_percent"`
+	ByMarket    []feeSummary `json:"by_market"`
+	ByStrategy  []feeSummary `json:"by_strategy"`
+}
+
+// getFeeReport 기간별 수수료 보고서 조회
+// 쿼리: from, to (YYYY-MM-DD, to는 포함하지 않음). 기본값은 최근 30일.
+func (s *Server) getFeeReport(c *gin.Context) {
+	to := time.Now()
+	from := to.AddDate(0, 0, -30)
+
+	var err error
+	if v := c.Query("from"); v != "" {
+		if from, err = time.Parse(dateLayout, v); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 from 날짜: " + v})
+			return
+		}
+	}
+	if v := c.Query("to"); v != "" {
+		if to, err = time.Parse(dateLayout, v); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 to 날짜: " + v})
+			return
+		}
+	}
+
+	report := feeReport{From: from, To: to}
+
+	err = s.db.Table("trades").
+		Select("market_id AS key, SUM(fee) AS total_fee, SUM(price * volume) AS trade_volume, COUNT(*) AS trade_count").
+		Where("timestamp >= ? AND timestamp < ? AND deleted_at IS NULL", from, to).
+		Group("market_id").
+		Order("total_fee DESC").
+		Scan(&report.ByMarket).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// 체결 → 주문 → 신호를 따라 전략을 찾고, 신호 없는 주문은 수동 주문으로 집계한다
+	err = s.db.Table("trades AS t").
+		Select("COALESCE(sg.strategy_name, 'MANUAL') AS key, SUM(t.fee) AS total_fee, SUM(t.price * t.volume) AS trade_volume, COUNT(*) AS trade_count").
+		Joins("LEFT JOIN orders AS o ON o.order_id = t.order_id").
+		Joins("LEFT JOIN signals AS sg ON sg.id = o.signal_id").
+		Where("t.timestamp >= ? AND t.timestamp < ? AND t.deleted_at IS NULL", from, to).
+		Group("COALESCE(sg.strategy_name, 'MANUAL')").
+		Order("total_fee DESC").
+		Scan(&report.ByStrategy).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	for i := range report.ByMarket {
+		summary := &report.ByMarket[i]
+		summary.FeePercent = feePercent(summary.TotalFee, summary.TradeVolume)
+		report.TotalFee += summary.TotalFee
+		report.TradeVolume += summary.TradeVolume
+	}
+	for i := range report.ByStrategy {
+		summary := &report.ByStrategy[i]
+		summary.FeePercent = feePercent(summary.TotalFee, summary.TradeVolume)
+	}
+	report.FeePercent = feePercent(report.TotalFee, report.TradeVolume)
+
+	c.JSON(http.StatusOK, report)
+}
+
+// feePercent 거래 금액 대비 수수료 비율 (%)
+func feePercent(fee, volume float64) float64 {
+	if volume == 0 {
+		return 0
+	}
+	return fee / volume * 100
+}
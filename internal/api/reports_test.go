@@ -0,0 +1,93 @@
+package api
+
+import (
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/risk"
+)
+
+func TestGetExposureReportRejectsInvalidLookback(t *testing.T) {
+	s, _ := newTestServer(t, nil)
+
+	for _, lookback := range []string{"abc", "0", "-3"} {
+		w := serve(s, http.MethodGet, "/api/reports/exposure?lookback="+lookback, nil)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("lookback=%s 상태 코드 = %d, want 400", lookback, w.Code)
+		}
+	}
+}
+
+func TestGetExposureReport(t *testing.T) {
+	s, db := newTestServer(t, nil)
+	s.riskManager = risk.NewManager(db, nil, &config.RiskConfig{})
+
+	w := serve(s, http.MethodGet, "/api/reports/exposure?benchmark=KRW-ETH&lookback=10", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+
+	var report risk.ExposureReport
+	decodeJSON(t, w, &report)
+	if report.Benchmark != "KRW-ETH" || report.Lookback != 10 || len(report.Positions) != 0 {
+		t.Fatalf("보고서 = %+v, want 기준 KRW-ETH, lookback 10, 포지션 없음", report)
+	}
+}
+
+func TestGetFeeReportGroupsByMarketAndStrategy(t *testing.T) {
+	s, db := newTestServer(t, nil)
+
+	signal := model.Signal{MarketID: "KRW-BTC", StrategyName: "rsi", SignalType: "BUY", Price: 100000, Timestamp: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)}
+	if err := db.Create(&signal).Error; err != nil {
+		t.Fatal(err)
+	}
+	records := []interface{}{
+		&model.Order{MarketID: "KRW-BTC", OrderID: "signal-order", Side: "BUY", OrderType: "limit", Volume: 1, Status: "DONE", SignalID: signal.ID},
+		&model.Order{MarketID: "KRW-ETH", OrderID: "manual-order", Side: "BUY", OrderType: "limit", Volume: 1, Status: "DONE"},
+		&model.Trade{MarketID: "KRW-BTC", OrderID: "signal-order", TradeID: "t1", Price: 100000, Volume: 1, Side: "BUY", Fee: 50, Timestamp: time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)},
+		&model.Trade{MarketID: "KRW-BTC", OrderID: "signal-order", TradeID: "t2", Price: 100000, Volume: 1, Side: "BUY", Fee: 50, Timestamp: time.Date(2024, 1, 11, 9, 0, 0, 0, time.UTC)},
+		&model.Trade{MarketID: "KRW-ETH", OrderID: "manual-order", TradeID: "t3", Price: 10000, Volume: 2, Side: "BUY", Fee: 10, Timestamp: time.Date(2024, 1, 12, 9, 0, 0, 0, time.UTC)},
+		// 기간 밖 체결은 빠진다
+		&model.Trade{MarketID: "KRW-BTC", OrderID: "signal-order", TradeID: "t4", Price: 100000, Volume: 1, Side: "SELL", Fee: 50, Timestamp: time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w := serve(s, http.MethodGet, "/api/reports/fees?from=2024-01-01&to=2024-02-01", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+
+	var report feeReport
+	decodeJSON(t, w, &report)
+	if report.TotalFee != 110 || report.TradeVolume != 220000 {
+		t.Fatalf("합계 수수료 %v, 거래 금액 %v, want 110, 220000", report.TotalFee, report.TradeVolume)
+	}
+	if math.Abs(report.FeePercent-0.05) > 1e-9 {
+		t.Fatalf("수수료 비율 = %v, want 0.05", report.FeePercent)
+	}
+	if len(report.ByMarket) != 2 || report.ByMarket[0].Key != "KRW-BTC" || report.ByMarket[0].TradeCount != 2 {
+		t.Fatalf("마켓별 집계 = %+v", report.ByMarket)
+	}
+	if len(report.ByStrategy) != 2 || report.ByStrategy[0].Key != "rsi" || report.ByStrategy[1].Key != "MANUAL" {
+		t.Fatalf("전략별 집계 = %+v, want rsi, MANUAL", report.ByStrategy)
+	}
+}
+
+func TestGetFeeReportRejectsInvalidDates(t *testing.T) {
+	s, _ := newTestServer(t, nil)
+
+	for _, query := range []string{"from=2024-13-01", "to=yesterday"} {
+		w := serve(s, http.MethodGet, "/api/reports/fees?"+query, nil)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s 상태 코드 = %d, want 400", query, w.Code)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getDailyLoss 당일 실현 손익과 손실 한도에 따른 신규 진입 차단 여부 조회
+func (s *Server) getDailyLoss(c *gin.Context) {
+	status, err := s.riskManager.DailyLoss(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// getDrawdownStatus 낙폭 차단기 상태 조회 (고점, 최저점, 차단 여부)
+func (s *Server) getDrawdownStatus(c *gin.Context) {
+	state, err := s.riskManager.DrawdownStatus()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, state)
+}
+
+// resetDrawdown 낙폭 차단 수동 해제 및 거래 재개
+func (s *Server) resetDrawdown(c *gin.Context) {
+	if err := s.riskManager.ResetDrawdown(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	s.getDrawdownStatus(c)
+}
+
+// getRiskDecisions 위험 관리 판단 기록 조회
+// 쿼리: market, kind(ENTRY, ALLOCATION, DRAWDOWN), limit(기본 100)
+func (s *Server) getRiskDecisions(c *gin.Context) {
+	var limit int
+	if v := c.Query("limit"); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil || limit < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 limit: " + v})
+			return
+		}
+	}
+
+	decisions, err := s.riskManager.Decisions(c.Query("market"), c.Query("kind"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, decisions)
+}
+
+// replayRiskDecision 기록된 입력으로 위험 관리 판단 재현
+func (s *Server) replayRiskDecision(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 판단 ID: " + c.Param("id")})
+		return
+	}
+
+	result, err := s.riskManager.ReplayDecision(uint(id))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
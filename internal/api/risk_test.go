@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/risk"
+)
+
+func TestGetRiskDecisionsRejectsInvalidLimit(t *testing.T) {
+	s, _ := newTestServer(t, nil)
+
+	for _, limit := range []string{"abc", "0", "-1"} {
+		w := serve(s, http.MethodGet, "/api/risk/decisions?limit="+limit, nil)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("limit=%s 상태 코드 = %d, want 400", limit, w.Code)
+		}
+	}
+}
+
+func TestGetAndReplayRiskDecisions(t *testing.T) {
+	s, db := newTestServer(t, nil)
+	s.riskManager = risk.NewManager(db, nil, &config.RiskConfig{RecordDecisions: true})
+
+	for _, marketID := range []string{"KRW-BTC", "KRW-ETH"} {
+		if err := s.riskManager.CheckEntry(context.Background(), &model.Signal{MarketID: marketID, SignalType: "BUY"}); err != nil {
+			t.Fatalf("진입 거부: %v", err)
+		}
+	}
+
+	w := serve(s, http.MethodGet, "/api/risk/decisions?market=KRW-ETH&limit=5", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+	var decisions []model.RiskDecision
+	decodeJSON(t, w, &decisions)
+	if len(decisions) != 1 || decisions[0].MarketID != "KRW-ETH" || decisions[0].Outcome != risk.OutcomeApprove {
+		t.Fatalf("판단 기록 = %+v, want KRW-ETH 승인 1건", decisions)
+	}
+
+	w = serve(s, http.MethodGet, "/api/risk/decisions/"+strconv.FormatUint(uint64(decisions[0].ID), 10)+"/replay", nil)
+	var result risk.ReplayResult
+	decodeJSON(t, w, &result)
+	if w.Code != http.StatusOK || !result.Match {
+		t.Fatalf("재현 응답 = %d %+v, want 200, 일치", w.Code, result)
+	}
+
+	if w := serve(s, http.MethodGet, "/api/risk/decisions/abc/replay", nil); w.Code != http.StatusBadRequest {
+		t.Fatalf("잘못된 ID 상태 코드 = %d, want 400", w.Code)
+	}
+}
+
+func TestResetDrawdownResumesTrading(t *testing.T) {
+	s, db := newTestServer(t, nil)
+	s.riskManager = risk.NewManager(db, nil, &config.RiskConfig{MaxEquityDrawdown: 10})
+	if err := db.Create(&model.DrawdownState{Peak: 1000000, Trough: 850000, Tripped: true, Reason: "낙폭 15%"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	s.riskManager.Pause("낙폭 15%")
+
+	w := serve(s, http.MethodGet, "/api/risk/drawdown", nil)
+	var state model.DrawdownState
+	decodeJSON(t, w, &state)
+	if w.Code != http.StatusOK || !state.Tripped || state.Peak != 1000000 {
+		t.Fatalf("차단 상태 조회 = %d %+v, want 200, Tripped, Peak 1000000", w.Code, state)
+	}
+
+	w = serve(s, http.MethodPost, "/api/risk/reset", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+	state = model.DrawdownState{}
+	decodeJSON(t, w, &state)
+	if state.Tripped || state.Peak != 0 {
+		t.Fatalf("해제 후 상태 = %+v, want 차단 해제, 고점 초기화", state)
+	}
+	if s.riskManager.IsPaused() {
+		t.Fatal("해제 후에도 거래 중지 상태")
+	}
+}
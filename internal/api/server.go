@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/risk"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/strategy"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gorm.io/gorm"
+)
+
+const defaultFeeRate = 0.05
+
+// Server API 서버
+type Server struct {
+	db              *gorm.DB
+	client          *exchange.UpbitClient
+	strategyManager *strategy.Manager
+	riskManager     *risk.Manager
+	orderCh         chan<- exchange.Order
+	router          *gin.Engine
+	httpServer      *http.Server
+	logger          *utils.Logger
+
+	feeRate float64
+	auth    *authenticator // nil이면 인증하지 않음
+	hub     *Hub
+	metrics prometheus.Gatherer // nil이면 /metrics 없음
+}
+
+// ServerOption API 서버 옵션
+type ServerOption func(*Server)
+
+// WithFeeRate 손익분기 계산에 사용할 수수료율(%) 설정
+func WithFeeRate(feeRate float64) ServerOption {
+	return func(s *Server) {
+		if feeRate > 0 {
+			s.feeRate = feeRate
+		}
+	}
+}
+
+// WithAuth API 인증 설정 (비밀 키가 비어 있으면 인증하지 않음)
+func WithAuth(cfg config.AuthConfig) ServerOption {
+	return func(s *Server) {
+		s.auth = newAuthenticator(cfg)
+	}
+}
+
+// WithHub 웹소켓 실시간 이벤트 전송기 설정 (주문 실행기 등 이벤트를 보내는 쪽과 공유)
+func WithHub(hub *Hub) ServerOption {
+	return func(s *Server) {
+		s.hub = hub
+	}
+}
+
+// WithMetrics Prometheus 지표 엔드포인트(/metrics) 설정
+func WithMetrics(gatherer prometheus.Gatherer) ServerOption {
+	return func(s *Server) {
+		s.metrics = gatherer
+	}
+}
+
+// NewServer 새로운 API 서버 생성
+func NewServer(db *gorm.DB, client *exchange.UpbitClient, strategyManager *strategy.Manager, riskManager *risk.Manager, orderCh chan<- exchange.Order, opts ...ServerOption) *Server {
+	s := &Server{
+		db:              db,
+		client:          client,
+		strategyManager: strategyManager,
+		riskManager:     riskManager,
+		orderCh:         orderCh,
+		logger:          utils.NewLogger("api"),
+		feeRate:         defaultFeeRate,
+		hub:             NewHub(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.auth == nil {
+		s.logger.Warn("API 인증이 설정되지 않아 누구나 주문과 설정 변경을 요청할 수 있습니다 (server.auth.secret)")
+	}
+
+	s.router = gin.New()
+	s.router.Use(gin.Recovery(), cors.Default())
+	s.setupRoutes()
+
+	return s
+}
+
+// setupRoutes 라우트 설정
+func (s *Server) setupRoutes() {
+	// Prometheus 수집기가 로그인할 수 없으므로 인증 없이 제공한다 (server.host로 접근 범위 제한)
+	if s.metrics != nil {
+		s.router.GET("/metrics", gin.WrapH(promhttp.HandlerFor(s.metrics, promhttp.HandlerOpts{})))
+	}
+
+	api := s.router.Group("/api")
+	api.POST("/login", s.login)
+
+	// 조회 엔드포인트 (server.auth.public_read_only이면 인증 없이 허용)
+	read := api.Group("", s.readAuth())
+	read.GET("/positions", s.getPositions)
+	read.GET("/positions/:market", s.getPosition)
+	read.GET("/reports/exposure", s.getExposureReport)
+	read.GET("/reports/fees", s.getFeeReport)
+	read.GET("/risk/daily-loss", s.getDailyLoss)
+	read.GET("/risk/decisions", s.getRiskDecisions)
+	read.GET("/risk/drawdown", s.getDrawdownStatus)
+	read.GET("/risk/decisions/:id/replay", s.replayRiskDecision)
+	read.GET("/status/websocket", s.getWebSocketStatus)
+	read.GET("/status/ratelimit", s.getRateLimitStatus)
+	read.GET("/strategies/export", s.exportStrategies)
+	read.GET("/ws", s.serveWebSocket)
+
+	// 변경 엔드포인트 (항상 인증 필요)
+	write := api.Group("", s.requireAuth())
+	write.POST("/risk/reset", s.resetDrawdown)
+	write.POST("/strategies/import", s.importStrategies)
+	write.POST("/strategies/reload", s.reloadStrategies)
+}
+
+// Start API 서버 시작
+func (s *Server) Start(addr string) error {
+	s.httpServer = &http.Server{
+		Addr:    addr,
+		Handler: s.router,
+	}
+
+	s.logger.Info("API 서버 시작:", addr)
+	if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Stop API 서버 종료
+func (s *Server) Stop(ctx context.Context) error {
+	if s.httpServer == nil {
+		return nil
+	}
+
+	s.logger.Info("API 서버 종료")
+	// 웹소켓 연결은 Shutdown이 닫지 않으므로 먼저 닫는다
+	s.hub.Close()
+	return s.httpServer.Shutdown(ctx)
+}
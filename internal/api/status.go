@@ -0,0 +1,17 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getWebSocketStatus 웹소켓 연결 상태 지표 조회
+func (s *Server) getWebSocketStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, s.client.WebSocketStats())
+}
+
+// getRateLimitStatus 업비트 요청 한도 상태 조회
+func (s *Server) getRateLimitStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, s.client.LastRateLimit())
+}
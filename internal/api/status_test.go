@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+)
+
+func TestGetRateLimitStatus(t *testing.T) {
+	client := newTestUpbitClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Remaining-Req", "group=default; min=1799; sec=29")
+		w.Write([]byte(`[]`))
+	}))
+	s, _ := newTestServer(t, client)
+
+	// 요청 전에는 빈 상태를 돌려준다
+	w := serve(s, http.MethodGet, "/api/status/ratelimit", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200", w.Code)
+	}
+
+	if _, err := client.GetAccounts(context.Background()); err != nil {
+		t.Fatalf("계정 조회 실패: %v", err)
+	}
+
+	w = serve(s, http.MethodGet, "/api/status/ratelimit", nil)
+	var status exchange.RateLimitStatus
+	decodeJSON(t, w, &status)
+	if status.Group != "default" || status.PerMinute != 1799 || status.PerSecond != 29 {
+		t.Fatalf("요청 한도 상태 = %+v, want default 1799 29", status)
+	}
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/strategy"
+	"gopkg.in/yaml.v2"
+)
+
+const formatYAML = "yaml"
+
+// exportStrategies 전략 설정 내보내기
+// format=yaml이면 YAML로, 그 외에는 JSON으로 응답한다.
+func (s *Server) exportStrategies(c *gin.Context) {
+	exports, err := s.strategyManager.ExportConfigs()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if c.Query("format") == formatYAML {
+		c.YAML(http.StatusOK, exports)
+		return
+	}
+	c.JSON(http.StatusOK, exports)
+}
+
+// importStrategies 전략 설정 가져오기
+// 본문은 format=yaml이면 YAML, 그 외에는 JSON 배열이며, merge=true이면 기존 설정을 갱신한다.
+func (s *Server) importStrategies(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var exports []strategy.ConfigExport
+	if c.Query("format") == formatYAML {
+		err = yaml.Unmarshal(body, &exports)
+	} else {
+		err = json.Unmarshal(body, &exports)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 전략 설정 형식: " + err.Error()})
+		return
+	}
+
+	merge, _ := strconv.ParseBool(c.Query("merge"))
+	result, err := s.strategyManager.ImportConfigs(exports, merge)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// reloadStrategies 전략 설정을 다시 읽어 실행 중인 전략에 반영 (봇 재시작 없음)
+// 잘못된 설정이 있으면 아무것도 반영하지 않고 400과 실패한 전략 목록을 응답한다.
+func (s *Server) reloadStrategies(c *gin.Context) {
+	result, err := s.strategyManager.Reload()
+	if errors.Is(err, strategy.ErrInvalidStrategyConfig) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "failed": result.Failed})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
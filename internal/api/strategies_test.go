@@ -0,0 +1,101 @@
+package api
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/strategy"
+	"gorm.io/gorm"
+)
+
+// newStrategyServer 전략 관리자를 연결한 API 서버
+func newStrategyServer(t *testing.T) (*Server, *strategy.Manager, *gorm.DB) {
+	t.Helper()
+	db := newTestDB(t)
+	manager := strategy.NewManager(db, nil, nil, nil)
+	return NewServer(db, nil, manager, nil, nil), manager, db
+}
+
+// createMomentumConfig 1분봉 모멘텀 전략 설정 저장
+func createMomentumConfig(t *testing.T, db *gorm.DB, marketID string, window float64) *model.StrategyConfig {
+	t.Helper()
+	cfg := &model.StrategyConfig{
+		MarketID:     marketID,
+		StrategyName: strategy.MomentumStrategyName,
+		Timeframe:    "minutes/1",
+		Enabled:      true,
+		Parameters:   model.Parameters{"window": window},
+	}
+	if err := db.Create(cfg).Error; err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+// strategyMarkets 정렬한 전략 마켓 목록
+func strategyMarkets(m *strategy.Manager) []string {
+	markets := m.Markets()
+	sort.Strings(markets)
+	return markets
+}
+
+func TestReloadStrategiesAppliesDatabaseChanges(t *testing.T) {
+	s, manager, db := newStrategyServer(t)
+	btc := createMomentumConfig(t, db, "KRW-BTC", 5)
+	if err := manager.LoadStrategies(); err != nil {
+		t.Fatal(err)
+	}
+
+	createMomentumConfig(t, db, "KRW-ETH", 5)
+	if err := db.Model(btc).Update("enabled", false).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	w := serve(s, http.MethodPost, "/api/strategies/reload", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("상태 코드 = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+	var result strategy.ReloadResult
+	decodeJSON(t, w, &result)
+	if !reflect.DeepEqual(result.Started, []string{"KRW-ETH/" + strategy.MomentumStrategyName}) ||
+		!reflect.DeepEqual(result.Stopped, []string{"KRW-BTC/" + strategy.MomentumStrategyName}) {
+		t.Fatalf("다시 읽기 결과 = %+v", result)
+	}
+	if got := strategyMarkets(manager); !reflect.DeepEqual(got, []string{"KRW-ETH"}) {
+		t.Fatalf("전략 마켓 = %v, want [KRW-ETH]", got)
+	}
+}
+
+func TestReloadStrategiesRejectsInvalidConfig(t *testing.T) {
+	s, manager, db := newStrategyServer(t)
+	btc := createMomentumConfig(t, db, "KRW-BTC", 5)
+	if err := manager.LoadStrategies(); err != nil {
+		t.Fatal(err)
+	}
+
+	createMomentumConfig(t, db, "KRW-ETH", 5)
+	btc.Parameters = model.Parameters{"window": -1.0}
+	if err := db.Save(btc).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	w := serve(s, http.MethodPost, "/api/strategies/reload", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("상태 코드 = %d, want 400 (%s)", w.Code, w.Body.String())
+	}
+	var body struct {
+		Error  string   `json:"error"`
+		Failed []string `json:"failed"`
+	}
+	decodeJSON(t, w, &body)
+	if body.Error == "" || !reflect.DeepEqual(body.Failed, []string{"KRW-BTC/" + strategy.MomentumStrategyName}) {
+		t.Fatalf("응답 = %+v, want KRW-BTC 실패", body)
+	}
+	// 새 ETH 전략도 시작하지 않고 기존 전략만 유지한다
+	if got := strategyMarkets(manager); !reflect.DeepEqual(got, []string{"KRW-BTC"}) {
+		t.Fatalf("전략 마켓 = %v, want [KRW-BTC]", got)
+	}
+}
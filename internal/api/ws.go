@@ -0,0 +1,223 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+)
+
+// 실시간 이벤트 종류 (주문, 포지션 이벤트는 exchange.EventOrder, exchange.EventPosition)
+const (
+	EventSignal = "signal"
+	EventTicker = "ticker"
+)
+
+const (
+	wsClientBuffer = 256
+	wsWriteTimeout = 10 * time.Second
+	wsPongWait     = 60 * time.Second
+	wsPingInterval = wsPongWait * 9 / 10
+)
+
+// wsEvent 클라이언트로 보내는 이벤트
+type wsEvent struct {
+	Type string      `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+// Hub 대시보드 실시간 이벤트 전송기
+// 신호, 주문 상태 변경, 포지션 변경, 현재가를 연결된 모든 웹소켓 클라이언트에 보낸다.
+// 클라이언트마다 버퍼가 있으며, 버퍼가 가득 찬 느린 클라이언트는 기다리지 않고 연결을 끊는다.
+type Hub struct {
+	mu      sync.Mutex
+	clients map[*wsClient]bool
+	closed  bool
+	logger  *utils.Logger
+}
+
+// wsClient 웹소켓 클라이언트
+type wsClient struct {
+	conn *websocket.Conn
+	send chan []byte
+}
+
+// NewHub 새로운 실시간 이벤트 전송기 생성
+func NewHub() *Hub {
+	return &Hub{
+		clients: make(map[*wsClient]bool),
+		logger:  utils.NewLogger("ws"),
+	}
+}
+
+// Publish 모든 클라이언트에 이벤트 전송 (기다리지 않음, exchange.EventSink 구현)
+func (h *Hub) Publish(eventType string, data interface{}) {
+	message, err := json.Marshal(wsEvent{Type: eventType, Time: time.Now(), Data: data})
+	if err != nil {
+		h.logger.Error("실시간 이벤트 인코딩 실패:", eventType, err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for client := range h.clients {
+		select {
+		case client.send <- message:
+		default:
+			h.logger.Warn("실시간 이벤트를 받지 못하는 느린 클라이언트 연결 종료:", client.conn.RemoteAddr())
+			h.remove(client)
+		}
+	}
+}
+
+// RelaySignals 신호를 주문 실행기로 넘기면서 클라이언트에도 전송
+func (h *Hub) RelaySignals(ctx context.Context, in <-chan model.Signal, out chan<- model.Signal) {
+	relay(ctx, in, out, func(signal model.Signal) {
+		h.Publish(EventSignal, signal)
+	})
+}
+
+// RelayMarketData 시장 데이터를 전략 관리자로 넘기면서 현재가는 클라이언트에도 전송
+func (h *Hub) RelayMarketData(ctx context.Context, in <-chan exchange.MarketData, out chan<- exchange.MarketData) {
+	relay(ctx, in, out, func(data exchange.MarketData) {
+		if data.Type == exchange.DataTypeTicker {
+			h.Publish(EventTicker, data)
+		}
+	})
+}
+
+// relay in의 값을 out으로 그대로 넘기며 tap 호출 (ctx가 취소되면 종료)
+func relay[T any](ctx context.Context, in <-chan T, out chan<- T, tap func(T)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case v := <-in:
+			tap(v)
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// Close 모든 클라이언트 연결 종료
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for client := range h.clients {
+		h.remove(client)
+	}
+}
+
+// add 클라이언트 등록 (종료된 전송기면 false)
+func (h *Hub) add(client *wsClient) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return false
+	}
+	h.clients[client] = true
+	return true
+}
+
+// remove 클라이언트 제거 (호출하는 쪽에서 mu를 잡고 있어야 함)
+func (h *Hub) remove(client *wsClient) {
+	if !h.clients[client] {
+		return
+	}
+	delete(h.clients, client)
+	close(client.send)
+}
+
+// unregister 클라이언트 제거
+func (h *Hub) unregister(client *wsClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(client)
+}
+
+// wsUpgrader 웹소켓 업그레이더 (CORS 설정과 같이 모든 출처 허용, 인증은 토큰으로 확인)
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// serveWebSocket 웹소켓 연결 후 실시간 이벤트 전송
+func (s *Server) serveWebSocket(c *gin.Context) {
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// 업그레이드 실패 응답은 Upgrade가 이미 보냈다
+		s.logger.Error("웹소켓 업그레이드 실패:", err)
+		return
+	}
+
+	client := &wsClient{conn: conn, send: make(chan []byte, wsClientBuffer)}
+	if !s.hub.add(client) {
+		conn.Close()
+		return
+	}
+
+	go client.writeLoop()
+	client.readLoop()
+	s.hub.unregister(client)
+}
+
+// readLoop 클라이언트 메시지 읽기 (퐁 응답과 연결 종료 확인용, 받은 메시지는 무시)
+func (c *wsClient) readLoop() {
+	c.conn.SetReadLimit(512)
+	c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// writeLoop 이벤트와 핑 전송 (send가 닫히면 연결 종료)
+func (c *wsClient) writeLoop() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
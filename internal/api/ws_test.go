@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// dialHub 실시간 이벤트 전송기를 쓰는 API 서버에 웹소켓 연결 (등록될 때까지 기다림)
+func dialHub(t *testing.T, hub *Hub) *websocket.Conn {
+	t.Helper()
+	s, _ := newTestServer(t, nil, WithHub(hub))
+	server := httptest.NewServer(s.router)
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/api/ws", nil)
+	if err != nil {
+		t.Fatalf("웹소켓 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	deadline := time.Now().Add(time.Second)
+	for hubClients(hub) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("웹소켓 클라이언트가 등록되지 않음")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return conn
+}
+
+// hubClients 등록된 클라이언트 수
+func hubClients(hub *Hub) int {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	return len(hub.clients)
+}
+
+func TestWebSocketReceivesPublishedOrderEvent(t *testing.T) {
+	hub := NewHub()
+	conn := dialHub(t, hub)
+
+	hub.Publish(exchange.EventOrder, model.Order{MarketID: "KRW-BTC", OrderID: "order-1", Side: "BUY", Status: "DONE"})
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, message, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("이벤트 수신 실패: %v", err)
+	}
+	var event struct {
+		Type string      `json:"type"`
+		Data model.Order `json:"data"`
+	}
+	if err := json.Unmarshal(message, &event); err != nil {
+		t.Fatalf("이벤트 디코딩 실패: %v (%s)", err, message)
+	}
+	if event.Type != exchange.EventOrder || event.Data.OrderID != "order-1" || event.Data.Status != "DONE" {
+		t.Fatalf("이벤트 = %+v, want order-1 DONE 주문 이벤트", event)
+	}
+}
+
+func TestHubDropsSlowClient(t *testing.T) {
+	hub := NewHub()
+	conn := dialHub(t, hub)
+
+	// 버퍼가 없고 읽지도 않는 클라이언트는 첫 이벤트에서 바로 끊긴다
+	slow := &wsClient{conn: conn, send: make(chan []byte)}
+	if !hub.add(slow) {
+		t.Fatal("클라이언트 등록 실패")
+	}
+	hub.Publish(EventTicker, exchange.MarketData{MarketID: "KRW-BTC", TradePrice: 100})
+
+	hub.mu.Lock()
+	dropped := !hub.clients[slow]
+	hub.mu.Unlock()
+	if !dropped {
+		t.Fatal("느린 클라이언트가 끊기지 않음")
+	}
+	if _, ok := <-slow.send; ok {
+		t.Fatal("끊긴 클라이언트의 전송 채널이 닫히지 않음")
+	}
+
+	// 제때 읽는 클라이언트는 그대로 이벤트를 받는다
+	if n := hubClients(hub); n != 1 {
+		t.Fatalf("남은 클라이언트 %d개, want 1", n)
+	}
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, _, err := conn.ReadMessage(); err != nil {
+		t.Fatalf("이벤트 수신 실패: %v", err)
+	}
+}
+
+func TestRelaySignalsPublishesAndForwards(t *testing.T) {
+	hub := NewHub()
+	conn := dialHub(t, hub)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	in, out := make(chan model.Signal), make(chan model.Signal, 1)
+	go hub.RelaySignals(ctx, in, out)
+
+	in <- model.Signal{MarketID: "KRW-BTC", SignalType: "BUY"}
+	select {
+	case signal := <-out:
+		if signal.MarketID != "KRW-BTC" {
+			t.Fatalf("전달된 신호 = %+v, want KRW-BTC", signal)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("신호가 주문 실행기로 전달되지 않음")
+	}
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, message, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("이벤트 수신 실패: %v", err)
+	}
+	if !strings.Contains(string(message), `"type":"signal"`) {
+		t.Fatalf("이벤트 = %s, want signal 이벤트", message)
+	}
+}
@@ -0,0 +1,316 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config 봇 전체 설정
+type Config struct {
+	Upbit     UpbitConfig     `yaml:"upbit"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Server    ServerConfig    `yaml:"server"`
+	Logging   LoggingConfig   `yaml:"logging"`
+	Trading   TradingConfig   `yaml:"trading"`
+	Risk      RiskConfig      `yaml:"risk"`
+	Collector CollectorConfig `yaml:"collector"`
+	Notify    NotifyConfig    `yaml:"notify"`
+}
+
+// UpbitConfig 업비트 API 설정
+type UpbitConfig struct {
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	BaseURL   string `yaml:"base_url"`    // 사용하지 않음 (설정하면 검증 오류)
+	WSBaseURL string `yaml:"ws_base_url"` // 사용하지 않음 (설정하면 검증 오류)
+
+	WSMarketsPerConnection    int    `yaml:"ws_markets_per_connection"`    // 웹소켓 연결당 최대 구독 마켓 수
+	WSPongTimeoutSeconds      int    `yaml:"ws_pong_timeout_seconds"`      // 핑 후 퐁 응답 제한 시간 (초과 시 재연결)
+	WSSubscribeTimeoutSeconds int    `yaml:"ws_subscribe_timeout_seconds"` // 구독 후 첫 데이터 수신 제한 시간 (초과 시 재연결)
+	WSFormat                  string `yaml:"ws_format"`                    // 웹소켓 메시지 형식 (DEFAULT, SIMPLE)
+
+	OrderRequestsPerSecond     float64 `yaml:"order_requests_per_second"`     // 주문 API 초당 요청 한도
+	QuotationRequestsPerSecond float64 `yaml:"quotation_requests_per_second"` // 시세 API 초당 요청 한도
+	MaxRetries                 int     `yaml:"max_retries"`                   // 일시적 REST 오류 재시도 횟수
+	RetryBackoffMillis         int     `yaml:"retry_backoff_millis"`          // 첫 재시도 대기 시간 (이후 두 배씩 증가)
+	ListPageSize               int     `yaml:"list_page_size"`                // 주문 목록 조회 페이지 크기 (최대 100)
+	DisableCandleChunking      bool    `yaml:"disable_candle_chunking"`       // 200개 초과 캔들 요청을 나눠 조회하지 않고 200개로 제한
+	DisableOrderPriority       bool    `yaml:"disable_order_priority"`        // 주문 그룹 한도에서 주문 실행 요청을 조회 요청보다 우선하지 않음
+}
+
+// NotifyConfig 알림 설정
+type NotifyConfig struct {
+	BatchSeconds int            `yaml:"batch_seconds"` // 알림을 모아 한 번에 보내는 주기 (초)
+	Telegram     TelegramConfig `yaml:"telegram"`
+	Discord      DiscordConfig  `yaml:"discord"`
+}
+
+// TelegramConfig 텔레그램 봇 알림 설정
+type TelegramConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Token   string `yaml:"token"`   // 봇 토큰
+	ChatID  string `yaml:"chat_id"` // 알림을 받을 대화 ID
+}
+
+// DiscordConfig 디스코드 웹훅 알림 설정
+type DiscordConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	WebhookURL string `yaml:"webhook_url"`
+}
+
+// DatabaseConfig 데이터베이스 설정
+type DatabaseConfig struct {
+	Driver   string `yaml:"driver"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	DBName   string `yaml:"dbname"`
+	SSLMode  string `yaml:"sslmode"`
+	DSN      string `yaml:"dsn"` // postgres 접속 문자열 (있으면 host 등 개별 항목 대신 사용)
+
+	CandleBatchSize int `yaml:"candle_batch_size"` // 캔들 일괄 저장 배치 크기 (0이면 500)
+}
+
+// ServerConfig API 서버 설정
+type ServerConfig struct {
+	Host string     `yaml:"host"`
+	Port string     `yaml:"port"`
+	Auth AuthConfig `yaml:"auth"`
+
+	Metrics bool `yaml:"metrics"` // Prometheus 지표 엔드포인트(/metrics) 제공 여부
+}
+
+// AuthConfig API 인증 설정
+type AuthConfig struct {
+	Secret         string `yaml:"secret"`           // 로그인 비밀 키이자 토큰 서명 키 (비어 있으면 인증하지 않음)
+	TokenMinutes   int    `yaml:"token_minutes"`    // 토큰 유효 시간 (분, 기본 30)
+	PublicReadOnly bool   `yaml:"public_read_only"` // 조회 엔드포인트를 인증 없이 허용
+}
+
+// LoggingConfig 로깅 설정
+type LoggingConfig struct {
+	Format     string `yaml:"format"`      // 출력 형식 (text, json)
+	Level      string `yaml:"level"`       // 최소 기록 수준 (debug, info, warn, error)
+	File       string `yaml:"file"`        // 로그 파일 경로 (비우면 표준 출력에만 기록)
+	MaxSize    int    `yaml:"max_size"`    // 파일 교체 크기 (MB)
+	MaxBackups int    `yaml:"max_backups"` // 보관할 이전 파일 수
+	MaxAge     int    `yaml:"max_age"`     // 이전 파일 보관 기간 (일)
+	Compress   bool   `yaml:"compress"`    // 이전 파일 gzip 압축
+}
+
+// TradingConfig 트레이딩 설정
+type TradingConfig struct {
+	Mode                string  `yaml:"mode"`             // 거래 모드 (live: 실거래, paper: 실제 시세로 가상 계좌 모의 거래)
+	DefaultStrategy     string  `yaml:"default_strategy"` // 사용하지 않음 (전략은 strategy_configs 테이블, 설정하면 검증 오류)
+	DefaultProfitTarget float64 `yaml:"default_profit_target"`
+	DefaultStopLoss     float64 `yaml:"default_stop_loss"`
+	MaxPositions        int     `yaml:"max_positions"` // 사용하지 않음 (risk.max_concurrent_positions로 대체, 설정하면 검증 오류)
+	MaxPositionSize     float64 `yaml:"max_position_size"`
+	MaxDailyLoss        float64 `yaml:"max_daily_loss"`  // 사용하지 않음 (risk.daily_loss_limit로 대체, 설정하면 검증 오류)
+	FeeRate             float64 `yaml:"fee_rate"`        // 거래 수수료율 (%)
+	PersistSignals      bool    `yaml:"persist_signals"` // 신호와 함께 지표 스냅샷 저장 여부 (신호는 항상 저장)
+
+	EntryOrderType            string  `yaml:"entry_order_type"`             // 신호 주문 유형 (limit, market)
+	PriceOutOfRangeAction     string  `yaml:"price_out_of_range_action"`    // 가격 범위 초과 거부 시 처리 (reprice, abandon)
+	MaxRepriceAttempts        int     `yaml:"max_reprice_attempts"`         // 최대 재호가 횟수
+	SinglePendingEntry        bool    `yaml:"single_pending_entry"`         // 마켓당 미체결 매수 주문을 하나로 제한
+	MinConfidence             float64 `yaml:"min_confidence"`               // 진입 신호 최소 신뢰도 (0이면 검사 안 함)
+	ConfidenceHalfLifeSeconds int     `yaml:"confidence_half_life_seconds"` // 신호 신뢰도 반감기 (0이면 감쇠 없음)
+	EnforceMinNotional        bool    `yaml:"enforce_min_notional"`         // 정밀도 반올림 후 최소 주문 금액 재확인
+	MaxPriceAgeSeconds        int     `yaml:"max_price_age_seconds"`        // 주문 가격 시세의 최대 허용 나이 (초과 시 재조회, 0이면 검사 안 함)
+	SignalConflictPolicy      string  `yaml:"signal_conflict_policy"`       // 같은 마켓 전략 간 반대 신호 처리 (none, confidence, net)
+	RecordOrderEvents         bool    `yaml:"record_order_events"`          // 주문 상태 변경을 이벤트 이력으로 저장
+	CancelOrdersOnShutdown    bool    `yaml:"cancel_orders_on_shutdown"`    // 종료 시 거래소 미체결 주문 전체 취소
+	CheckOrderChance          bool    `yaml:"check_order_chance"`           // 주문 전 마켓별 최소 주문 금액 확인 및 실제 수수료율 사용
+	FlattenPartialEntries     bool    `yaml:"flatten_partial_entries"`      // 부분 체결 후 취소된 매수의 체결분을 보유하지 않고 시장가 매도
+	MaxOrdersPerSignal        int     `yaml:"max_orders_per_signal"`        // 신호 하나로 낼 수 있는 같은 방향 최대 주문 수 (기본 1, 초과 시 차단)
+	OrderPollSeconds          int     `yaml:"order_poll_seconds"`           // 미체결 주문 상태 조회 주기 (초, 기본 2)
+	OrderTimeoutSeconds       int     `yaml:"order_timeout_seconds"`        // 지정가 주문 미체결 허용 시간 (초과 시 취소, 초, 0이면 취소 안 함)
+
+	OrderbookSupport OrderbookSupportConfig `yaml:"orderbook_support"`
+	SellIntoStrength SellIntoStrengthConfig `yaml:"sell_into_strength"`
+	DrawdownSizing   DrawdownSizingConfig   `yaml:"drawdown_sizing"`
+	VolatilitySizing VolatilitySizingConfig `yaml:"volatility_sizing"`
+	ShutdownSnapshot ShutdownSnapshotConfig `yaml:"shutdown_snapshot"`
+	Approval         ApprovalConfig         `yaml:"approval"`
+	Paper            PaperConfig            `yaml:"paper"`
+}
+
+// PaperConfig 모의 거래 설정
+type PaperConfig struct {
+	InitialBalance float64 `yaml:"initial_balance"` // 가상 계좌 시작 원화 잔고
+}
+
+// OrderbookSupportConfig 돌파 진입 호가 지지 확인 설정
+type OrderbookSupportConfig struct {
+	Enabled      bool    `yaml:"enabled"`
+	RangePercent float64 `yaml:"range_percent"` // 최우선 매수호가 아래 확인 범위 (%)
+	MinBidDepth  float64 `yaml:"min_bid_depth"` // 범위 내 최소 매수 잔량 (KRW)
+}
+
+// SellIntoStrengthConfig 거래량 급증 급등 캔들에서의 부분 익절 설정
+type SellIntoStrengthConfig struct {
+	Enabled        bool    `yaml:"enabled"`
+	Timeframe      string  `yaml:"timeframe"`        // 판단 타임프레임 (비어 있으면 전략의 기본 타임프레임)
+	VolumePeriod   int     `yaml:"volume_period"`    // 평균 거래량 산정 캔들 수
+	VolumeMultiple float64 `yaml:"volume_multiple"`  // 평균 대비 거래량 배수 기준
+	MinPriceChange float64 `yaml:"min_price_change"` // 캔들 시가 대비 최소 상승률 (%)
+	ExitFraction   float64 `yaml:"exit_fraction"`    // 매도할 보유 수량 비율 (0~1)
+}
+
+// DrawdownSizingConfig 전략 낙폭에 따른 포지션 크기 조정 설정
+type DrawdownSizingConfig struct {
+	Enabled         bool                 `yaml:"enabled"`
+	LookbackPeriods int                  `yaml:"lookback_periods"` // 낙폭 계산에 사용할 최근 성과 기록 수
+	Steps           []DrawdownSizingStep `yaml:"steps"`            // 낙폭 구간별 포지션 크기 배율
+}
+
+// DrawdownSizingStep 낙폭 구간 배율
+type DrawdownSizingStep struct {
+	Drawdown float64 `yaml:"drawdown"` // 구간 시작 낙폭 (%)
+	Scale    float64 `yaml:"scale"`    // 포지션 크기 배율 (0~1)
+}
+
+// VolatilitySizingConfig 변동성 목표에 맞춘 포지션 크기 조정 설정
+type VolatilitySizingConfig struct {
+	Enabled   bool    `yaml:"enabled"`
+	VolTarget float64 `yaml:"vol_target"` // 목표 변동성 (캔들 수익률 표준편차, %)
+	Timeframe string  `yaml:"timeframe"`  // 변동성 계산 타임프레임
+	Period    int     `yaml:"period"`     // 변동성 계산 캔들 수
+	MaxScale  float64 `yaml:"max_scale"`  // 최대 포지션 크기 배율 (변동성이 낮은 마켓의 확대 한도)
+}
+
+// ShutdownSnapshotConfig 종료 시 포지션/주문 스냅샷 설정
+type ShutdownSnapshotConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	OnPanic bool   `yaml:"on_panic"` // 주문 실행기 패닉 시에도 저장
+	Dir     string `yaml:"dir"`      // 스냅샷 저장 디렉터리
+}
+
+// ApprovalConfig 외부 웹훅 주문 승인 설정
+type ApprovalConfig struct {
+	Enabled        bool   `yaml:"enabled"`
+	WebhookURL     string `yaml:"webhook_url"`     // 주문 승인 요청을 보낼 주소
+	TimeoutSeconds int    `yaml:"timeout_seconds"` // 승인 대기 시간 (초과 시 주문 폐기)
+}
+
+// RiskConfig 위험 관리 설정
+type RiskConfig struct {
+	MaxMarketAllocation     float64              `yaml:"max_market_allocation"`      // 단일 마켓 최대 비중 (총 자산의 %, 0이면 비활성)
+	MaxEquityDrawdown       float64              `yaml:"max_equity_drawdown"`        // 자산 고점 대비 최대 낙폭 (%, 초과 시 전량 청산 후 중지)
+	KeepPositionsOnDrawdown bool                 `yaml:"keep_positions_on_drawdown"` // 낙폭 한도 초과 시 미체결 주문 취소와 진입 중지만 하고 포지션은 유지
+	EquityPeakWindowHours   int                  `yaml:"equity_peak_window_hours"`   // 고점 산정 기간 (0이면 전체 기간)
+	EquitySnapshotMinutes   int                  `yaml:"equity_snapshot_minutes"`    // 자산 스냅샷 주기
+	DustThreshold           float64              `yaml:"dust_threshold"`             // 먼지 잔고로 보고 무시할 코인 평가액 기준 (KRW)
+	RiskPerTrade            float64              `yaml:"risk_per_trade"`             // 거래당 위험 (총 자산 대비 %, 진입가에서 손절가까지의 손실 기준, 0이면 max_position_size 비율 사용)
+	MaxConcurrentPositions  int                  `yaml:"max_concurrent_positions"`   // 동시에 열 수 있는 최대 포지션 수 (0이면 제한 없음, 기존 포지션 추가 매수는 제외)
+	Reentry                 ReentryConfig        `yaml:"reentry"`
+	APIErrorPause           APIErrorPauseConfig  `yaml:"api_error_pause"`
+	Blackouts               []BlackoutWindow     `yaml:"blackouts"`
+	RecordDecisions         bool                 `yaml:"record_decisions"` // 진입/비중/낙폭 판단과 입력을 risk_decisions 테이블에 저장
+	TrailingStop            TrailingStopConfig   `yaml:"trailing_stop"`
+	DailyLossLimit          DailyLossLimitConfig `yaml:"daily_loss_limit"`
+}
+
+// DailyLossLimitConfig 일일 실현 손실 한도 (도달 시 KST 자정까지 신규 진입 차단, 청산은 허용)
+type DailyLossLimitConfig struct {
+	Percent float64 `yaml:"percent"` // 당일 시작 자산 대비 손실 한도 (%, 0이면 비활성)
+	Amount  float64 `yaml:"amount"`  // 손실 한도 금액 (KRW, 0이면 비활성, 비율과 함께 설정하면 작은 쪽 적용)
+}
+
+// TrailingStopConfig 추적 손절 설정
+type TrailingStopConfig struct {
+	Enabled        bool    `yaml:"enabled"`
+	Percent        float64 `yaml:"percent"`         // 최고가 대비 손절 폭 (%)
+	TriggerPercent float64 `yaml:"trigger_percent"` // 최고가가 진입가 대비 이 수익률(%)을 넘으면 추적 시작 (0이면 진입 직후부터)
+}
+
+// ReentryConfig 손절 후 동일 마켓 재진입 설정
+type ReentryConfig struct {
+	Enabled                 bool   `yaml:"enabled"`
+	CooldownMinutes         int    `yaml:"cooldown_minutes"`          // 손절 후 재진입 금지 시간
+	ExtendedCooldownMinutes int    `yaml:"extended_cooldown_minutes"` // 상위 추세 이탈 시 연장 시간
+	TrendTimeframe          string `yaml:"trend_timeframe"`           // 추세 판단 타임프레임 (예: minutes/60)
+	TrendPeriod             int    `yaml:"trend_period"`              // 추세 판단 이동평균 기간
+}
+
+// BlackoutWindow 신규 진입 금지 구간
+type BlackoutWindow struct {
+	Start   string   `yaml:"start"`   // 시작 시각 (RFC3339)
+	End     string   `yaml:"end"`     // 종료 시각 (RFC3339)
+	Reason  string   `yaml:"reason"`  // 사유 (예: FOMC, 업비트 점검)
+	Markets []string `yaml:"markets"` // 적용 마켓 (비어 있으면 전체)
+}
+
+// APIErrorPauseConfig API 오류율 기반 자동 거래 중지 설정
+type APIErrorPauseConfig struct {
+	Enabled         bool    `yaml:"enabled"`
+	MaxErrorRate    float64 `yaml:"max_error_rate"`    // 거래 중지 기준 오류율 (%)
+	ResumeErrorRate float64 `yaml:"resume_error_rate"` // 거래 재개 기준 오류율 (%, 0이면 중지 기준의 절반)
+	MinRequests     int     `yaml:"min_requests"`      // 판단에 필요한 최소 호출 수
+	WindowSeconds   int     `yaml:"window_seconds"`    // 오류율 집계 구간
+}
+
+// CollectorConfig 시장 데이터 수집 설정
+type CollectorConfig struct {
+	Markets            []string           `yaml:"markets"`             // 수집 대상 마켓 (비어 있으면 전체 KRW 마켓)
+	WebSocket          bool               `yaml:"websocket"`           // 웹소켓 실시간 현재가 수신 여부
+	WebSocketOrderbook bool               `yaml:"websocket_orderbook"` // 웹소켓 실시간 호가 수신 여부
+	WebSocketTrade     bool               `yaml:"websocket_trade"`     // 웹소켓 실시간 체결 수신 여부
+	PollIntervals      map[string]string  `yaml:"poll_intervals"`      // 데이터 유형(ticker, 캔들 타임프레임)별 폴링 주기
+	Backfill           BackfillConfig     `yaml:"backfill"`
+	GapCheck           GapCheckConfig     `yaml:"gap_check"`
+	Adaptive           AdaptivePollConfig `yaml:"adaptive"`
+
+	DelistAfterMisses int `yaml:"delist_after_misses"` // 현재가가 연속으로 비어 있으면 상장 폐지로 보는 횟수
+}
+
+// BackfillConfig 과거 캔들 백필 설정
+type BackfillConfig struct {
+	Enabled           bool     `yaml:"enabled"`
+	Timeframes        []string `yaml:"timeframes"`          // 백필 대상 타임프레임
+	Days              int      `yaml:"days"`                // 백필 기간 (일)
+	MaxRetries        int      `yaml:"max_retries"`         // 페이지 조회 실패 시 재시도 횟수
+	RetryDelaySeconds int      `yaml:"retry_delay_seconds"` // 재시도 대기 시간
+}
+
+// GapCheckConfig 저장된 캔들 누락 검사 설정
+type GapCheckConfig struct {
+	Enabled         bool `yaml:"enabled"`
+	IntervalMinutes int  `yaml:"interval_minutes"` // 검사 주기
+	LookbackHours   int  `yaml:"lookback_hours"`   // 검사 구간
+	Backfill        bool `yaml:"backfill"`         // 누락 구간 자동 백필 여부
+}
+
+// AdaptivePollConfig 마켓 활동에 따른 적응형 폴링 설정
+// 열린 포지션이나 최근 신호가 있는 활성 마켓은 poll_intervals 주기로, 나머지 유휴 마켓은 cold_intervals 주기로 조회한다.
+type AdaptivePollConfig struct {
+	Enabled             bool              `yaml:"enabled"`
+	ColdIntervals       map[string]string `yaml:"cold_intervals"`        // 데이터 유형별 유휴 마켓 폴링 주기 (없으면 활성 주기의 5배)
+	SignalWindowMinutes int               `yaml:"signal_window_minutes"` // 이 기간 안에 신호가 있으면 활성 마켓 (기본 60분)
+}
+
+// LoadConfig 설정 파일 로드
+// 파일의 ${NAME} 참조는 환경 변수 값으로 치환하고, UPBIT_ACCESS_KEY 등 덮어쓰기 환경 변수가 있으면 파일 값보다 우선한다.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("설정 파일 읽기 실패: %w", err)
+	}
+
+	data, err = expandEnv(data, os.LookupEnv)
+	if err != nil {
+		return nil, fmt.Errorf("설정 파일 환경 변수 치환 실패: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("설정 파일 파싱 실패: %w", err)
+	}
+
+	applyEnvOverrides(&cfg, os.LookupEnv)
+	return &cfg, nil
+}
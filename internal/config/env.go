@@ -0,0 +1,94 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// envReference 설정 파일의 환경 변수 참조 (${NAME} 또는 기본값이 있는 ${NAME:-default})
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// envOverrides 설정 파일 값보다 우선하는 환경 변수
+// 비밀 값을 파일에 두지 않고 운영 환경에서 주입할 때 쓴다.
+var envOverrides = []struct {
+	name  string
+	apply func(cfg *Config, value string)
+}{
+	{"UPBIT_ACCESS_KEY", func(cfg *Config, value string) { cfg.Upbit.AccessKey = value }},
+	{"UPBIT_SECRET_KEY", func(cfg *Config, value string) { cfg.Upbit.SecretKey = value }},
+	{"DATABASE_DSN", func(cfg *Config, value string) { cfg.Database.DSN = value }},
+	{"DATABASE_PASSWORD", func(cfg *Config, value string) { cfg.Database.Password = value }},
+	{"API_AUTH_SECRET", func(cfg *Config, value string) { cfg.Server.Auth.Secret = value }},
+}
+
+// expandEnv 설정 파일의 ${NAME} 참조를 환경 변수 값으로 치환
+// ${NAME:-default}는 변수가 없거나 빈 값이면 default를 쓴다.
+// 기본값 없이 정의되지 않은 환경 변수를 참조하면 빈 값으로 두지 않고 참조한 이름을 모두 모아 오류를 반환한다.
+// 빈 문자열로 정의된 변수는 그대로 빈 값이 된다.
+// 주석(#)은 치환하지 않으므로 주석 처리한 설정의 참조는 오류가 되지 않는다.
+func expandEnv(data []byte, lookup func(string) (string, bool)) ([]byte, error) {
+	var missing []string
+	seen := make(map[string]bool)
+
+	expand := func(ref []byte) []byte {
+		match := envReference.FindSubmatch(ref)
+		name := string(match[1])
+		value, ok := lookup(name)
+		if match[2] != nil {
+			if !ok || value == "" {
+				return match[3]
+			}
+			return []byte(value)
+		}
+		if !ok {
+			if !seen[name] {
+				seen[name] = true
+				missing = append(missing, name)
+			}
+			return ref
+		}
+		return []byte(value)
+	}
+
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	for i, line := range lines {
+		code := commentStart(line)
+		expanded := envReference.ReplaceAllFunc(line[:code], expand)
+		lines[i] = append(expanded, line[code:]...)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("정의되지 않은 환경 변수: %s", strings.Join(missing, ", "))
+	}
+	return bytes.Join(lines, nil), nil
+}
+
+// commentStart 줄에서 YAML 주석이 시작하는 위치 (주석이 없으면 줄 길이)
+// 줄 맨 앞이나 공백 뒤의 #만 주석이며, 따옴표 안의 #은 값으로 본다.
+func commentStart(line []byte) int {
+	var quote byte
+	for i, c := range line {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return i
+		}
+	}
+	return len(line)
+}
+
+// applyEnvOverrides 값이 있는 덮어쓰기 환경 변수를 설정에 반영
+func applyEnvOverrides(cfg *Config, lookup func(string) (string, bool)) {
+	for _, override := range envOverrides {
+		if value, ok := lookup(override.name); ok && value != "" {
+			override.apply(cfg, value)
+		}
+	}
+}
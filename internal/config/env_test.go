@@ -0,0 +1,119 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// mapLookup 맵에서 환경 변수를 찾는 조회 함수
+func mapLookup(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+// writeConfig 임시 설정 파일 작성
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestExpandEnvSubstitutesReferences(t *testing.T) {
+	data := []byte("host: ${DB_HOST}\nport: ${DB_PORT:-5432}\nuser: ${DB_USER:-bot}\npassword: \"${DB_PASSWORD}\"\n")
+	got, err := expandEnv(data, mapLookup(map[string]string{"DB_HOST": "db.internal", "DB_USER": "", "DB_PASSWORD": ""}))
+	if err != nil {
+		t.Fatalf("expandEnv 오류: %v", err)
+	}
+	// 기본값은 변수가 없거나 빈 값일 때 쓰고, 기본값이 없는 빈 변수는 빈 값으로 둔다
+	want := "host: db.internal\nport: 5432\nuser: bot\npassword: \"\"\n"
+	if string(got) != want {
+		t.Fatalf("expandEnv = %q, want %q", got, want)
+	}
+
+	got, err = expandEnv([]byte("port: ${DB_PORT:-5432}\n"), mapLookup(map[string]string{"DB_PORT": "6432"}))
+	if err != nil || string(got) != "port: 6432\n" {
+		t.Fatalf("expandEnv = %q, %v, want 정의된 값 6432", got, err)
+	}
+}
+
+func TestExpandEnvReportsMissingVariables(t *testing.T) {
+	data := []byte("a: ${MISSING_A}\nb: ${MISSING_B}\nc: ${MISSING_A}\nd: ${DEFINED}\n")
+	_, err := expandEnv(data, mapLookup(map[string]string{"DEFINED": "x"}))
+	if err == nil {
+		t.Fatal("정의되지 않은 변수를 참조했는데 오류 없음")
+	}
+	if err.Error() != "정의되지 않은 환경 변수: MISSING_A, MISSING_B" {
+		t.Fatalf("오류 = %q, want MISSING_A, MISSING_B", err)
+	}
+}
+
+func TestExpandEnvIgnoresComments(t *testing.T) {
+	data := []byte("# access_key: ${OLD_ACCESS_KEY}\n  # secret: ${OLD_SECRET}\nkey: ${KEY} # 예전 값 ${OLD_KEY}\nurl: \"http://host/#${KEY}\"\ntag: a#${KEY}\n")
+	got, err := expandEnv(data, mapLookup(map[string]string{"KEY": "k"}))
+	if err != nil {
+		t.Fatalf("주석 안의 참조로 오류: %v", err)
+	}
+	want := "# access_key: ${OLD_ACCESS_KEY}\n  # secret: ${OLD_SECRET}\nkey: k # 예전 값 ${OLD_KEY}\nurl: \"http://host/#k\"\ntag: a#k\n"
+	if string(got) != want {
+		t.Fatalf("expandEnv = %q, want %q", got, want)
+	}
+}
+
+func TestLoadConfigEnvOverridesFileValues(t *testing.T) {
+	path := writeConfig(t, strings.Join([]string{
+		"upbit:",
+		"  access_key: file-access",
+		"  secret_key: ${TEST_UPBIT_SECRET}",
+		"database:",
+		"  dsn: postgres://file",
+		"  host: ${TEST_DB_HOST:-localhost}",
+		"server:",
+		"  auth:",
+		"    secret: file-auth",
+		"",
+	}, "\n"))
+
+	t.Setenv("TEST_UPBIT_SECRET", "from-reference")
+	t.Setenv("UPBIT_ACCESS_KEY", "env-access")
+	t.Setenv("UPBIT_SECRET_KEY", "")
+	t.Setenv("DATABASE_DSN", "postgres://env")
+	t.Setenv("API_AUTH_SECRET", "env-auth")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig 오류: %v", err)
+	}
+	if cfg.Upbit.AccessKey != "env-access" || cfg.Database.DSN != "postgres://env" || cfg.Server.Auth.Secret != "env-auth" {
+		t.Fatalf("덮어쓰기 결과 = %q %q %q, want 환경 변수 값", cfg.Upbit.AccessKey, cfg.Database.DSN, cfg.Server.Auth.Secret)
+	}
+	// 빈 덮어쓰기 변수는 파일 값을 지우지 않는다
+	if cfg.Upbit.SecretKey != "from-reference" {
+		t.Fatalf("secret_key = %q, want from-reference", cfg.Upbit.SecretKey)
+	}
+	if cfg.Database.Host != "localhost" {
+		t.Fatalf("database.host = %q, want 기본값 localhost", cfg.Database.Host)
+	}
+}
+
+func TestLoadConfigFailsOnMissingVariable(t *testing.T) {
+	path := writeConfig(t, "upbit:\n  access_key: ${TEST_UNSET_ACCESS_KEY}\n")
+	os.Unsetenv("TEST_UNSET_ACCESS_KEY")
+
+	_, err := LoadConfig(path)
+	if err == nil || !strings.Contains(err.Error(), "TEST_UNSET_ACCESS_KEY") {
+		t.Fatalf("LoadConfig 오류 = %v, want TEST_UNSET_ACCESS_KEY 누락", err)
+	}
+}
+
+func TestExampleConfigLoads(t *testing.T) {
+	if _, err := LoadConfig(filepath.Join("..", "..", "configs", "config.example.yaml")); err != nil {
+		t.Fatalf("예제 설정 로드 실패: %v", err)
+	}
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ValidationError 설정 검증 오류 (발견한 문제 전체)
+type ValidationError struct {
+	Problems []string
+}
+
+// Error 문제 목록을 한 줄에 하나씩 나열한 메시지
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("설정 오류 %d건:\n  - %s", len(e.Problems), strings.Join(e.Problems, "\n  - "))
+}
+
+// validator 설정 문제 수집기
+type validator struct {
+	problems []string
+}
+
+// addf 문제 추가 (field는 YAML 경로)
+func (v *validator) addf(field, format string, args ...interface{}) {
+	v.problems = append(v.problems, field+": "+fmt.Sprintf(format, args...))
+}
+
+// required 빈 문자열이면 문제 추가
+func (v *validator) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.addf(field, "값이 필요합니다")
+	}
+}
+
+// percent 0~max(%) 범위를 벗어나면 문제 추가
+func (v *validator) percent(field string, value, max float64) {
+	if value < 0 || value > max {
+		v.addf(field, "0~%g 사이여야 합니다 (현재 %g)", max, value)
+	}
+}
+
+// nonNegative 음수면 문제 추가
+func (v *validator) nonNegative(field string, value float64) {
+	if value < 0 {
+		v.addf(field, "0 이상이어야 합니다 (현재 %g)", value)
+	}
+}
+
+// removed 더 이상 쓰지 않는 설정이 있으면 안내(hint)와 함께 문제 추가
+// 조용히 무시하면 설정한 값이 적용된다고 오해하므로 시작을 막는다.
+func (v *validator) removed(field string, set bool, hint string) {
+	if set {
+		v.addf(field, "더 이상 사용하지 않는 설정입니다 (%s)", hint)
+	}
+}
+
+// oneOf 허용 값이 아니면 문제 추가 (빈 값은 기본값으로 허용)
+func (v *validator) oneOf(field, value string, allowed ...string) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.addf(field, "%s 중 하나여야 합니다 (현재 %q)", strings.Join(allowed, ", "), value)
+}
+
+// port 1~65535 숫자가 아니면 문제 추가
+func (v *validator) port(field, value string) {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || n < 1 || n > 65535 {
+		v.addf(field, "1~65535 사이의 숫자여야 합니다 (현재 %q)", value)
+	}
+}
+
+// Validate 필수 값과 값 범위 검증
+// 첫 문제에서 멈추지 않고 모든 문제를 모아 *ValidationError 하나로 반환한다.
+// 전략 설정은 데이터베이스(strategy_configs)에 있으므로 여기서는 확인하지 않으며, 어디서도 읽지 않는 설정은 값이 있으면 오류로 알린다.
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	v.oneOf("trading.mode", c.Trading.Mode, "live", "paper")
+	// 모의 거래는 공개 시세 API만 쓰므로 API 키가 없어도 된다
+	if c.Trading.Mode != "paper" {
+		v.required("upbit.access_key", c.Upbit.AccessKey)
+		v.required("upbit.secret_key", c.Upbit.SecretKey)
+	}
+	v.removed("upbit.base_url", c.Upbit.BaseURL != "", "업비트 REST 주소는 고정입니다")
+	v.removed("upbit.ws_base_url", c.Upbit.WSBaseURL != "", "업비트 웹소켓 주소는 고정입니다")
+
+	v.port("server.port", c.Server.Port)
+	v.oneOf("logging.format", c.Logging.Format, "text", "json")
+	v.oneOf("logging.level", c.Logging.Level, "debug", "info", "warn", "error")
+	v.nonNegative("logging.max_size", float64(c.Logging.MaxSize))
+	v.nonNegative("logging.max_backups", float64(c.Logging.MaxBackups))
+	v.nonNegative("logging.max_age", float64(c.Logging.MaxAge))
+
+	c.Database.validate(v)
+
+	v.removed("trading.default_strategy", c.Trading.DefaultStrategy != "", "전략은 strategy_configs 테이블에서 마켓별로 설정합니다")
+	v.oneOf("trading.entry_order_type", c.Trading.EntryOrderType, "limit", "market")
+	v.percent("trading.fee_rate", c.Trading.FeeRate, 5)
+	if c.Trading.MaxPositionSize <= 0 || c.Trading.MaxPositionSize > 100 {
+		v.addf("trading.max_position_size", "0보다 크고 100 이하여야 합니다 (현재 %g)", c.Trading.MaxPositionSize)
+	}
+	v.removed("trading.max_daily_loss", c.Trading.MaxDailyLoss != 0, "risk.daily_loss_limit.percent를 사용하세요")
+	v.percent("trading.default_stop_loss", c.Trading.DefaultStopLoss, 100)
+	v.nonNegative("trading.default_profit_target", c.Trading.DefaultProfitTarget)
+	v.removed("trading.max_positions", c.Trading.MaxPositions != 0, "risk.max_concurrent_positions를 사용하세요")
+	v.nonNegative("trading.order_timeout_seconds", float64(c.Trading.OrderTimeoutSeconds))
+
+	v.percent("risk.max_market_allocation", c.Risk.MaxMarketAllocation, 100)
+	v.percent("risk.max_equity_drawdown", c.Risk.MaxEquityDrawdown, 100)
+	v.percent("risk.risk_per_trade", c.Risk.RiskPerTrade, 100)
+	v.percent("risk.daily_loss_limit.percent", c.Risk.DailyLossLimit.Percent, 100)
+	v.nonNegative("risk.daily_loss_limit.amount", c.Risk.DailyLossLimit.Amount)
+	v.nonNegative("risk.max_concurrent_positions", float64(c.Risk.MaxConcurrentPositions))
+	if c.Risk.TrailingStop.Enabled && (c.Risk.TrailingStop.Percent <= 0 || c.Risk.TrailingStop.Percent >= 100) {
+		v.addf("risk.trailing_stop.percent", "0보다 크고 100보다 작아야 합니다 (현재 %g)", c.Risk.TrailingStop.Percent)
+	}
+
+	if c.Notify.Telegram.Enabled {
+		v.required("notify.telegram.token", c.Notify.Telegram.Token)
+		v.required("notify.telegram.chat_id", c.Notify.Telegram.ChatID)
+	}
+	if c.Notify.Discord.Enabled {
+		v.required("notify.discord.webhook_url", c.Notify.Discord.WebhookURL)
+	}
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+// validate 데이터베이스 접속 정보 검증 (postgres는 dsn이 있으면 개별 항목 생략, sqlite는 파일 경로만 필요)
+func (d DatabaseConfig) validate(v *validator) {
+	switch d.Driver {
+	case "", "postgres":
+		if d.DSN != "" {
+			return
+		}
+		v.required("database.host", d.Host)
+		if d.Port < 1 || d.Port > 65535 {
+			v.addf("database.port", "1~65535 사이여야 합니다 (현재 %d)", d.Port)
+		}
+		v.required("database.username", d.Username)
+		v.required("database.dbname", d.DBName)
+	case "sqlite":
+		v.required("database.dbname", d.DBName)
+	default:
+		v.addf("database.driver", "postgres, sqlite 중 하나여야 합니다 (현재 %q)", d.Driver)
+	}
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// validConfig 검증을 통과하는 최소 설정
+func validConfig() *Config {
+	return &Config{
+		Upbit:    UpbitConfig{AccessKey: "access", SecretKey: "secret"},
+		Database: DatabaseConfig{Host: "localhost", Port: 5432, Username: "bot", DBName: "upbit"},
+		Server:   ServerConfig{Port: "8080"},
+		Trading:  TradingConfig{MaxPositionSize: 10},
+	}
+}
+
+// validationProblems Validate가 돌려준 문제 목록
+func validationProblems(t *testing.T, cfg *Config) []string {
+	t.Helper()
+	err := cfg.Validate()
+	if err == nil {
+		return nil
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Validate 오류 = %T %v, want *ValidationError", err, err)
+	}
+	return verr.Problems
+}
+
+func TestValidateAcceptsValidConfig(t *testing.T) {
+	if err := validConfig().Validate(); err != nil {
+		t.Fatalf("Validate 오류: %v", err)
+	}
+}
+
+func TestValidateReportsEachProblem(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(*Config)
+		want   string
+	}{
+		{"API 키 없음", func(c *Config) { c.Upbit.AccessKey = "" }, "upbit.access_key: 값이 필요합니다"},
+		{"비밀 키 공백", func(c *Config) { c.Upbit.SecretKey = "  " }, "upbit.secret_key: 값이 필요합니다"},
+		{"포트가 숫자가 아님", func(c *Config) { c.Server.Port = "http" }, `server.port: 1~65535 사이의 숫자여야 합니다 (현재 "http")`},
+		{"포트 범위 초과", func(c *Config) { c.Server.Port = "70000" }, `server.port: 1~65535 사이의 숫자여야 합니다 (현재 "70000")`},
+		{"DB 호스트 없음", func(c *Config) { c.Database.Host = "" }, "database.host: 값이 필요합니다"},
+		{"알 수 없는 DB 드라이버", func(c *Config) { c.Database.Driver = "mysql" }, `database.driver: postgres, sqlite 중 하나여야 합니다 (현재 "mysql")`},
+		{"제거된 기본 전략", func(c *Config) { c.Trading.DefaultStrategy = "momentum" }, "trading.default_strategy: 더 이상 사용하지 않는 설정입니다 (전략은 strategy_configs 테이블에서 마켓별로 설정합니다)"},
+		{"제거된 REST 주소", func(c *Config) { c.Upbit.BaseURL = "https://api.upbit.com/v1" }, "upbit.base_url: 더 이상 사용하지 않는 설정입니다 (업비트 REST 주소는 고정입니다)"},
+		{"제거된 웹소켓 주소", func(c *Config) { c.Upbit.WSBaseURL = "wss://api.upbit.com/websocket/v1" }, "upbit.ws_base_url: 더 이상 사용하지 않는 설정입니다 (업비트 웹소켓 주소는 고정입니다)"},
+		{"포지션 크기 0", func(c *Config) { c.Trading.MaxPositionSize = 0 }, "trading.max_position_size: 0보다 크고 100 이하여야 합니다 (현재 0)"},
+		{"제거된 일일 손실 한도", func(c *Config) { c.Trading.MaxDailyLoss = 5 }, "trading.max_daily_loss: 더 이상 사용하지 않는 설정입니다 (risk.daily_loss_limit.percent를 사용하세요)"},
+		{"제거된 최대 포지션 수", func(c *Config) { c.Trading.MaxPositions = 5 }, "trading.max_positions: 더 이상 사용하지 않는 설정입니다 (risk.max_concurrent_positions를 사용하세요)"},
+		{"음수 시간 초과", func(c *Config) { c.Trading.OrderTimeoutSeconds = -1 }, "trading.order_timeout_seconds: 0 이상이어야 합니다 (현재 -1)"},
+		{"트레일링 비율 없음", func(c *Config) { c.Risk.TrailingStop.Enabled = true }, "risk.trailing_stop.percent: 0보다 크고 100보다 작아야 합니다 (현재 0)"},
+		{"알 수 없는 거래 모드", func(c *Config) { c.Trading.Mode = "demo" }, `trading.mode: live, paper 중 하나여야 합니다 (현재 "demo")`},
+	}
+
+	for _, tc := range cases {
+		cfg := validConfig()
+		tc.modify(cfg)
+		problems := validationProblems(t, cfg)
+		if len(problems) != 1 || problems[0] != tc.want {
+			t.Fatalf("%s: 문제 = %q, want [%q]", tc.name, problems, tc.want)
+		}
+	}
+}
+
+func TestValidateAggregatesProblems(t *testing.T) {
+	cfg := validConfig()
+	cfg.Upbit = UpbitConfig{}
+	cfg.Server.Port = ""
+	cfg.Risk.MaxEquityDrawdown = -5
+
+	err := cfg.Validate()
+	problems := validationProblems(t, cfg)
+	if len(problems) != 4 {
+		t.Fatalf("문제 %d건 = %q, want 4", len(problems), problems)
+	}
+	for _, field := range []string{"upbit.access_key", "upbit.secret_key", "server.port", "risk.max_equity_drawdown"} {
+		if !strings.Contains(err.Error(), "  - "+field+": ") {
+			t.Fatalf("오류 메시지에 %s 없음:\n%s", field, err)
+		}
+	}
+	if !strings.HasPrefix(err.Error(), "설정 오류 4건:") {
+		t.Fatalf("오류 메시지 = %q, want 설정 오류 4건으로 시작", err)
+	}
+}
+
+func TestValidatePaperModeAndDSNRelaxRequirements(t *testing.T) {
+	cfg := validConfig()
+	cfg.Trading.Mode = "paper"
+	cfg.Upbit = UpbitConfig{}
+	cfg.Database = DatabaseConfig{DSN: "postgres://bot@localhost/upbit"}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("모의 거래, DSN 설정 Validate 오류: %v", err)
+	}
+
+	cfg.Database = DatabaseConfig{Driver: "sqlite"}
+	if problems := validationProblems(t, cfg); len(problems) != 1 || problems[0] != "database.dbname: 값이 필요합니다" {
+		t.Fatalf("sqlite 문제 = %q, want database.dbname", problems)
+	}
+}
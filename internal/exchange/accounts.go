@@ -0,0 +1,50 @@
+package exchange
+
+import "strconv"
+
+// Quantity 보유 수량 (주문 대기 중인 수량 포함)
+func (a Account) Quantity() float64 {
+	balance, _ := strconv.ParseFloat(a.Balance, 64)
+	locked, _ := strconv.ParseFloat(a.Locked, 64)
+	return balance + locked
+}
+
+// Available 주문 가능 수량 (주문 대기 중인 수량 제외)
+func (a Account) Available() float64 {
+	balance, _ := strconv.ParseFloat(a.Balance, 64)
+	return balance
+}
+
+// AvgBuyPriceValue 평균 매수가
+func (a Account) AvgBuyPriceValue() float64 {
+	price, _ := strconv.ParseFloat(a.AvgBuyPrice, 64)
+	return price
+}
+
+// IsDust 먼지 잔고 여부
+// 업비트는 전량 매도 후에도 수량 0이나 극소량 잔고를 평균 매수가와 함께 돌려주는 경우가 있다.
+// 수량이 없거나 평균 매수가 기준 평가액이 threshold(KRW) 미만인 코인 잔고를 먼지로 본다.
+func (a Account) IsDust(threshold float64) bool {
+	if a.Currency == "KRW" {
+		return false
+	}
+
+	quantity := a.Quantity()
+	if quantity <= 0 {
+		return true
+	}
+
+	return quantity*a.AvgBuyPriceValue() < threshold
+}
+
+// HoldingAccounts 먼지 잔고를 제외한 계정 목록
+func HoldingAccounts(accounts []Account, dustThreshold float64) []Account {
+	holdings := make([]Account, 0, len(accounts))
+	for _, account := range accounts {
+		if !account.IsDust(dustThreshold) {
+			holdings = append(holdings, account)
+		}
+	}
+
+	return holdings
+}
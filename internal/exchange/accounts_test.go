@@ -0,0 +1,37 @@
+package exchange
+
+import "testing"
+
+func TestIsDust(t *testing.T) {
+	cases := []struct {
+		name    string
+		account Account
+		want    bool
+	}{
+		{"원화", Account{Currency: "KRW", Balance: "0", Locked: "0"}, false},
+		{"수량 0", Account{Currency: "BTC", Balance: "0", Locked: "0", AvgBuyPrice: "50000000"}, true},
+		{"극소량", Account{Currency: "BTC", Balance: "0.00000001", Locked: "0", AvgBuyPrice: "50000000"}, true},
+		{"주문 대기 포함", Account{Currency: "BTC", Balance: "0", Locked: "0.001", AvgBuyPrice: "50000000"}, false},
+		{"보유", Account{Currency: "ETH", Balance: "0.1", Locked: "0", AvgBuyPrice: "3000000"}, false},
+	}
+
+	for _, tc := range cases {
+		if got := tc.account.IsDust(5000); got != tc.want {
+			t.Fatalf("%s: IsDust = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestHoldingAccountsDropsDust(t *testing.T) {
+	accounts := []Account{
+		{Currency: "KRW", Balance: "100000", Locked: "0"},
+		{Currency: "BTC", Balance: "0", Locked: "0", AvgBuyPrice: "50000000"},
+		{Currency: "XRP", Balance: "1", Locked: "0", AvgBuyPrice: "700"},
+		{Currency: "ETH", Balance: "0.1", Locked: "0", AvgBuyPrice: "3000000"},
+	}
+
+	holdings := HoldingAccounts(accounts, 5000)
+	if len(holdings) != 2 || holdings[0].Currency != "KRW" || holdings[1].Currency != "ETH" {
+		t.Fatalf("HoldingAccounts = %+v, want KRW, ETH", holdings)
+	}
+}
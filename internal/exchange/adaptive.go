@@ -0,0 +1,114 @@
+package exchange
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+const (
+	// defaultColdFactor 유휴 마켓 폴링 주기 배수 (cold_intervals가 없을 때)
+	defaultColdFactor = 5
+	// defaultHotSignalWindow 최근 신호가 있으면 활성 마켓으로 보는 기간
+	defaultHotSignalWindow = time.Hour
+	// hotMarketsRefresh 활성 마켓 목록 재조회 주기
+	hotMarketsRefresh = 30 * time.Second
+)
+
+// coldPollIntervals 유휴 마켓의 데이터 유형별 폴링 주기
+// 설정이 없는 유형은 활성 주기의 defaultColdFactor배를 쓰며, 활성 주기보다 짧게 잡을 수 없다.
+func (d *DataCollector) coldPollIntervals(hot map[string]time.Duration) (map[string]time.Duration, error) {
+	cold := make(map[string]time.Duration, len(hot))
+	for dataType, interval := range hot {
+		cold[dataType] = interval * defaultColdFactor
+
+		value, ok := d.cfg.Adaptive.ColdIntervals[dataType]
+		if !ok {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("잘못된 유휴 마켓 폴링 주기: %s=%s", dataType, value)
+		}
+		if parsed < interval {
+			parsed = interval
+		}
+		cold[dataType] = parsed
+	}
+
+	return cold, nil
+}
+
+// hotMarkets 열린 포지션이나 최근 신호가 있는 마켓
+// 매 폴링마다 DB를 조회하지 않도록 hotMarketsRefresh 동안 결과를 재사용한다.
+func (d *DataCollector) hotMarkets(now time.Time) map[string]bool {
+	d.mu.Lock()
+	if d.hot != nil && now.Sub(d.hotAt) < hotMarketsRefresh {
+		hot := d.hot
+		d.mu.Unlock()
+		return hot
+	}
+	d.mu.Unlock()
+
+	window := defaultHotSignalWindow
+	if d.cfg.Adaptive.SignalWindowMinutes > 0 {
+		window = time.Duration(d.cfg.Adaptive.SignalWindowMinutes) * time.Minute
+	}
+
+	var positions, signals []string
+	if err := d.db.Model(&model.Position{}).Where("status = ?", "OPEN").Distinct().Pluck("market_id", &positions).Error; err != nil {
+		d.logger.Error("활성 마켓 포지션 조회 실패:", err)
+	}
+	if err := d.db.Model(&model.Signal{}).Where("timestamp >= ?", now.Add(-window)).Distinct().Pluck("market_id", &signals).Error; err != nil {
+		d.logger.Error("활성 마켓 신호 조회 실패:", err)
+	}
+
+	hot := make(map[string]bool, len(positions)+len(signals))
+	for _, marketID := range positions {
+		hot[marketID] = true
+	}
+	for _, marketID := range signals {
+		hot[marketID] = true
+	}
+
+	d.mu.Lock()
+	d.hot, d.hotAt = hot, now
+	d.mu.Unlock()
+
+	return hot
+}
+
+// pollTargets 이번 폴링에서 조회할 마켓
+// 적응형 폴링이 꺼져 있으면 모든 마켓을, 켜져 있으면 활성 마켓은 매번, 유휴 마켓은 유휴 주기가 지났을 때만 고른다.
+// 티커 지연으로 한 틱 늦어지지 않도록 활성 주기의 절반만큼 여유를 둔다.
+func (d *DataCollector) pollTargets(dataType string, markets []string, interval time.Duration, now time.Time) []string {
+	if !d.cfg.Adaptive.Enabled {
+		return markets
+	}
+
+	hot := d.hotMarkets(now)
+	cold := d.coldIntervals[dataType]
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	polled := d.lastPolled[dataType]
+	if polled == nil {
+		polled = make(map[string]time.Time)
+		d.lastPolled[dataType] = polled
+	}
+
+	targets := make([]string, 0, len(markets))
+	for _, marketID := range markets {
+		if !hot[marketID] {
+			if last, ok := polled[marketID]; ok && now.Sub(last) < cold-interval/2 {
+				continue
+			}
+		}
+		polled[marketID] = now
+		targets = append(targets, marketID)
+	}
+
+	return targets
+}
@@ -0,0 +1,94 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// newAdaptiveCollector 적응형 폴링을 켠 수집기 (티커 활성 주기 1초)
+func newAdaptiveCollector(t *testing.T, adaptive config.AdaptivePollConfig) *DataCollector {
+	t.Helper()
+	adaptive.Enabled = true
+	d := NewDataCollector(nil, newTestDB(t), nil, &config.CollectorConfig{Adaptive: adaptive})
+
+	cold, err := d.coldPollIntervals(map[string]time.Duration{DataTypeTicker: time.Second})
+	if err != nil {
+		t.Fatalf("coldPollIntervals 오류: %v", err)
+	}
+	d.coldIntervals = cold
+	return d
+}
+
+// countPolls 1초마다 폴링했을 때 마켓별 조회 횟수
+func countPolls(d *DataCollector, markets []string, start time.Time, ticks int) map[string]int {
+	counts := make(map[string]int)
+	for i := 0; i < ticks; i++ {
+		for _, marketID := range d.pollTargets(DataTypeTicker, markets, time.Second, start.Add(time.Duration(i)*time.Second)) {
+			counts[marketID]++
+		}
+	}
+	return counts
+}
+
+func TestAdaptivePollingFavorsMarketsWithOpenPositions(t *testing.T) {
+	d := newAdaptiveCollector(t, config.AdaptivePollConfig{ColdIntervals: map[string]string{DataTypeTicker: "5s"}})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+	if err := d.db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	counts := countPolls(d, []string{"KRW-BTC", "KRW-ETH"}, time.Now(), 20)
+	if counts["KRW-BTC"] != 20 {
+		t.Fatalf("포지션이 있는 마켓 조회 %d번, want 20", counts["KRW-BTC"])
+	}
+	if counts["KRW-ETH"] != 4 {
+		t.Fatalf("유휴 마켓 조회 %d번, want 4 (5초마다)", counts["KRW-ETH"])
+	}
+}
+
+func TestAdaptivePollingTreatsRecentSignalsAsHot(t *testing.T) {
+	d := newAdaptiveCollector(t, config.AdaptivePollConfig{SignalWindowMinutes: 10})
+	now := time.Now()
+	signals := []model.Signal{
+		{MarketID: "KRW-BTC", StrategyName: "test", SignalType: "BUY", Price: 100, Timestamp: now.Add(-5 * time.Minute)},
+		{MarketID: "KRW-ETH", StrategyName: "test", SignalType: "BUY", Price: 100, Timestamp: now.Add(-20 * time.Minute)},
+	}
+	if err := d.db.Create(&signals).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	hot := d.hotMarkets(now)
+	if !hot["KRW-BTC"] || hot["KRW-ETH"] {
+		t.Fatalf("활성 마켓 = %v, want 최근 신호가 있는 KRW-BTC만", hot)
+	}
+}
+
+func TestColdPollIntervalsDefaultsAndClamps(t *testing.T) {
+	d := NewDataCollector(nil, nil, nil, &config.CollectorConfig{Adaptive: config.AdaptivePollConfig{
+		ColdIntervals: map[string]string{"minutes/1": "30s"},
+	}})
+
+	cold, err := d.coldPollIntervals(map[string]time.Duration{DataTypeTicker: time.Second, "minutes/1": time.Minute})
+	if err != nil {
+		t.Fatalf("coldPollIntervals 오류: %v", err)
+	}
+	if cold[DataTypeTicker] != 5*time.Second || cold["minutes/1"] != time.Minute {
+		t.Fatalf("유휴 주기 = %v, want ticker 5s (기본 5배), minutes/1 1m (활성 주기 이상)", cold)
+	}
+
+	d.cfg.Adaptive.ColdIntervals = map[string]string{DataTypeTicker: "later"}
+	if _, err := d.coldPollIntervals(map[string]time.Duration{DataTypeTicker: time.Second}); err == nil {
+		t.Fatal("잘못된 유휴 주기가 오류 없이 통과")
+	}
+}
+
+func TestPollTargetsReturnsAllMarketsWhenDisabled(t *testing.T) {
+	d := NewDataCollector(nil, nil, nil, &config.CollectorConfig{})
+	markets := []string{"KRW-BTC", "KRW-ETH"}
+	if targets := d.pollTargets(DataTypeTicker, markets, time.Second, time.Now()); len(targets) != 2 {
+		t.Fatalf("조회 마켓 = %v, want 전체", targets)
+	}
+}
@@ -0,0 +1,64 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// addInIntervalParam 추가 매수 최소 간격 전략 파라미터 (분)
+const addInIntervalParam = "min_addin_interval_minutes"
+
+// ErrAddInTooSoon 추가 매수 최소 간격 미충족
+var ErrAddInTooSoon = errors.New("추가 매수 최소 간격이 지나지 않았습니다")
+
+// checkAddInInterval 추가 매수 간격 확인
+// 이미 열린 포지션에 대한 매수(물타기)일 때, 신호를 낸 전략 설정의 최소 간격 안에
+// 포지션 진입이나 직전 추가 매수 주문이 있었다면 거부한다. 급락 한 번에 모든 분할 매수가 소진되는 것을 막는다.
+// 이전 포지션의 주문은 보지 않도록 현재 포지션 진입 이후의 주문만 확인한다.
+func (e *OrderExecutor) checkAddInInterval(marketID, strategyName string, now time.Time) error {
+	var position model.Position
+	err := e.db.Where("market_id = ? AND status = ?", marketID, "OPEN").First(&position).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("포지션 조회 실패: %w", err)
+	}
+
+	var strategyConfig model.StrategyConfig
+	err = e.db.Where("market_id = ? AND strategy_name = ?", marketID, strategyName).First(&strategyConfig).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("전략 설정 조회 실패: %w", err)
+	}
+
+	minutes, _ := strategyConfig.Parameters[addInIntervalParam].(float64)
+	if minutes <= 0 {
+		return nil
+	}
+
+	lastBuy := position.EntryTime
+	var last model.Order
+	err = e.db.Where("market_id = ? AND side = ? AND created_at > ?", marketID, "BUY", position.EntryTime).
+		Order("created_at DESC").
+		First(&last).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("직전 매수 주문 조회 실패: %w", err)
+	}
+	if err == nil {
+		lastBuy = last.CreatedAt
+	}
+
+	interval := time.Duration(minutes * float64(time.Minute))
+	if elapsed := now.Sub(lastBuy); elapsed < interval {
+		return fmt.Errorf("%w: %s (경과 %s, 최소 %s)", ErrAddInTooSoon, marketID, elapsed.Round(time.Second), interval)
+	}
+
+	return nil
+}
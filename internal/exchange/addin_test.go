@@ -0,0 +1,101 @@
+package exchange
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// seedAddIn 추가 매수 간격 30분인 전략 설정과 진입 시각이 entryTime인 열린 포지션
+func seedAddIn(t *testing.T, db *gorm.DB, entryTime time.Time) {
+	t.Helper()
+	records := []interface{}{
+		&model.StrategyConfig{MarketID: "KRW-BTC", StrategyName: "dca", Timeframe: "minutes/1", Enabled: true, Parameters: model.Parameters{addInIntervalParam: 30.0}},
+		&model.StrategyConfig{MarketID: "KRW-BTC", StrategyName: "rsi", Timeframe: "minutes/1", Enabled: true, Parameters: model.Parameters{}},
+		&model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: entryTime, Quantity: 1, Status: "OPEN", LastPrice: 100000},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// createBuyOrder createdAt에 만든 매수 주문 저장
+func createBuyOrder(t *testing.T, db *gorm.DB, orderID string, createdAt time.Time) {
+	t.Helper()
+	order := model.Order{MarketID: "KRW-BTC", OrderID: orderID, Side: "BUY", OrderType: "limit", Price: 100000, Volume: 0.1, Status: OrderStatusDone, LastUpdated: createdAt}
+	order.CreatedAt = createdAt
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckAddInIntervalMeasuresFromEntry(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	entry := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	seedAddIn(t, db, entry)
+
+	if err := e.checkAddInInterval("KRW-BTC", "dca", entry.Add(10*time.Minute)); !errors.Is(err, ErrAddInTooSoon) {
+		t.Fatalf("진입 10분 후 추가 매수 오류 = %v, want ErrAddInTooSoon", err)
+	}
+	if err := e.checkAddInInterval("KRW-BTC", "dca", entry.Add(31*time.Minute)); err != nil {
+		t.Fatalf("진입 31분 후 추가 매수 거부: %v", err)
+	}
+}
+
+func TestCheckAddInIntervalMeasuresFromLastAddIn(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	entry := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	seedAddIn(t, db, entry)
+
+	// 이전 포지션의 매수 주문은 보지 않는다
+	createBuyOrder(t, db, "old", entry.Add(-2*time.Hour))
+	createBuyOrder(t, db, "addin-1", entry.Add(60*time.Minute))
+
+	if err := e.checkAddInInterval("KRW-BTC", "dca", entry.Add(70*time.Minute)); !errors.Is(err, ErrAddInTooSoon) {
+		t.Fatalf("추가 매수 10분 후 오류 = %v, want ErrAddInTooSoon", err)
+	}
+	if err := e.checkAddInInterval("KRW-BTC", "dca", entry.Add(91*time.Minute)); err != nil {
+		t.Fatalf("추가 매수 31분 후 거부: %v", err)
+	}
+}
+
+func TestCheckAddInIntervalUsesSignalStrategyConfig(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	entry := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	seedAddIn(t, db, entry)
+
+	// 같은 마켓이라도 간격을 설정하지 않은 전략의 신호는 막지 않는다
+	if err := e.checkAddInInterval("KRW-BTC", "rsi", entry.Add(time.Minute)); err != nil {
+		t.Fatalf("간격 미설정 전략 추가 매수 거부: %v", err)
+	}
+	if err := e.checkAddInInterval("KRW-BTC", "unknown", entry.Add(time.Minute)); err != nil {
+		t.Fatalf("설정이 없는 전략 추가 매수 거부: %v", err)
+	}
+}
+
+func TestCheckAddInIntervalReturnsDatabaseErrors(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	entry := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	seedAddIn(t, db, entry)
+
+	if err := db.Migrator().DropTable(&model.StrategyConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	err := e.checkAddInInterval("KRW-BTC", "dca", entry.Add(time.Minute))
+	if err == nil || errors.Is(err, ErrAddInTooSoon) {
+		t.Fatalf("전략 설정 조회 실패 오류 = %v, want 조회 오류", err)
+	}
+}
+
+func TestCheckAddInIntervalAllowsFirstEntry(t *testing.T) {
+	e, _ := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	if err := e.checkAddInInterval("KRW-BTC", "dca", time.Now()); err != nil {
+		t.Fatalf("열린 포지션이 없는 매수 거부: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package exchange
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const defaultApprovalTimeout = 30 * time.Second
+
+var (
+	// ErrOrderNotApproved 외부 승인 거부
+	ErrOrderNotApproved = errors.New("주문이 승인되지 않았습니다")
+	// ErrApprovalTimeout 제한 시간 내 승인 응답 없음
+	ErrApprovalTimeout = errors.New("주문 승인 응답 시간 초과")
+)
+
+// approvalRequest 승인 웹훅 요청
+type approvalRequest struct {
+	Order
+	SignalID  uint      `json:"signal_id,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// approvalResponse 승인 웹훅 응답
+type approvalResponse struct {
+	Approved bool   `json:"approved"`
+	Reason   string `json:"reason"`
+}
+
+// requestApproval 외부 웹훅에 주문 승인 요청
+// 웹훅이 제한 시간 내에 승인한 주문만 실행하며, 거부나 시간 초과, 오류는 모두 미승인으로 처리한다.
+func (e *OrderExecutor) requestApproval(ctx context.Context, order Order, signalID uint) error {
+	cfg := e.cfg.Approval
+	if !cfg.Enabled {
+		return nil
+	}
+
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultApprovalTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body, err := json.Marshal(approvalRequest{Order: order, SignalID: signalID, Timestamp: time.Now()})
+	if err != nil {
+		return fmt.Errorf("승인 요청 인코딩 실패: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%w: %s (%s)", ErrApprovalTimeout, order.MarketID, timeout)
+		}
+		return fmt.Errorf("%w: 승인 요청 실패: %w", ErrOrderNotApproved, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: 웹훅 응답 %s", ErrOrderNotApproved, resp.Status)
+	}
+
+	var result approvalResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("%w: 응답 파싱 실패: %w", ErrOrderNotApproved, err)
+	}
+	if !result.Approved {
+		return fmt.Errorf("%w: %s", ErrOrderNotApproved, result.Reason)
+	}
+
+	e.logger.Info("주문 승인:", order.MarketID, order.Side, result.Reason)
+	return nil
+}
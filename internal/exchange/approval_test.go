@@ -0,0 +1,101 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+// newApprovalWebhook 승인 웹훅 테스트 서버
+func newApprovalWebhook(t *testing.T, handler http.HandlerFunc) string {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestSubmitSendsApprovedOrder(t *testing.T) {
+	var received approvalRequest
+	url := newApprovalWebhook(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("승인 요청 디코딩 실패: %v", err)
+		}
+		w.Write([]byte(`{"approved":true,"reason":"ok"}`))
+	})
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{
+		Approval: config.ApprovalConfig{Enabled: true, WebhookURL: url, TimeoutSeconds: 5},
+	})
+
+	order := Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100000, Volume: 0.1}
+	if _, err := e.submit(context.Background(), order, 7); err != nil {
+		t.Fatalf("승인된 주문 전송 실패: %v", err)
+	}
+	if received.MarketID != "KRW-BTC" || received.SignalID != 7 || received.Volume != 0.1 {
+		t.Fatalf("승인 요청 = %+v, want KRW-BTC 신호 7 수량 0.1", received)
+	}
+	if n := len(client.createdOrders()); n != 1 {
+		t.Fatalf("주문 요청 수 = %d, want 1", n)
+	}
+}
+
+func TestSubmitDropsUnapprovedOrder(t *testing.T) {
+	cases := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"거부", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"approved":false,"reason":"too large"}`))
+		}},
+		{"오류 응답", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}},
+		{"잘못된 응답", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`approved`))
+		}},
+	}
+
+	for _, tc := range cases {
+		client := &fakeExchange{}
+		e, _ := newTestExecutor(t, client, config.TradingConfig{
+			Approval: config.ApprovalConfig{Enabled: true, WebhookURL: newApprovalWebhook(t, tc.handler), TimeoutSeconds: 5},
+		})
+
+		order := Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100000, Volume: 0.1}
+		if _, err := e.submit(context.Background(), order, 0); !errors.Is(err, ErrOrderNotApproved) {
+			t.Fatalf("%s: submit 오류 = %v, want ErrOrderNotApproved", tc.name, err)
+		}
+		if n := len(client.createdOrders()); n != 0 {
+			t.Fatalf("%s: 주문 요청 수 = %d, want 0", tc.name, n)
+		}
+	}
+}
+
+func TestSubmitDropsOrderOnApprovalTimeout(t *testing.T) {
+	release := make(chan struct{})
+	url := newApprovalWebhook(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+	defer close(release)
+
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{
+		Approval: config.ApprovalConfig{Enabled: true, WebhookURL: url, TimeoutSeconds: 1},
+	})
+
+	order := Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100000, Volume: 0.1}
+	if _, err := e.submit(context.Background(), order, 0); !errors.Is(err, ErrApprovalTimeout) {
+		t.Fatalf("submit 오류 = %v, want ErrApprovalTimeout", err)
+	}
+	if n := len(client.createdOrders()); n != 0 {
+		t.Fatalf("주문 요청 수 = %d, want 0", n)
+	}
+}
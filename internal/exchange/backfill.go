@@ -0,0 +1,164 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+const (
+	backfillPageSize          = maxCandlesPerRequest
+	defaultBackfillDays       = 30
+	defaultBackfillRetries    = 3
+	defaultBackfillRetryDelay = 5 * time.Second
+)
+
+// backfillAll 모든 마켓과 타임프레임의 과거 캔들 백필
+func (d *DataCollector) backfillAll(ctx context.Context, markets []string) {
+	defer d.wg.Done()
+
+	days := d.cfg.Backfill.Days
+	if days <= 0 {
+		days = defaultBackfillDays
+	}
+	from := time.Now().AddDate(0, 0, -days)
+
+	for _, timeframe := range d.cfg.Backfill.Timeframes {
+		for _, marketID := range markets {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := d.backfill(ctx, marketID, timeframe, from); err != nil {
+				d.logger.Error("캔들 백필 실패:", marketID, timeframe, err)
+			}
+		}
+	}
+	d.logger.Info("캔들 백필 완료")
+}
+
+// backfill 마켓의 과거 캔들 백필
+// 이미 저장된 구간은 건너뛰고, 저장된 최신 캔들 이후와 가장 오래된 캔들 이전의 빈 구간만 채운다.
+// 백필은 최신 캔들부터 과거로 진행하므로 중단되더라도 저장된 구간은 연속이며 다음 실행에서 이어서 수집한다.
+func (d *DataCollector) backfill(ctx context.Context, marketID, timeframe string, from time.Time) error {
+	oldest, newest, found, err := d.storedRange(marketID, timeframe, from)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return d.backfillRange(ctx, marketID, timeframe, from, time.Time{})
+	}
+
+	if err := d.backfillRange(ctx, marketID, timeframe, newest, time.Time{}); err != nil {
+		return err
+	}
+	if oldest.After(from) {
+		d.logger.Info("캔들 백필 재개:", marketID, timeframe, oldest)
+		return d.backfillRange(ctx, marketID, timeframe, from, oldest)
+	}
+
+	return nil
+}
+
+// storedRange from 이후 저장된 캔들의 가장 오래된 시각과 최신 시각
+// MIN/MAX 집계는 sqlite에서 문자열로 반환되므로 정렬 조회로 양 끝 캔들을 찾는다.
+func (d *DataCollector) storedRange(marketID, timeframe string, from time.Time) (time.Time, time.Time, bool, error) {
+	var oldest, newest model.Candlestick
+	err := d.db.Where("market_id = ? AND timeframe = ? AND timestamp >= ?", marketID, timeframe, from).
+		Order("timestamp").
+		First(&oldest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("저장된 캔들 구간 조회 실패: %w", err)
+	}
+
+	err = d.db.Where("market_id = ? AND timeframe = ? AND timestamp >= ?", marketID, timeframe, from).
+		Order("timestamp DESC").
+		First(&newest).Error
+	if err != nil {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("저장된 캔들 구간 조회 실패: %w", err)
+	}
+
+	return oldest.Timestamp, newest.Timestamp, true, nil
+}
+
+// backfillRange from부터 to 이전까지의 캔들을 최신순으로 페이지 단위 수집
+// to가 0이면 현재 캔들부터 수집한다.
+func (d *DataCollector) backfillRange(ctx context.Context, marketID, timeframe string, from, to time.Time) error {
+	cursor := to
+	for {
+		candles, err := d.fetchCandlePage(ctx, marketID, timeframe, cursor)
+		if err != nil {
+			return err
+		}
+
+		candlesticks, err := ToCandlesticks(candles, timeframe)
+		if err != nil {
+			return err
+		}
+		if len(candlesticks) == 0 {
+			return nil
+		}
+
+		// 시간순이므로 from 이전 캔들은 앞쪽에 있다
+		start := 0
+		for start < len(candlesticks) && candlesticks[start].Timestamp.Before(from) {
+			start++
+		}
+		if err := d.saveCandles(candlesticks[start:]); err != nil {
+			return err
+		}
+
+		earliest := candlesticks[0].Timestamp
+		if start > 0 || !earliest.After(from) || len(candlesticks) < backfillPageSize {
+			return nil
+		}
+		cursor = earliest
+
+		// 시세 조회 요청 한도를 넘지 않도록 페이지 사이에 대기한다
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second / quotationRequestsPerSecond):
+		}
+	}
+}
+
+// fetchCandlePage 캔들 페이지 조회 (실패 시 설정된 횟수만큼 재시도)
+func (d *DataCollector) fetchCandlePage(ctx context.Context, marketID, timeframe string, to time.Time) ([]Candle, error) {
+	retries := d.cfg.Backfill.MaxRetries
+	if retries <= 0 {
+		retries = defaultBackfillRetries
+	}
+	delay := time.Duration(d.cfg.Backfill.RetryDelaySeconds) * time.Second
+	if delay <= 0 {
+		delay = defaultBackfillRetryDelay
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		var candles []Candle
+		candles, err = d.client.GetCandlesBefore(ctx, marketID, timeframe, backfillPageSize, to)
+		if err == nil {
+			return candles, nil
+		}
+		if attempt >= retries || errors.Is(err, context.Canceled) {
+			break
+		}
+
+		d.logger.Warn("캔들 백필 페이지 조회 실패, 재시도:", marketID, timeframe, attempt+1, err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	return nil, fmt.Errorf("캔들 백필 페이지 조회 실패 (%d회 재시도): %w", retries, err)
+}
@@ -0,0 +1,155 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// candleServer end 직전까지 1분봉이 있는 캔들 API
+// to 이전(to가 없으면 end 이전) 캔들을 최신순으로 count개 돌려주며, 요청한 to를 기록한다.
+type candleServer struct {
+	start, end time.Time
+	failures   int // 처음 몇 번의 요청을 실패시킬지
+
+	mu  sync.Mutex
+	tos []string
+}
+
+func (s *candleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.tos = append(s.tos, r.URL.Query().Get("to"))
+	if s.failures > 0 {
+		s.failures--
+		s.mu.Unlock()
+		http.Error(w, `{"error":{"name":"bad_request","message":"temporary"}}`, http.StatusBadRequest)
+		return
+	}
+	s.mu.Unlock()
+
+	before := s.end
+	if to := r.URL.Query().Get("to"); to != "" {
+		before, _ = time.Parse(candleTimeLayout+"Z", to)
+	}
+	count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+
+	candles := []Candle{}
+	for ts := before.Add(-time.Minute); !ts.Before(s.start) && len(candles) < count; ts = ts.Add(-time.Minute) {
+		candles = append(candles, Candle{
+			MarketID:          r.URL.Query().Get("market"),
+			CandleDateTimeUTC: ts.UTC().Format(candleTimeLayout),
+			OpeningPrice:      100,
+			HighPrice:         100,
+			LowPrice:          100,
+			TradePrice:        100,
+		})
+	}
+	json.NewEncoder(w).Encode(candles)
+}
+
+// requestedTos 지금까지 요청한 to 값
+func (s *candleServer) requestedTos() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.tos...)
+}
+
+// newBackfillCollector 캔들 서버를 쓰는 수집기
+func newBackfillCollector(t *testing.T, server *candleServer, cfg config.BackfillConfig) (*DataCollector, *gorm.DB) {
+	t.Helper()
+	db := newTestDB(t)
+	client := newTestClient(t, server)
+	return NewDataCollector(client, db, nil, &config.CollectorConfig{Backfill: cfg}), db
+}
+
+// storedCandles 저장된 1분봉 수와 가장 오래된 시각
+func storedCandles(t *testing.T, db *gorm.DB) (int64, time.Time) {
+	t.Helper()
+	var count int64
+	if err := db.Model(&model.Candlestick{}).Where("market_id = ? AND timeframe = ?", "KRW-BTC", "minutes/1").Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	var oldest model.Candlestick
+	db.Where("market_id = ?", "KRW-BTC").Order("timestamp").First(&oldest)
+	return count, oldest.Timestamp
+}
+
+func TestBackfillCollectsPagesBackToFrom(t *testing.T) {
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	server := &candleServer{start: end.Add(-1000 * time.Minute), end: end}
+	d, db := newBackfillCollector(t, server, config.BackfillConfig{})
+
+	from := end.Add(-300 * time.Minute)
+	if err := d.backfill(context.Background(), "KRW-BTC", "minutes/1", from); err != nil {
+		t.Fatalf("backfill 오류: %v", err)
+	}
+
+	count, oldest := storedCandles(t, db)
+	if count != 300 || !oldest.Equal(from) {
+		t.Fatalf("저장된 캔들 %d개, 가장 오래된 캔들 %s, want 300개, %s", count, oldest, from)
+	}
+	if tos := server.requestedTos(); len(tos) != 2 {
+		t.Fatalf("페이지 요청 수 = %d, want 2", len(tos))
+	}
+}
+
+func TestBackfillResumesBeforeOldestStoredCandle(t *testing.T) {
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	server := &candleServer{start: end.Add(-1000 * time.Minute), end: end}
+	d, db := newBackfillCollector(t, server, config.BackfillConfig{})
+
+	// 지난 실행이 최근 100분만 저장하고 중단되었다
+	var stored []model.Candlestick
+	for i := 1; i <= 100; i++ {
+		ts := end.Add(-time.Duration(i) * time.Minute)
+		stored = append(stored, model.Candlestick{MarketID: "KRW-BTC", Timeframe: "minutes/1", Timestamp: ts, Open: 100, High: 100, Low: 100, Close: 100})
+	}
+	if err := d.saveCandles(stored); err != nil {
+		t.Fatal(err)
+	}
+
+	from := end.Add(-300 * time.Minute)
+	if err := d.backfill(context.Background(), "KRW-BTC", "minutes/1", from); err != nil {
+		t.Fatalf("backfill 오류: %v", err)
+	}
+
+	count, oldest := storedCandles(t, db)
+	if count != 300 || !oldest.Equal(from) {
+		t.Fatalf("저장된 캔들 %d개, 가장 오래된 캔들 %s, want 300개, %s", count, oldest, from)
+	}
+
+	resumeTo := end.Add(-100 * time.Minute).Format(candleTimeLayout + "Z")
+	resumed := false
+	for _, to := range server.requestedTos() {
+		if to == resumeTo {
+			resumed = true
+		}
+	}
+	if !resumed {
+		t.Fatalf("저장된 가장 오래된 캔들(%s)부터 이어서 요청하지 않음: %v", resumeTo, server.requestedTos())
+	}
+}
+
+func TestBackfillRetriesFailedPage(t *testing.T) {
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	server := &candleServer{start: end.Add(-1000 * time.Minute), end: end, failures: 1}
+	d, db := newBackfillCollector(t, server, config.BackfillConfig{MaxRetries: 2, RetryDelaySeconds: 1})
+
+	if err := d.backfill(context.Background(), "KRW-BTC", "minutes/1", end.Add(-50*time.Minute)); err != nil {
+		t.Fatalf("재시도 후 backfill 오류: %v", err)
+	}
+	if count, _ := storedCandles(t, db); count != 50 {
+		t.Fatalf("저장된 캔들 %d개, want 50개", count)
+	}
+	if n := len(server.requestedTos()); n != 2 {
+		t.Fatalf("요청 수 = %d, want 2 (실패 1회 + 재시도 1회)", n)
+	}
+}
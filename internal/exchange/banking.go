@@ -0,0 +1,106 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// bankProfitsParam 실현 수익을 포지션 크기 기준에서 제외할지 여부 전략 파라미터
+// 설정하지 않으면 실현 수익이 총 자산에 포함되어 이후 진입 금액이 복리로 커진다.
+const bankProfitsParam = "bank_profits"
+
+// strategyTradeFlow 전략 체결 내역 집계용 행
+type strategyTradeFlow struct {
+	MarketID  string
+	Side      string
+	Price     float64
+	Volume    float64
+	Fee       float64
+	Timestamp time.Time
+}
+
+// sizingEquity 전략의 포지션 크기 기준 자산
+// 수익을 적립하는 전략은 그동안 실현한 수익을 총 자산에서 빼서 진입 금액이 커지지 않게 한다.
+func (e *OrderExecutor) sizingEquity(signal model.Signal, equity float64) (float64, error) {
+	if signal.StrategyName == "" {
+		return equity, nil
+	}
+
+	var strategyConfig model.StrategyConfig
+	err := e.db.Where("market_id = ? AND strategy_name = ?", signal.MarketID, signal.StrategyName).First(&strategyConfig).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return equity, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("전략 설정 조회 실패: %w", err)
+	}
+	if bank, _ := strategyConfig.Parameters[bankProfitsParam].(bool); !bank {
+		return equity, nil
+	}
+
+	banked, err := e.BankedProfit(signal.StrategyName)
+	if err != nil {
+		return 0, err
+	}
+	if banked > 0 {
+		e.logger.Info("적립 수익 제외 후 포지션 크기 산정:", signal.StrategyName, equity, banked)
+	}
+
+	if equity-banked < 0 {
+		return 0, nil
+	}
+	return equity - banked, nil
+}
+
+// BankedProfit 전략이 실현한 누적 수익 (KRW, 손실이면 0)
+// 체결 → 주문 → 신호를 따라 전략의 체결을 모으고, 아직 열린 포지션에 속한 체결은 미실현이므로 제외한다.
+func (e *OrderExecutor) BankedProfit(strategyName string) (float64, error) {
+	var flows []strategyTradeFlow
+	err := e.db.Table("trades AS t").
+		Select("t.market_id, t.side, t.price, t.volume, t.fee, t.timestamp").
+		Joins("JOIN orders AS o ON o.order_id = t.order_id").
+		Joins("JOIN signals AS sg ON sg.id = o.signal_id").
+		Where("sg.strategy_name = ? AND t.deleted_at IS NULL", strategyName).
+		Scan(&flows).Error
+	if err != nil {
+		return 0, fmt.Errorf("전략 체결 내역 조회 실패: %w", err)
+	}
+
+	var positions []model.Position
+	if err := e.db.Where("status = ?", "OPEN").Find(&positions).Error; err != nil {
+		return 0, fmt.Errorf("포지션 조회 실패: %w", err)
+	}
+	openSince := make(map[string]time.Time, len(positions))
+	for _, position := range positions {
+		openSince[position.MarketID] = position.EntryTime
+	}
+
+	return realizedProfit(flows, openSince), nil
+}
+
+// realizedProfit 체결 현금 흐름으로 계산한 실현 수익 (손실이면 0)
+// openSince에 있는 마켓은 해당 시각 이후 체결을 열린 포지션으로 보고 제외한다.
+func realizedProfit(flows []strategyTradeFlow, openSince map[string]time.Time) float64 {
+	total := 0.0
+	for _, flow := range flows {
+		if since, ok := openSince[flow.MarketID]; ok && !flow.Timestamp.Before(since) {
+			continue
+		}
+
+		notional := flow.Price * flow.Volume
+		if flow.Side == "SELL" {
+			total += notional - flow.Fee
+		} else {
+			total -= notional + flow.Fee
+		}
+	}
+
+	if total < 0 {
+		return 0
+	}
+	return total
+}
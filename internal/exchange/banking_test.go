@@ -0,0 +1,103 @@
+package exchange
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// storeStrategyTrade 전략 신호에서 나온 주문의 체결 기록
+func storeStrategyTrade(t *testing.T, db *gorm.DB, strategyName, marketID, side string, price, volume, fee float64, at time.Time) {
+	t.Helper()
+
+	signal := model.Signal{MarketID: marketID, StrategyName: strategyName, SignalType: side, Price: price, Confidence: 1, Timestamp: at}
+	if err := db.Create(&signal).Error; err != nil {
+		t.Fatal(err)
+	}
+	orderID := "order-" + side + "-" + at.Format("150405")
+	order := model.Order{MarketID: marketID, OrderID: orderID, Side: side, OrderType: "limit", Price: price, Volume: volume, Status: OrderStatusDone, SignalID: signal.ID}
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+	trade := model.Trade{MarketID: marketID, OrderID: orderID, TradeID: orderID, Price: price, Volume: volume, Side: side, Fee: fee, Timestamp: at}
+	if err := db.Create(&trade).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBankedProfitExcludesOpenPositions(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// 청산한 거래: 9895원 수익
+	storeStrategyTrade(t, db, "test", "KRW-BTC", "BUY", 100000, 1, 50, start)
+	storeStrategyTrade(t, db, "test", "KRW-BTC", "SELL", 110000, 1, 55, start.Add(time.Hour))
+	// 아직 열린 포지션의 매수는 미실현이므로 제외
+	storeStrategyTrade(t, db, "test", "KRW-BTC", "BUY", 120000, 1, 60, start.Add(2*time.Hour))
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 120000, EntryTime: start.Add(2 * time.Hour), Quantity: 1, Status: "OPEN"}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	// 다른 전략 체결은 포함하지 않는다
+	storeStrategyTrade(t, db, "other", "KRW-ETH", "SELL", 50000, 1, 25, start.Add(3*time.Hour))
+
+	banked, err := e.BankedProfit("test")
+	if err != nil {
+		t.Fatalf("BankedProfit 오류: %v", err)
+	}
+	if math.Abs(banked-9895) > 1e-6 {
+		t.Fatalf("적립 수익 = %v, want 9895", banked)
+	}
+}
+
+func TestRealizedProfitFloorsLossesAtZero(t *testing.T) {
+	flows := []strategyTradeFlow{
+		{MarketID: "KRW-BTC", Side: "BUY", Price: 100, Volume: 1},
+		{MarketID: "KRW-BTC", Side: "SELL", Price: 90, Volume: 1},
+	}
+	if got := realizedProfit(flows, nil); got != 0 {
+		t.Fatalf("손실 실현 수익 = %v, want 0", got)
+	}
+}
+
+func TestEnterSizesBankingStrategyWithoutProfits(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{MaxPositionSize: 10})
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	storeStrategyTrade(t, db, "test", "KRW-BTC", "BUY", 100000, 1, 0, start)
+	storeStrategyTrade(t, db, "test", "KRW-BTC", "SELL", 200000, 1, 0, start.Add(time.Hour))
+
+	cfg := model.StrategyConfig{MarketID: "KRW-BTC", StrategyName: "test", Timeframe: "minutes/1", Enabled: true, Parameters: model.Parameters{bankProfitsParam: true}}
+	if err := db.Create(&cfg).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.enter(context.Background(), buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("매수 주문 실패: %v", err)
+	}
+	// 자산 1,000,000원에서 적립 수익 100,000원을 뺀 900,000원의 10%
+	orders := client.createdOrders()
+	if len(orders) != 1 || math.Abs(orders[0].Price*orders[0].Volume-90000) > 1 {
+		t.Fatalf("주문 = %+v, want 90000원 매수", orders)
+	}
+}
+
+func TestEnterCompoundsWithoutBankProfits(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{MaxPositionSize: 10})
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	storeStrategyTrade(t, db, "test", "KRW-BTC", "BUY", 100000, 1, 0, start)
+	storeStrategyTrade(t, db, "test", "KRW-BTC", "SELL", 200000, 1, 0, start.Add(time.Hour))
+
+	if err := e.enter(context.Background(), buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("매수 주문 실패: %v", err)
+	}
+	if orders := client.createdOrders(); len(orders) != 1 || orders[0].Price*orders[0].Volume != 100000 {
+		t.Fatalf("주문 = %+v, want 100000원 매수", orders)
+	}
+}
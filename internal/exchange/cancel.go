@@ -0,0 +1,74 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// CancelOrder 주문 취소 및 부분 체결분 반영
+// 일부 체결된 지정가 주문을 취소하면 체결된 수량은 포지션과 체결 내역에 반영하고 남은 수량만 포기한다.
+// FlattenPartialEntries가 켜져 있으면 부분 체결된 매수분은 보유하지 않고 시장가로 바로 매도한다.
+func (e *OrderExecutor) CancelOrder(ctx context.Context, orderID string) (*model.Order, error) {
+	e.orderMu.Lock()
+	defer e.orderMu.Unlock()
+
+	var record model.Order
+	if err := e.db.Where("order_id = ?", orderID).First(&record).Error; err != nil {
+		return nil, fmt.Errorf("주문 조회 실패: %w", err)
+	}
+	if IsTerminalStatus(record.Status) {
+		return &record, nil
+	}
+
+	if err := e.cancelOrder(ctx, &record); err != nil {
+		if record.Status != OrderStatusCancel {
+			return nil, err
+		}
+		return &record, err
+	}
+	return &record, nil
+}
+
+// cancelOrder 미체결 주문 취소 (호출하는 쪽에서 orderMu를 잡고 있어야 함)
+func (e *OrderExecutor) cancelOrder(ctx context.Context, record *model.Order) error {
+	resp, err := e.client.CancelOrder(ctx, record.OrderID)
+	if err != nil {
+		return err
+	}
+
+	// 취소 응답은 아직 wait 상태일 수 있으므로 취소 요청이 받아들여진 시점에 취소로 확정한다
+	e.applyOrderResponse(record, resp)
+	if !IsTerminalStatus(record.Status) {
+		record.Status = OrderStatusCancel
+		e.recordOrderEvent(record, OrderEventCancelled)
+	}
+	if err := e.db.Save(record).Error; err != nil {
+		return fmt.Errorf("주문 저장 실패: %w", err)
+	}
+
+	if record.ExecutedVolume <= 0 {
+		e.logger.Info("주문 취소:", record.MarketID, record.OrderID)
+		return nil
+	}
+
+	// 추적 루프가 이미 반영한 체결은 건너뛰고 남은 체결만 반영한다
+	if _, err := e.applyNewFills(ctx, record, resp.PaidFee); err != nil {
+		return fmt.Errorf("부분 체결 반영 실패: %w", err)
+	}
+	volume, _, _, err := e.filledPortion(record.OrderID)
+	if err != nil {
+		return err
+	}
+	e.logger.Info("부분 체결 주문 취소:", record.MarketID, record.OrderID, volume, "/", record.Volume)
+
+	if record.Side == "BUY" && e.cfg.FlattenPartialEntries {
+		order := Order{MarketID: record.MarketID, Side: "ask", OrderType: "market", Volume: volume}
+		if _, err := e.submit(ctx, order, record.SignalID); err != nil {
+			return fmt.Errorf("부분 체결분 매도 실패: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// storeWaitingBuy 1 BTC 지정가 매수 대기 주문(entry-1) 저장
+func storeWaitingBuy(t *testing.T, db *gorm.DB) model.Order {
+	t.Helper()
+	order := model.Order{MarketID: "KRW-BTC", OrderID: "entry-1", Side: "BUY", OrderType: "limit", Price: 100000, Volume: 1, Status: OrderStatusWait, LastUpdated: time.Now()}
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+	return order
+}
+
+// partialFill 체결 하나
+func partialFill(uuid, volume, funds, createdAt string) OrderTrade {
+	return OrderTrade{UUID: uuid, Price: "100000", Volume: volume, Funds: funds, CreatedAt: createdAt}
+}
+
+func TestCancelPartiallyFilledOrderKeepsFilledPart(t *testing.T) {
+	client := &fakeExchange{
+		orders: map[string]*OrderResponse{
+			// 취소 응답은 아직 wait 상태로 올 수 있다
+			"entry-1": {UUID: "entry-1", State: "wait", ExecutedVolume: "0.4", RemainingVolume: "0.6"},
+		},
+		trades: map[string][]OrderTrade{
+			"entry-1": {partialFill("trade-1", "0.4", "40000", "2024-01-01T09:00:00+09:00")},
+		},
+	}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	storeWaitingBuy(t, db)
+
+	record, err := e.CancelOrder(context.Background(), "entry-1")
+	if err != nil {
+		t.Fatalf("CancelOrder 오류: %v", err)
+	}
+	if record.Status != OrderStatusCancel || record.ExecutedVolume != 0.4 {
+		t.Fatalf("주문 = %s, 체결 %v, want CANCEL, 0.4", record.Status, record.ExecutedVolume)
+	}
+
+	var position model.Position
+	if err := db.Where("market_id = ?", "KRW-BTC").First(&position).Error; err != nil {
+		t.Fatalf("부분 체결분 포지션 없음: %v", err)
+	}
+	if position.Quantity != 0.4 || position.EntryPrice != 100000 {
+		t.Fatalf("포지션 = %v @ %v, want 0.4 @ 100000", position.Quantity, position.EntryPrice)
+	}
+	if n := len(client.createdOrders()); n != 0 {
+		t.Fatalf("주문 요청 %d건, want 0 (체결분 보유)", n)
+	}
+}
+
+func TestCancelSkipsFillsAlreadyTracked(t *testing.T) {
+	client := &fakeExchange{
+		orders: map[string]*OrderResponse{
+			"entry-1": {UUID: "entry-1", State: "wait", ExecutedVolume: "0.3", RemainingVolume: "0.7"},
+		},
+		trades: map[string][]OrderTrade{
+			"entry-1": {partialFill("trade-1", "0.3", "30000", "2024-01-01T09:00:00+09:00")},
+		},
+	}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	order := storeWaitingBuy(t, db)
+
+	if err := e.trackOrder(context.Background(), &order); err != nil {
+		t.Fatalf("trackOrder 오류: %v", err)
+	}
+
+	// 추적 이후 0.1이 더 체결된 상태에서 취소
+	client.mu.Lock()
+	client.orders["entry-1"] = &OrderResponse{UUID: "entry-1", State: "cancel", ExecutedVolume: "0.4", RemainingVolume: "0.6"}
+	client.trades["entry-1"] = append(client.trades["entry-1"], partialFill("trade-2", "0.1", "10000", "2024-01-01T09:00:01+09:00"))
+	client.mu.Unlock()
+
+	if _, err := e.CancelOrder(context.Background(), "entry-1"); err != nil {
+		t.Fatalf("CancelOrder 오류: %v", err)
+	}
+
+	var trades int64
+	db.Model(&model.Trade{}).Count(&trades)
+	var position model.Position
+	if err := db.Where("market_id = ?", "KRW-BTC").First(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	if trades != 2 || position.Quantity != 0.4 {
+		t.Fatalf("체결 %d건, 포지션 %v, want 2건, 0.4 (중복 반영 없음)", trades, position.Quantity)
+	}
+}
+
+func TestCancelFlattensPartialEntryWhenConfigured(t *testing.T) {
+	client := &fakeExchange{
+		orders: map[string]*OrderResponse{
+			"entry-1": {UUID: "entry-1", State: "cancel", ExecutedVolume: "0.4", RemainingVolume: "0.6"},
+		},
+		trades: map[string][]OrderTrade{
+			"entry-1": {partialFill("trade-1", "0.4", "40000", "2024-01-01T09:00:00+09:00")},
+		},
+	}
+	e, db := newTestExecutor(t, client, config.TradingConfig{FlattenPartialEntries: true})
+	storeWaitingBuy(t, db)
+
+	if _, err := e.CancelOrder(context.Background(), "entry-1"); err != nil {
+		t.Fatalf("CancelOrder 오류: %v", err)
+	}
+
+	orders := client.createdOrders()
+	if len(orders) != 1 || orders[0].Side != "ask" || orders[0].OrderType != "market" || orders[0].Volume != 0.4 {
+		t.Fatalf("주문 요청 = %+v, want 0.4 시장가 매도 1건", orders)
+	}
+}
+
+func TestCancelUnfilledOrderCreatesNoPosition(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	storeWaitingBuy(t, db)
+
+	record, err := e.CancelOrder(context.Background(), "entry-1")
+	if err != nil {
+		t.Fatalf("CancelOrder 오류: %v", err)
+	}
+	if record.Status != OrderStatusCancel {
+		t.Fatalf("주문 상태 = %s, want CANCEL", record.Status)
+	}
+
+	var positions int64
+	db.Model(&model.Position{}).Count(&positions)
+	if positions != 0 {
+		t.Fatalf("포지션 %d개, want 0", positions)
+	}
+}
@@ -0,0 +1,59 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GetCandlesRange from부터 to 이전까지의 캔들을 시간순으로 조회
+// 요청당 최대 개수씩 to를 과거로 옮겨 가며 조회하고, 페이지 경계에서 겹친 캔들은 시각 기준으로 한 번만 담는다.
+// to가 0이면 현재 캔들부터 조회한다.
+func (c *UpbitClient) GetCandlesRange(ctx context.Context, marketID, timeframe string, from, to time.Time) ([]Candle, error) {
+	var newestFirst []Candle
+	seen := make(map[string]bool)
+	cursor := to
+
+	for {
+		page, err := c.GetCandlesBefore(ctx, marketID, timeframe, maxCandlesPerRequest, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		earliest := cursor
+		for _, candle := range page {
+			timestamp, err := time.Parse(candleTimeLayout, candle.CandleDateTimeUTC)
+			if err != nil {
+				return nil, fmt.Errorf("캔들 시각 파싱 실패: %w", err)
+			}
+			if earliest.IsZero() || timestamp.Before(earliest) {
+				earliest = timestamp
+			}
+			if timestamp.Before(from) || seen[candle.CandleDateTimeUTC] {
+				continue
+			}
+			seen[candle.CandleDateTimeUTC] = true
+			newestFirst = append(newestFirst, candle)
+		}
+
+		// 마지막 페이지이거나 더 과거로 진행하지 못하면 종료한다
+		if len(page) < maxCandlesPerRequest || !earliest.After(from) || (!cursor.IsZero() && !earliest.Before(cursor)) {
+			break
+		}
+		cursor = earliest
+
+		// 시세 조회 요청 한도를 넘지 않도록 페이지 사이에 대기한다
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second / quotationRequestsPerSecond):
+		}
+	}
+
+	result := make([]Candle, len(newestFirst))
+	for i, candle := range newestFirst {
+		result[len(newestFirst)-1-i] = candle
+	}
+
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestGetCandlesChunksLargeCounts(t *testing.T) {
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	server := &candleServer{start: end.Add(-1000 * time.Minute), end: end}
+	c := newTestClient(t, server, WithQuotationRateLimit(100))
+
+	candles, err := c.GetCandles(context.Background(), "KRW-BTC", "minutes/1", 450)
+	if err != nil {
+		t.Fatalf("GetCandles 오류: %v", err)
+	}
+	if len(candles) != 450 {
+		t.Fatalf("캔들 수 = %d, want 450", len(candles))
+	}
+	if tos := server.requestedTos(); len(tos) != 3 || tos[0] != "" {
+		t.Fatalf("요청한 to = %v, want 최신부터 3번", tos)
+	}
+
+	// 최신순으로 빠짐없이 이어진다
+	seen := make(map[string]bool, len(candles))
+	for i, candle := range candles {
+		if seen[candle.CandleDateTimeUTC] {
+			t.Fatalf("중복 캔들: %s", candle.CandleDateTimeUTC)
+		}
+		seen[candle.CandleDateTimeUTC] = true
+
+		want := end.Add(-time.Duration(i+1) * time.Minute).Format(candleTimeLayout)
+		if candle.CandleDateTimeUTC != want {
+			t.Fatalf("%d번째 캔들 = %s, want %s", i, candle.CandleDateTimeUTC, want)
+		}
+	}
+}
+
+func TestGetCandlesStopsAtOldestAvailable(t *testing.T) {
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	server := &candleServer{start: end.Add(-300 * time.Minute), end: end}
+	c := newTestClient(t, server, WithQuotationRateLimit(100))
+
+	candles, err := c.GetCandles(context.Background(), "KRW-BTC", "minutes/1", 600)
+	if err != nil {
+		t.Fatalf("GetCandles 오류: %v", err)
+	}
+	if len(candles) != 300 || len(server.requestedTos()) != 2 {
+		t.Fatalf("캔들 %d개, 요청 %d번, want 300개, 2번", len(candles), len(server.requestedTos()))
+	}
+}
+
+func TestGetCandlesCapsCountWithoutChunking(t *testing.T) {
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	server := &candleServer{start: end.Add(-1000 * time.Minute), end: end}
+	c := newTestClient(t, server, WithCandleChunking(false))
+
+	candles, err := c.GetCandles(context.Background(), "KRW-BTC", "minutes/1", 450)
+	if err != nil {
+		t.Fatalf("GetCandles 오류: %v", err)
+	}
+	if len(candles) != maxCandlesPerRequest || len(server.requestedTos()) != 1 {
+		t.Fatalf("캔들 %d개, 요청 %d번, want %d개, 1번", len(candles), len(server.requestedTos()), maxCandlesPerRequest)
+	}
+}
+
+func TestGetCandlesUsesSecondsEndpoint(t *testing.T) {
+	var path string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		json.NewEncoder(w).Encode([]Candle{{MarketID: "KRW-BTC", CandleDateTimeUTC: "2024-01-01T00:00:01", TradePrice: 100}})
+	}))
+
+	candles, err := c.GetCandles(context.Background(), "KRW-BTC", "seconds", 1)
+	if err != nil {
+		t.Fatalf("GetCandles 오류: %v", err)
+	}
+	if path != "/v1/candles/seconds" || len(candles) != 1 {
+		t.Fatalf("요청 경로 = %s, 캔들 %d개, want /v1/candles/seconds, 1개", path, len(candles))
+	}
+
+	if _, err := c.GetCandles(context.Background(), "KRW-BTC", "seconds/1", 1); !errors.Is(err, ErrUnsupportedTimeframe) {
+		t.Fatalf("seconds/1 오류 = %v, want ErrUnsupportedTimeframe", err)
+	}
+}
+
+func TestTimeframeDurationSeconds(t *testing.T) {
+	if d, err := TimeframeDuration("seconds"); err != nil || d != time.Second {
+		t.Fatalf("seconds 간격 = %v, %v, want 1s", d, err)
+	}
+	if _, err := TimeframeDuration("minutes"); !errors.Is(err, ErrInvalidTimeframe) {
+		t.Fatalf("minutes 오류 = %v, want ErrInvalidTimeframe", err)
+	}
+}
+
+func TestSecondsCandlesDoNotCollideWithMinutes(t *testing.T) {
+	db := newTestDB(t)
+	ts := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	for _, timeframe := range []string{"seconds", "minutes/1"} {
+		candle := model.Candlestick{MarketID: "KRW-BTC", Timeframe: timeframe, Timestamp: ts, Open: 100, High: 100, Low: 100, Close: 100}
+		if err := db.Create(&candle).Error; err != nil {
+			t.Fatalf("%s 캔들 저장 실패: %v", timeframe, err)
+		}
+	}
+
+	var count int64
+	db.Model(&model.Candlestick{}).Where("timeframe = ?", "seconds").Count(&count)
+	if count != 1 {
+		t.Fatalf("초봉 %d개, want 1", count)
+	}
+}
@@ -0,0 +1,184 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// orderChanceTTL 주문 가능 정보 캐시 유효 시간
+// 수수료와 최소 주문 금액은 자주 바뀌지 않으므로 주문마다 조회하지 않는다.
+const orderChanceTTL = 10 * time.Minute
+
+// ErrBelowMarketMinTotal 주문 금액이 마켓 최소 주문 금액 미만
+var ErrBelowMarketMinTotal = errors.New("주문 금액이 마켓 최소 주문 금액보다 작습니다")
+
+// OrderChance 마켓별 주문 가능 정보
+type OrderChance struct {
+	BidFee      string            `json:"bid_fee"` // 매수 수수료 비율 (0.0005 = 0.05%)
+	AskFee      string            `json:"ask_fee"`
+	MakerBidFee string            `json:"maker_bid_fee"`
+	MakerAskFee string            `json:"maker_ask_fee"`
+	Market      OrderChanceMarket `json:"market"`
+	BidAccount  Account           `json:"bid_account"` // 매수 시 사용하는 화폐 잔고 (KRW 등)
+	AskAccount  Account           `json:"ask_account"` // 매도 시 사용하는 화폐 잔고 (코인)
+}
+
+// OrderChanceMarket 마켓 주문 제약
+type OrderChanceMarket struct {
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	OrderTypes []string              `json:"order_types"`
+	OrderSides []string              `json:"order_sides"`
+	Bid        OrderChanceConstraint `json:"bid"`
+	Ask        OrderChanceConstraint `json:"ask"`
+	MaxTotal   string                `json:"max_total"`
+	State      string                `json:"state"`
+}
+
+// OrderChanceConstraint 주문 방향별 제약
+type OrderChanceConstraint struct {
+	Currency string      `json:"currency"`
+	MinTotal json.Number `json:"min_total"` // 응답에 따라 숫자 또는 문자열
+}
+
+// FeeRate 주문 방향의 테이커 수수료율 (%)
+func (o *OrderChance) FeeRate(side string) (float64, bool) {
+	fee := o.AskFee
+	if side == "bid" {
+		fee = o.BidFee
+	}
+
+	rate, err := strconv.ParseFloat(fee, 64)
+	if err != nil {
+		return 0, false
+	}
+	return rate * 100, true
+}
+
+// MinTotal 주문 방향의 최소 주문 금액 (알 수 없으면 0)
+func (o *OrderChance) MinTotal(side string) float64 {
+	constraint := o.Market.Ask
+	if side == "bid" {
+		constraint = o.Market.Bid
+	}
+
+	minTotal, err := constraint.MinTotal.Float64()
+	if err != nil {
+		return 0
+	}
+	return minTotal
+}
+
+// GetOrderChance 마켓별 주문 가능 정보 조회 (수수료, 최소 주문 금액, 잔고)
+func (c *UpbitClient) GetOrderChance(ctx context.Context, marketID string) (*OrderChance, error) {
+	params := map[string]string{
+		"market": marketID,
+	}
+	url := fmt.Sprintf("%s/orders/chance?market=%s", upbitAPIURL, marketID)
+
+	build := func() (*http.Request, error) {
+		return c.newAuthRequest(ctx, "GET", url, params, nil)
+	}
+
+	var chance OrderChance
+	if err := c.doRequest(ctx, c.orderLimiter, build, http.StatusOK, &chance); err != nil {
+		return nil, err
+	}
+
+	return &chance, nil
+}
+
+// chanceCache 마켓별 주문 가능 정보 캐시
+type chanceCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedChance
+}
+
+// cachedChance 캐시된 주문 가능 정보
+type cachedChance struct {
+	chance    *OrderChance
+	fetchedAt time.Time
+}
+
+// get 유효한 캐시 항목 조회
+func (c *chanceCache) get(marketID string, now time.Time) (*OrderChance, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[marketID]
+	if !ok || now.Sub(entry.fetchedAt) > orderChanceTTL {
+		return nil, false
+	}
+	return entry.chance, true
+}
+
+// put 캐시 항목 저장
+func (c *chanceCache) put(marketID string, chance *OrderChance, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]cachedChance)
+	}
+	c.entries[marketID] = cachedChance{chance: chance, fetchedAt: now}
+}
+
+// orderChance 캐시를 거친 마켓 주문 가능 정보
+func (e *OrderExecutor) orderChance(ctx context.Context, marketID string) (*OrderChance, error) {
+	now := time.Now()
+	if chance, ok := e.chances.get(marketID, now); ok {
+		return chance, nil
+	}
+
+	chance, err := e.client.GetOrderChance(ctx, marketID)
+	if err != nil {
+		return nil, fmt.Errorf("주문 가능 정보 조회 실패: %w", err)
+	}
+	e.chances.put(marketID, chance, now)
+
+	return chance, nil
+}
+
+// checkMarketMinTotal 마켓별 최소 주문 금액 확인
+// 거래소가 거부할 주문을 API 호출 전에 미리 막는다. 시장가 매도는 주문 금액을 알 수 없어 확인하지 않는다.
+func (e *OrderExecutor) checkMarketMinTotal(ctx context.Context, order Order) error {
+	if !e.cfg.CheckOrderChance {
+		return nil
+	}
+
+	notional := orderNotional(order)
+	if notional == 0 {
+		return nil
+	}
+
+	chance, err := e.orderChance(ctx, order.MarketID)
+	if err != nil {
+		return err
+	}
+
+	if minTotal := chance.MinTotal(order.Side); notional < minTotal {
+		return fmt.Errorf("%w: %s %.2f원 (최소 %.0f원)", ErrBelowMarketMinTotal, order.MarketID, notional, minTotal)
+	}
+
+	return nil
+}
+
+// marketFeeRate 수수료 추정에 사용할 마켓 수수료율 (%)
+// 주문 가능 정보(GetOrderChance, 캐시 사용)의 마켓 수수료를 쓰고, 조회에 실패하면 설정된 수수료율을 사용한다.
+func (e *OrderExecutor) marketFeeRate(ctx context.Context, marketID, side string) float64 {
+	chance, err := e.orderChance(ctx, marketID)
+	if err != nil {
+		e.logger.Error("마켓 수수료율 조회 실패, 설정 수수료율 사용:", marketID, err)
+		return e.feeRate()
+	}
+	if rate, ok := chance.FeeRate(side); ok {
+		return rate
+	}
+	return e.feeRate()
+}
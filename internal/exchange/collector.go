@@ -0,0 +1,310 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/storage"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	// quotationRequestsPerSecond 시세 조회 API 초당 요청 한도
+	quotationRequestsPerSecond = 10
+	collectorCandleCount       = 2
+	defaultDelistAfterMisses   = 3
+
+	// DataTypeDelisted 상장 폐지로 수집을 중단한 마켓 알림
+	DataTypeDelisted = "delisted"
+)
+
+// defaultPollIntervals 데이터 유형별 기본 폴링 주기
+var defaultPollIntervals = map[string]time.Duration{
+	DataTypeTicker: 5 * time.Second,
+	"minutes/1":    time.Minute,
+	"days":         time.Hour,
+}
+
+// DataCollector 시장 데이터 수집기
+type DataCollector struct {
+	client *UpbitClient
+	db     *gorm.DB
+	dataCh chan<- MarketData
+	cfg    config.CollectorConfig
+	logger *utils.Logger
+
+	mu       sync.Mutex
+	markets  []string
+	misses   map[string]int
+	delisted map[string]bool
+
+	coldIntervals map[string]time.Duration        // 유휴 마켓 폴링 주기 (적응형 폴링)
+	lastPolled    map[string]map[string]time.Time // 데이터 유형별 마켓 마지막 폴링 시각
+	hot           map[string]bool                 // 활성 마켓 (열린 포지션 또는 최근 신호)
+	hotAt         time.Time
+
+	candleBatchSize int // 캔들 일괄 저장 배치 크기
+
+	wg sync.WaitGroup
+}
+
+// CollectorOption 시장 데이터 수집기 옵션
+type CollectorOption func(*DataCollector)
+
+// WithCandleBatchSize 캔들 일괄 저장 배치 크기 설정 (0 이하면 기본값)
+func WithCandleBatchSize(n int) CollectorOption {
+	return func(d *DataCollector) {
+		d.candleBatchSize = n
+	}
+}
+
+// NewDataCollector 새로운 시장 데이터 수집기 생성
+func NewDataCollector(client *UpbitClient, db *gorm.DB, dataCh chan<- MarketData, cfg *config.CollectorConfig, opts ...CollectorOption) *DataCollector {
+	if cfg == nil {
+		cfg = &config.CollectorConfig{}
+	}
+
+	d := &DataCollector{
+		client:          client,
+		db:              db,
+		dataCh:          dataCh,
+		cfg:             *cfg,
+		logger:          utils.NewLogger("collector"),
+		misses:          make(map[string]int),
+		delisted:        make(map[string]bool),
+		lastPolled:      make(map[string]map[string]time.Time),
+		candleBatchSize: storage.DefaultCandleBatchSize,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// Start 데이터 수집 시작
+// 데이터 유형(ticker 또는 캔들 타임프레임)마다 설정된 주기로 폴링하며, ctx가 취소되면 종료한다.
+func (d *DataCollector) Start(ctx context.Context) {
+	markets, err := d.loadMarkets(ctx)
+	if err != nil {
+		d.logger.Error("수집 대상 마켓 조회 실패:", err)
+		return
+	}
+	d.mu.Lock()
+	d.markets = markets
+	d.mu.Unlock()
+
+	if d.cfg.WebSocket {
+		types := []string{DataTypeTicker}
+		if d.cfg.WebSocketOrderbook {
+			types = append(types, DataTypeOrderbook)
+		}
+		if d.cfg.WebSocketTrade {
+			types = append(types, DataTypeTrade)
+		}
+
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.client.MaintainWebSocketConnection(markets, types, d.dataCh, ctx.Done())
+		}()
+	}
+
+	if d.cfg.Backfill.Enabled {
+		d.wg.Add(1)
+		go d.backfillAll(ctx, markets)
+	}
+
+	intervals, err := d.pollIntervals()
+	if err != nil {
+		d.logger.Error("폴링 주기 설정 오류:", err)
+		return
+	}
+	adjusted, scaled := limitPollRate(intervals, len(markets), quotationRequestsPerSecond)
+	if scaled {
+		for dataType, interval := range intervals {
+			d.logger.Warn("요청 한도 초과로 폴링 주기 조정:", dataType, interval, "->", adjusted[dataType])
+		}
+		intervals = adjusted
+	}
+	if d.cfg.Adaptive.Enabled {
+		d.coldIntervals, err = d.coldPollIntervals(intervals)
+		if err != nil {
+			d.logger.Error("폴링 주기 설정 오류:", err)
+			return
+		}
+	}
+
+	for dataType, interval := range intervals {
+		d.logger.Info("데이터 수집 시작:", dataType, interval)
+		d.wg.Add(1)
+		go d.poll(ctx, dataType, interval)
+	}
+
+	if d.cfg.GapCheck.Enabled {
+		var timeframes []string
+		for dataType := range intervals {
+			if dataType != DataTypeTicker {
+				timeframes = append(timeframes, dataType)
+			}
+		}
+		d.wg.Add(1)
+		go d.checkGaps(ctx, timeframes)
+	}
+}
+
+// Wait 수집 고루틴 종료 대기
+func (d *DataCollector) Wait() {
+	d.wg.Wait()
+}
+
+// loadMarkets 수집 대상 마켓 목록 조회
+func (d *DataCollector) loadMarkets(ctx context.Context) ([]string, error) {
+	if len(d.cfg.Markets) > 0 {
+		return d.cfg.Markets, nil
+	}
+
+	markets, err := d.client.GetMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(markets))
+	for i, market := range markets {
+		ids[i] = market.MarketID
+	}
+
+	return ids, nil
+}
+
+// pollIntervals 데이터 유형별 폴링 주기
+func (d *DataCollector) pollIntervals() (map[string]time.Duration, error) {
+	if len(d.cfg.PollIntervals) == 0 {
+		intervals := make(map[string]time.Duration, len(defaultPollIntervals))
+		for dataType, interval := range defaultPollIntervals {
+			intervals[dataType] = interval
+		}
+		return intervals, nil
+	}
+
+	intervals := make(map[string]time.Duration, len(d.cfg.PollIntervals))
+	for dataType, value := range d.cfg.PollIntervals {
+		if dataType != DataTypeTicker {
+			if _, err := TimeframeDuration(dataType); err != nil {
+				return nil, err
+			}
+		}
+
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("잘못된 폴링 주기: %s=%s", dataType, value)
+		}
+		intervals[dataType] = interval
+	}
+
+	return intervals, nil
+}
+
+// limitPollRate 폴링 주기를 요청 한도에 맞게 조정
+// 각 폴링은 마켓마다 한 번씩 요청하므로 전체 초당 요청 수가 한도를 넘으면 모든 주기를 같은 비율로 늘린다.
+func limitPollRate(intervals map[string]time.Duration, marketCount int, limit float64) (map[string]time.Duration, bool) {
+	rate := 0.0
+	for _, interval := range intervals {
+		rate += float64(marketCount) / interval.Seconds()
+	}
+	if rate <= limit {
+		return intervals, false
+	}
+
+	scale := rate / limit
+	adjusted := make(map[string]time.Duration, len(intervals))
+	for dataType, interval := range intervals {
+		adjusted[dataType] = time.Duration(float64(interval) * scale)
+	}
+
+	return adjusted, true
+}
+
+// poll 데이터 유형 폴링 루프
+// 적응형 폴링이 켜져 있으면 유휴 마켓은 유휴 주기마다만 조회한다.
+func (d *DataCollector) poll(ctx context.Context, dataType string, interval time.Duration) {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, marketID := range d.pollTargets(dataType, d.activeMarkets(), interval, now) {
+				var err error
+				if dataType == DataTypeTicker {
+					err = d.collectTicker(ctx, marketID)
+				} else {
+					err = d.collectCandles(ctx, marketID, dataType)
+				}
+				if errors.Is(err, context.Canceled) {
+					return
+				}
+				if err != nil {
+					d.logger.Error("데이터 수집 실패:", dataType, marketID, err)
+				}
+			}
+		}
+	}
+}
+
+// collectTicker 현재가 수집
+func (d *DataCollector) collectTicker(ctx context.Context, marketID string) error {
+	ticker, err := d.client.GetTicker(ctx, marketID)
+	if errors.Is(err, ErrTickerNotFound) {
+		d.recordMiss(ctx, marketID)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	d.resetMisses(marketID)
+
+	data := MarketData{
+		Type:       DataTypeTicker,
+		MarketID:   ticker.MarketID,
+		Timestamp:  ticker.Timestamp,
+		TradePrice: ticker.TradePrice,
+	}
+
+	select {
+	case d.dataCh <- data:
+	case <-ctx.Done():
+	}
+
+	return nil
+}
+
+// collectCandles 최근 캔들 수집 및 저장
+func (d *DataCollector) collectCandles(ctx context.Context, marketID, timeframe string) error {
+	candles, err := d.client.GetCandles(ctx, marketID, timeframe, collectorCandleCount)
+	if err != nil {
+		return err
+	}
+
+	candlesticks, err := ToCandlesticks(candles, timeframe)
+	if err != nil {
+		return err
+	}
+
+	return d.saveCandles(candlesticks)
+}
+
+// saveCandles 캔들 일괄 저장 (이미 있으면 갱신)
+func (d *DataCollector) saveCandles(candlesticks []model.Candlestick) error {
+	return storage.SaveCandles(d.db, candlesticks, d.candleBatchSize)
+}
@@ -0,0 +1,71 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+func TestPollIntervalsUsesConfiguredValues(t *testing.T) {
+	d := NewDataCollector(nil, nil, nil, &config.CollectorConfig{
+		PollIntervals: map[string]string{DataTypeTicker: "2s", "minutes/5": "30s"},
+	})
+
+	intervals, err := d.pollIntervals()
+	if err != nil {
+		t.Fatalf("pollIntervals 오류: %v", err)
+	}
+	if len(intervals) != 2 || intervals[DataTypeTicker] != 2*time.Second || intervals["minutes/5"] != 30*time.Second {
+		t.Fatalf("폴링 주기 = %v, want ticker 2s, minutes/5 30s", intervals)
+	}
+}
+
+func TestPollIntervalsDefaultsWhenUnset(t *testing.T) {
+	d := NewDataCollector(nil, nil, nil, &config.CollectorConfig{})
+
+	intervals, err := d.pollIntervals()
+	if err != nil {
+		t.Fatalf("pollIntervals 오류: %v", err)
+	}
+	if intervals[DataTypeTicker] != 5*time.Second || intervals["minutes/1"] != time.Minute || intervals["days"] != time.Hour {
+		t.Fatalf("기본 폴링 주기 = %v", intervals)
+	}
+
+	// 돌려받은 값을 고쳐도 기본값은 바뀌지 않는다
+	intervals[DataTypeTicker] = time.Millisecond
+	if defaultPollIntervals[DataTypeTicker] != 5*time.Second {
+		t.Fatal("기본 폴링 주기가 변경됨")
+	}
+}
+
+func TestPollIntervalsRejectsInvalidEntries(t *testing.T) {
+	cases := map[string]map[string]string{
+		"알 수 없는 데이터 유형": {"hours": "1m"},
+		"잘못된 주기":        {DataTypeTicker: "soon"},
+		"0 이하 주기":       {DataTypeTicker: "0s"},
+	}
+	for name, intervals := range cases {
+		d := NewDataCollector(nil, nil, nil, &config.CollectorConfig{PollIntervals: intervals})
+		if _, err := d.pollIntervals(); err == nil {
+			t.Errorf("%s: 오류 없이 통과", name)
+		}
+	}
+}
+
+func TestLimitPollRateScalesToRequestLimit(t *testing.T) {
+	intervals := map[string]time.Duration{DataTypeTicker: time.Second, "minutes/1": 2 * time.Second}
+
+	// 10개 마켓: 초당 10 + 5 = 15회, 한도 10회면 1.5배로 늘린다
+	adjusted, changed := limitPollRate(intervals, 10, quotationRequestsPerSecond)
+	if !changed {
+		t.Fatal("한도를 넘는 주기가 조정되지 않음")
+	}
+	if adjusted[DataTypeTicker] != 1500*time.Millisecond || adjusted["minutes/1"] != 3*time.Second {
+		t.Fatalf("조정된 주기 = %v, want ticker 1.5s, minutes/1 3s", adjusted)
+	}
+
+	if _, changed := limitPollRate(intervals, 2, quotationRequestsPerSecond); changed {
+		t.Fatal("한도 이내 주기가 조정됨")
+	}
+}
@@ -0,0 +1,40 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// ErrLowConfidence 신호 신뢰도가 기준 미만
+var ErrLowConfidence = errors.New("신호 신뢰도가 최소 기준보다 낮습니다")
+
+// effectiveConfidence 대기 시간에 따라 감쇠된 신뢰도
+// 반감기마다 신뢰도가 절반으로 줄어든다. 반감기가 0 이하이면 감쇠하지 않는다.
+func effectiveConfidence(confidence float64, age, halfLife time.Duration) float64 {
+	if halfLife <= 0 || age <= 0 {
+		return confidence
+	}
+
+	return confidence * math.Pow(0.5, age.Seconds()/halfLife.Seconds())
+}
+
+// checkConfidence 진입 신호의 감쇠 신뢰도 확인
+// 다른 주문에 밀려 늦게 처리된 신호는 신뢰도가 줄어 최소 기준 아래로 내려가면 건너뛴다.
+func (e *OrderExecutor) checkConfidence(signal model.Signal, now time.Time) error {
+	if e.cfg.MinConfidence <= 0 {
+		return nil
+	}
+
+	halfLife := time.Duration(e.cfg.ConfidenceHalfLifeSeconds) * time.Second
+	confidence := effectiveConfidence(signal.Confidence, now.Sub(signal.Timestamp), halfLife)
+	if confidence < e.cfg.MinConfidence {
+		return fmt.Errorf("%w: %.3f (원래 %.3f, 대기 %s)", ErrLowConfidence,
+			confidence, signal.Confidence, now.Sub(signal.Timestamp).Round(time.Millisecond))
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+func TestEffectiveConfidenceHalvesEachHalfLife(t *testing.T) {
+	cases := []struct {
+		age  time.Duration
+		want float64
+	}{
+		{0, 0.8},
+		{10 * time.Second, 0.4},
+		{20 * time.Second, 0.2},
+		{5 * time.Second, 0.8 / math.Sqrt2},
+	}
+	for _, c := range cases {
+		if got := effectiveConfidence(0.8, c.age, 10*time.Second); math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("대기 %s 신뢰도 = %v, want %v", c.age, got, c.want)
+		}
+	}
+
+	if got := effectiveConfidence(0.8, time.Hour, 0); got != 0.8 {
+		t.Fatalf("반감기 0일 때 신뢰도 = %v, want 0.8 (감쇠 없음)", got)
+	}
+}
+
+func TestEnterSkipsSignalDecayedBelowMinimum(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{MaxPositionSize: 10, MinConfidence: 0.5, ConfidenceHalfLifeSeconds: 10})
+
+	// 신뢰도 0.8인 신호가 10초 기다리면 0.4로 줄어 기준 0.5 아래가 된다
+	stale := buySignal("KRW-BTC", 100000)
+	stale.Confidence = 0.8
+	stale.Timestamp = time.Now().Add(-10 * time.Second)
+	if err := e.enter(context.Background(), stale); !errors.Is(err, ErrLowConfidence) {
+		t.Fatalf("오래된 신호 진입 오류 = %v, want ErrLowConfidence", err)
+	}
+	if n := len(client.createdOrders()); n != 0 {
+		t.Fatalf("건너뛴 신호로 주문 %d건", n)
+	}
+
+	fresh := buySignal("KRW-BTC", 100000)
+	fresh.Confidence = 0.8
+	if err := e.enter(context.Background(), fresh); err != nil {
+		t.Fatalf("새 신호 진입 실패: %v", err)
+	}
+}
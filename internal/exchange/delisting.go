@@ -0,0 +1,60 @@
+package exchange
+
+import "context"
+
+// activeMarkets 상장 폐지로 제외된 마켓을 뺀 수집 대상 목록
+func (d *DataCollector) activeMarkets() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	active := make([]string, 0, len(d.markets))
+	for _, marketID := range d.markets {
+		if !d.delisted[marketID] {
+			active = append(active, marketID)
+		}
+	}
+
+	return active
+}
+
+// resetMisses 현재가 조회 성공 시 연속 실패 횟수 초기화
+func (d *DataCollector) resetMisses(marketID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.misses, marketID)
+}
+
+// recordMiss 빈 현재가 응답 기록
+// 연속으로 설정 횟수만큼 현재가가 비어 있으면 상장 폐지된 마켓으로 보고 폴링 대상에서 제외한 뒤
+// 전략 관리자가 포지션을 정리하고 평가를 멈출 수 있도록 알린다.
+// 웹소켓 구독은 연결 시점에 고정되므로 그대로 두며, 폐지된 마켓은 더 이상 데이터를 보내지 않는다.
+func (d *DataCollector) recordMiss(ctx context.Context, marketID string) {
+	threshold := d.cfg.DelistAfterMisses
+	if threshold <= 0 {
+		threshold = defaultDelistAfterMisses
+	}
+
+	d.mu.Lock()
+	if d.delisted[marketID] {
+		d.mu.Unlock()
+		return
+	}
+	d.misses[marketID]++
+	delisted := d.misses[marketID] >= threshold
+	if delisted {
+		d.delisted[marketID] = true
+		delete(d.misses, marketID)
+	}
+	d.mu.Unlock()
+
+	if !delisted {
+		return
+	}
+
+	d.logger.Error("상장 폐지 마켓 감지, 수집 중단:", marketID)
+	select {
+	case d.dataCh <- MarketData{Type: DataTypeDelisted, MarketID: marketID}:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,76 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+func TestCollectTickerMarksMarketDelistedAfterMisses(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	dataCh := make(chan MarketData, 1)
+	d := NewDataCollector(client, nil, dataCh, &config.CollectorConfig{DelistAfterMisses: 2})
+	d.markets = []string{"KRW-BTC", "KRW-OLD"}
+	ctx := context.Background()
+
+	if err := d.collectTicker(ctx, "KRW-OLD"); !errors.Is(err, ErrTickerNotFound) {
+		t.Fatalf("collectTicker 오류 = %v, want ErrTickerNotFound", err)
+	}
+	if len(dataCh) != 0 || len(d.activeMarkets()) != 2 {
+		t.Fatal("한 번의 빈 응답으로 상장 폐지 처리됨")
+	}
+
+	d.collectTicker(ctx, "KRW-OLD")
+	select {
+	case data := <-dataCh:
+		if data.Type != DataTypeDelisted || data.MarketID != "KRW-OLD" {
+			t.Fatalf("알림 = %+v, want KRW-OLD 상장 폐지", data)
+		}
+	default:
+		t.Fatal("상장 폐지 알림이 전달되지 않음")
+	}
+	if active := d.activeMarkets(); len(active) != 1 || active[0] != "KRW-BTC" {
+		t.Fatalf("수집 대상 = %v, want KRW-BTC", active)
+	}
+
+	// 이미 제외된 마켓은 다시 알리지 않는다
+	d.collectTicker(ctx, "KRW-OLD")
+	d.collectTicker(ctx, "KRW-OLD")
+	if len(dataCh) != 0 {
+		t.Fatal("상장 폐지 알림이 중복 전달됨")
+	}
+}
+
+func TestCollectTickerResetsMissesOnSuccess(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 빈 응답과 정상 응답을 번갈아 보낸다
+		if calls.Add(1)%2 == 1 {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"market":"KRW-BTC","trade_price":100}]`))
+	}))
+	dataCh := make(chan MarketData, 10)
+	d := NewDataCollector(client, nil, dataCh, &config.CollectorConfig{DelistAfterMisses: 2})
+	d.markets = []string{"KRW-BTC"}
+
+	for i := 0; i < 6; i++ {
+		d.collectTicker(context.Background(), "KRW-BTC")
+	}
+	close(dataCh)
+	for data := range dataCh {
+		if data.Type == DataTypeDelisted {
+			t.Fatal("연속되지 않은 빈 응답으로 상장 폐지 처리됨")
+		}
+	}
+	if active := d.activeMarkets(); len(active) != 1 {
+		t.Fatalf("수집 대상 = %v, want KRW-BTC", active)
+	}
+}
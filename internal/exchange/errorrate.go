@@ -0,0 +1,79 @@
+package exchange
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultErrorRateWindow = 5 * time.Minute
+
+// callResult API 호출 결과
+type callResult struct {
+	at     time.Time
+	failed bool
+}
+
+// errorRateTracker 최근 구간의 API 오류율 추적
+type errorRateTracker struct {
+	mu      sync.Mutex
+	window  time.Duration
+	results []callResult
+}
+
+// newErrorRateTracker 새로운 오류율 추적기 생성
+func newErrorRateTracker(window time.Duration) *errorRateTracker {
+	if window <= 0 {
+		window = defaultErrorRateWindow
+	}
+	return &errorRateTracker{window: window}
+}
+
+// record 호출 결과 기록
+func (t *errorRateTracker) record(now time.Time, failed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.results = append(t.results, callResult{at: now, failed: failed})
+	t.prune(now)
+}
+
+// rate 구간 내 오류율(%)과 호출 수
+func (t *errorRateTracker) rate(now time.Time) (float64, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.prune(now)
+	if len(t.results) == 0 {
+		return 0, 0
+	}
+
+	failed := 0
+	for _, result := range t.results {
+		if result.failed {
+			failed++
+		}
+	}
+
+	return float64(failed) / float64(len(t.results)) * 100, len(t.results)
+}
+
+// prune 구간을 벗어난 기록 제거
+func (t *errorRateTracker) prune(now time.Time) {
+	cutoff := now.Add(-t.window)
+	i := 0
+	for i < len(t.results) && t.results[i].at.Before(cutoff) {
+		i++
+	}
+	t.results = t.results[i:]
+}
+
+// ErrorRate 최근 구간의 API 오류율(%)과 호출 수
+// 네트워크 오류, 5xx, 429 응답을 업비트 장애로 보고 오류로 집계한다.
+func (c *UpbitClient) ErrorRate() (float64, int) {
+	return c.errorRate.rate(time.Now())
+}
+
+// isServerFailure 업비트 측 장애로 볼 상태 코드인지 여부
+func isServerFailure(statusCode int) bool {
+	return statusCode >= 500 || statusCode == 429
+}
@@ -0,0 +1,37 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+)
+
+func TestErrorRateTrackerCountsWithinWindow(t *testing.T) {
+	tracker := newErrorRateTracker(time.Minute)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tracker.record(start, true)
+	tracker.record(start.Add(10*time.Second), false)
+	tracker.record(start.Add(20*time.Second), true)
+	tracker.record(start.Add(30*time.Second), false)
+
+	if rate, total := tracker.rate(start.Add(30 * time.Second)); rate != 50 || total != 4 {
+		t.Fatalf("오류율 = %v%% / %d건, want 50%% / 4건", rate, total)
+	}
+
+	// 구간을 벗어난 첫 실패는 빠진다
+	if rate, total := tracker.rate(start.Add(65 * time.Second)); total != 3 || rate < 33.3 || rate > 33.4 {
+		t.Fatalf("구간 이동 후 오류율 = %v%% / %d건, want 33.3%% / 3건", rate, total)
+	}
+
+	if rate, total := tracker.rate(start.Add(time.Hour)); rate != 0 || total != 0 {
+		t.Fatalf("기록이 없을 때 오류율 = %v%% / %d건, want 0", rate, total)
+	}
+}
+
+func TestIsServerFailure(t *testing.T) {
+	for code, want := range map[int]bool{200: false, 400: false, 404: false, 429: true, 500: true, 503: true} {
+		if got := isServerFailure(code); got != want {
+			t.Errorf("isServerFailure(%d) = %v, want %v", code, got, want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package exchange
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrRequestFailed 네트워크 오류 등으로 요청 전송 실패
+	ErrRequestFailed = errors.New("요청 전송 실패")
+	// ErrUnexpectedStatus 기대하지 않은 HTTP 상태 코드 응답
+	ErrUnexpectedStatus = errors.New("API 오류")
+	// ErrDecodeResponse 응답 JSON 파싱 실패
+	ErrDecodeResponse = errors.New("응답 파싱 실패")
+	// ErrTickerNotFound 티커 정보 없음
+	ErrTickerNotFound = errors.New("티커 정보가 없습니다")
+	// ErrNoMarkets 조회할 마켓 목록이 비어 있음
+	ErrNoMarkets = errors.New("조회할 마켓이 없습니다")
+	// ErrOrderbookNotFound 호가 정보 없음
+	ErrOrderbookNotFound = errors.New("호가 정보가 없습니다")
+	// ErrInvalidTimeframe 잘못된 타임프레임 형식
+	ErrInvalidTimeframe = errors.New("잘못된 타임프레임 형식")
+	// ErrUnsupportedTimeframe 지원되지 않는 타임프레임
+	ErrUnsupportedTimeframe = errors.New("지원되지 않는 타임프레임")
+	// ErrWebSocketConnect 웹소켓 연결 실패
+	ErrWebSocketConnect = errors.New("웹소켓 연결 실패")
+	// ErrWebSocketSubscribe 웹소켓 구독 요청 실패
+	ErrWebSocketSubscribe = errors.New("웹소켓 구독 요청 실패")
+	// ErrPriceOutOfRange 주문 가격이 현재가 대비 허용 범위를 벗어남
+	ErrPriceOutOfRange = errors.New("주문 가격이 허용 범위를 벗어났습니다")
+	// ErrInsufficientFunds 주문 가능 잔고 부족 (매수 원화 또는 매도 코인)
+	ErrInsufficientFunds = errors.New("잔고가 부족합니다")
+)
+
+// apiErrorSentinels 업비트 오류 이름별 센티널 오류
+var apiErrorSentinels = map[string]error{
+	"invalid_price_bid": ErrPriceOutOfRange,
+	"invalid_price_ask": ErrPriceOutOfRange,
+
+	APIErrorInsufficientFundsBid: ErrInsufficientFunds,
+	APIErrorInsufficientFundsAsk: ErrInsufficientFunds,
+}
+
+// 업비트 오류 이름 (UpbitAPIError.Name)
+const (
+	APIErrorInsufficientFundsBid = "insufficient_funds_bid"
+	APIErrorInsufficientFundsAsk = "insufficient_funds_ask"
+	APIErrorUnderMinTotalBid     = "under_min_total_bid"
+	APIErrorUnderMinTotalAsk     = "under_min_total_ask"
+)
+
+// UpbitAPIError 업비트 REST API 오류 응답
+// errors.As로 오류 이름에 따라 분기할 수 있고, errors.Is(err, ErrUnexpectedStatus)와
+// 이름에 대응하는 센티널 오류(ErrPriceOutOfRange 등)와의 비교도 그대로 동작한다.
+type UpbitAPIError struct {
+	StatusCode int
+	Name       string
+	Message    string // JSON이 아닌 응답이면 본문 전체
+}
+
+// Error 오류 메시지
+func (e *UpbitAPIError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("%s: %d %s", ErrUnexpectedStatus, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s: %d %s: %s", ErrUnexpectedStatus, e.StatusCode, e.Name, e.Message)
+}
+
+// Unwrap 공통 API 오류와 오류 이름에 대응하는 센티널 오류
+func (e *UpbitAPIError) Unwrap() []error {
+	if sentinel, ok := apiErrorSentinels[e.Name]; ok {
+		return []error{ErrUnexpectedStatus, sentinel}
+	}
+	return []error{ErrUnexpectedStatus}
+}
+
+// apiErrorRequestFailed 응답을 받지 못한 요청의 오류 지표 이름
+const apiErrorRequestFailed = "request_failed"
+
+// metricName 오류 지표 이름 (오류 이름이 없으면 http_상태코드)
+func (e *UpbitAPIError) metricName() string {
+	if e.Name == "" {
+		return fmt.Sprintf("http_%d", e.StatusCode)
+	}
+	return e.Name
+}
+
+// parseAPIError 오류 응답 본문 파싱
+// 업비트 오류 응답은 {"error":{"name":"...","message":"..."}} 형식이며, 파싱할 수 없으면 본문을 그대로 메시지로 쓴다.
+func parseAPIError(statusCode int, body []byte) *UpbitAPIError {
+	apiErr := &UpbitAPIError{StatusCode: statusCode}
+
+	var payload struct {
+		Error struct {
+			Name    string `json:"name"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || payload.Error.Name == "" {
+		apiErr.Message = string(body)
+		return apiErr
+	}
+
+	apiErr.Name = payload.Error.Name
+	apiErr.Message = payload.Error.Message
+	return apiErr
+}
+
+// IsAPIError 업비트 오류 응답의 이름이 name인지 여부
+func IsAPIError(err error, name string) bool {
+	var apiErr *UpbitAPIError
+	return errors.As(err, &apiErr) && apiErr.Name == name
+}
+
+// isClientError 업비트가 4xx로 거부한 요청 여부
+func isClientError(err error) bool {
+	var apiErr *UpbitAPIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= 400 && apiErr.StatusCode < 500
+}
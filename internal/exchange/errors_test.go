@@ -0,0 +1,145 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestAPIErrorUnwrapsToSentinels(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"name":"invalid_price_bid","message":"주문 가격 단위를 잘못 입력하셨습니다."}}`))
+	}))
+
+	_, err := c.GetTicker(context.Background(), "KRW-BTC")
+	if !errors.Is(err, ErrUnexpectedStatus) {
+		t.Fatalf("errors.Is(err, ErrUnexpectedStatus) = false: %v", err)
+	}
+	if !errors.Is(err, ErrPriceOutOfRange) {
+		t.Fatalf("errors.Is(err, ErrPriceOutOfRange) = false: %v", err)
+	}
+
+	var apiErr *UpbitAPIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("errors.As(err, *UpbitAPIError) = false: %v", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Name != "invalid_price_bid" {
+		t.Fatalf("오류 응답 = %d %s, want 400 invalid_price_bid", apiErr.StatusCode, apiErr.Name)
+	}
+	if !IsAPIError(err, "invalid_price_bid") {
+		t.Fatal("IsAPIError = false")
+	}
+}
+
+func TestAPIErrorWithoutJSONBodyKeepsMessage(t *testing.T) {
+	apiErr := parseAPIError(http.StatusBadGateway, []byte("<html>bad gateway</html>"))
+	if apiErr.Name != "" || apiErr.Message != "<html>bad gateway</html>" {
+		t.Fatalf("파싱 결과 = %+v", apiErr)
+	}
+	if errors.Is(apiErr, ErrPriceOutOfRange) || !errors.Is(apiErr, ErrUnexpectedStatus) {
+		t.Fatalf("센티널 비교 결과가 잘못됨: %v", apiErr)
+	}
+}
+
+func TestCreateOrderInsufficientFundsIsNotRetried(t *testing.T) {
+	var requests int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"name":"insufficient_funds_bid","message":"매수가능금액이 부족합니다."}}`))
+	}), WithRetry(3, time.Millisecond))
+
+	_, err := c.CreateOrder(context.Background(), "KRW-BTC", "bid", "limit", 0.1, 100000)
+	if !IsAPIError(err, APIErrorInsufficientFundsBid) || !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("주문 오류 = %v, want insufficient_funds_bid", err)
+	}
+	var apiErr *UpbitAPIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "매수가능금액이 부족합니다." {
+		t.Fatalf("오류 응답 = %v, want 메시지 매수가능금액이 부족합니다.", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("주문 요청 %d건, want 1 (재시도 없음)", n)
+	}
+}
+
+func TestExecutorRecordsInsufficientFundsRejection(t *testing.T) {
+	client := &fakeExchange{createErrs: []error{
+		&UpbitAPIError{StatusCode: http.StatusBadRequest, Name: APIErrorInsufficientFundsBid, Message: "매수가능금액이 부족합니다."},
+	}}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+
+	_, err := e.submit(context.Background(), Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100000, Volume: 0.1}, 7)
+	if !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("submit 오류 = %v, want ErrInsufficientFunds", err)
+	}
+	if n := len(client.createdOrders()); n != 1 {
+		t.Fatalf("주문 요청 %d건, want 1 (재시도 없음)", n)
+	}
+
+	var record model.Order
+	if err := db.Where("signal_id = ?", 7).First(&record).Error; err != nil {
+		t.Fatalf("거부된 주문 기록 없음: %v", err)
+	}
+	if record.Status != OrderStatusCancel || record.Reason != APIErrorInsufficientFundsBid {
+		t.Fatalf("거부된 주문 = %s %s, want CANCEL insufficient_funds_bid", record.Status, record.Reason)
+	}
+}
+
+func TestDecodeErrorUnwrapsToJSONError(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{not json`))
+	}))
+
+	_, err := c.GetTicker(context.Background(), "KRW-BTC")
+	if !errors.Is(err, ErrDecodeResponse) {
+		t.Fatalf("errors.Is(err, ErrDecodeResponse) = false: %v", err)
+	}
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("errors.As(err, *json.SyntaxError) = false: %v", err)
+	}
+}
+
+func TestRequestErrorUnwrapsToNetworkError(t *testing.T) {
+	c := NewUpbitClient("access", "secret", WithRetry(1, time.Millisecond))
+	c.httpClient = &http.Client{Transport: failingTransport{}}
+
+	_, err := c.GetTicker(context.Background(), "KRW-BTC")
+	if !errors.Is(err, ErrRequestFailed) {
+		t.Fatalf("errors.Is(err, ErrRequestFailed) = false: %v", err)
+	}
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		t.Fatalf("errors.As(err, *net.OpError) = false: %v", err)
+	}
+}
+
+func TestCanceledRequestUnwrapsToContextError(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.GetTicker(ctx, "KRW-BTC")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("errors.Is(err, context.Canceled) = false: %v", err)
+	}
+}
+
+// failingTransport 항상 연결 거부 오류를 반환하는 전송기
+type failingTransport struct{}
+
+// RoundTrip 연결 거부 오류 반환
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+}
@@ -0,0 +1,19 @@
+package exchange
+
+// 주문 실행기 이벤트 종류
+const (
+	EventOrder    = "order"    // 주문 상태 변경 (model.Order)
+	EventPosition = "position" // 포지션 변경 (model.Position)
+)
+
+// EventSink 주문 실행기 이벤트 수신자
+// Publish는 주문 처리 중에 호출되므로 기다리지 않고 바로 반환해야 한다.
+type EventSink interface {
+	Publish(eventType string, data interface{})
+}
+
+// nopEventSink 이벤트를 버리는 수신자 (수신자가 설정되지 않았을 때)
+type nopEventSink struct{}
+
+// Publish 아무것도 하지 않음
+func (nopEventSink) Publish(eventType string, data interface{}) {}
@@ -0,0 +1,436 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/metrics"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultMaxRepriceAttempts = 2
+	defaultFeeRate            = 0.05
+	priceActionReprice        = "reprice"
+)
+
+// ErrPendingEntryExists 같은 마켓에 미체결 매수 주문이 있음
+var ErrPendingEntryExists = errors.New("미체결 매수 주문이 이미 있습니다")
+
+// RiskChecker 주문 실행기가 사용하는 위험 관리 인터페이스
+type RiskChecker interface {
+	CheckEntry(ctx context.Context, signal *model.Signal) error
+	LimitEntryAmount(ctx context.Context, marketID string, amount float64) (float64, error)
+	Equity(ctx context.Context) (float64, error)
+	RecordRealized(marketID string, profit, profitPercent float64, at time.Time) error
+	SizePosition(ctx context.Context, entryPrice, stopPrice float64) (float64, error)
+	OnPositionClosed(position *model.Position)
+}
+
+// Order 수동 주문 요청
+type Order struct {
+	MarketID  string  `json:"market"`
+	Side      string  `json:"side"`     // bid(매수), ask(매도)
+	OrderType string  `json:"ord_type"` // limit(지정가), market(시장가)
+	Volume    float64 `json:"volume"`
+	Price     float64 `json:"price"`
+
+	PriceTime time.Time `json:"-"` // 주문 가격의 기준이 된 시세 시각 (신호 주문만 설정)
+	Urgent    bool      `json:"-"` // 지정가 주문이 시간 초과로 취소되면 시장가로 다시 주문 (신호의 urgent 파라미터)
+}
+
+// OrderExecutor 주문 실행기
+type OrderExecutor struct {
+	db       *gorm.DB
+	client   Exchange
+	risk     RiskChecker
+	signalCh <-chan model.Signal
+	orderCh  <-chan Order
+	cfg      config.TradingConfig
+	logger   *utils.Logger
+	notifier notify.Notifier
+	events   EventSink
+	metrics  *metrics.Metrics
+
+	chances chanceCache
+	urgent  urgentOrders
+
+	// orderMu 주문 추적 루프와 수동 취소가 같은 주문의 체결분을 두 번 반영하지 않도록 막는다
+	orderMu sync.Mutex
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// ExecutorOption 주문 실행기 옵션
+type ExecutorOption func(*OrderExecutor)
+
+// WithExecutorNotifier 주문 전송, 체결, 취소와 주문 실패 알림 설정
+func WithExecutorNotifier(notifier notify.Notifier) ExecutorOption {
+	return func(e *OrderExecutor) {
+		e.notifier = notifier
+	}
+}
+
+// WithEventSink 주문 상태 변경과 포지션 변경 이벤트 수신자 설정 (대시보드 실시간 전송 등)
+func WithEventSink(sink EventSink) ExecutorOption {
+	return func(e *OrderExecutor) {
+		e.events = sink
+	}
+}
+
+// WithExecutorMetrics 주문 결과와 주문 왕복 시간 지표 설정
+func WithExecutorMetrics(m *metrics.Metrics) ExecutorOption {
+	return func(e *OrderExecutor) {
+		e.metrics = m
+	}
+}
+
+// NewOrderExecutor 새로운 주문 실행기 생성
+func NewOrderExecutor(db *gorm.DB, client Exchange, risk RiskChecker, signalCh <-chan model.Signal, orderCh <-chan Order, cfg *config.TradingConfig, opts ...ExecutorOption) *OrderExecutor {
+	if cfg == nil {
+		cfg = &config.TradingConfig{}
+	}
+
+	e := &OrderExecutor{
+		db:       db,
+		client:   client,
+		risk:     risk,
+		signalCh: signalCh,
+		orderCh:  orderCh,
+		cfg:      *cfg,
+		logger:   utils.NewLogger("executor"),
+		notifier: notify.Nop{},
+		events:   nopEventSink{},
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// Start 주문 실행 시작
+// ctx가 취소되거나 Stop이 호출되면 진행 중인 API 요청도 함께 취소된다.
+func (e *OrderExecutor) Start(ctx context.Context) {
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.wg.Add(2)
+	go e.run(ctx)
+	go e.trackOrders(ctx)
+	e.logger.Info("주문 실행기 시작")
+}
+
+// Stop 주문 실행 중지
+func (e *OrderExecutor) Stop() {
+	if e.cancel == nil {
+		return
+	}
+	e.cancel()
+	e.wg.Wait()
+	e.cancel = nil
+	e.logger.Info("주문 실행기 중지")
+}
+
+// run 신호 및 수동 주문 처리 루프
+func (e *OrderExecutor) run(ctx context.Context) {
+	defer e.wg.Done()
+	defer e.recoverWithSnapshot()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case signal, ok := <-e.signalCh:
+			if !ok {
+				return
+			}
+			e.handleSignal(ctx, signal)
+		case order, ok := <-e.orderCh:
+			if !ok {
+				return
+			}
+			if _, err := e.submit(ctx, order, 0); err != nil {
+				e.logger.Error("수동 주문 실패:", order.MarketID, err)
+			}
+		}
+	}
+}
+
+// handleSignal 매매 신호 처리
+func (e *OrderExecutor) handleSignal(ctx context.Context, signal model.Signal) {
+	var err error
+	switch signal.SignalType {
+	case "BUY":
+		err = e.enter(ctx, signal)
+	case "SELL":
+		err = e.exit(ctx, signal)
+	default:
+		err = fmt.Errorf("알 수 없는 신호 유형: %s", signal.SignalType)
+	}
+
+	if err != nil {
+		e.logger.Error("신호 처리 실패:", signal.MarketID, signal.SignalType, err)
+	}
+}
+
+// enter 매수 신호 처리
+func (e *OrderExecutor) enter(ctx context.Context, signal model.Signal) error {
+	if err := e.checkConfidence(signal, time.Now()); err != nil {
+		return err
+	}
+
+	if e.cfg.SinglePendingEntry {
+		if err := e.checkPendingEntry(signal.MarketID); err != nil {
+			return err
+		}
+	}
+
+	if err := e.checkAddInInterval(signal.MarketID, signal.StrategyName, time.Now()); err != nil {
+		return err
+	}
+
+	if err := e.checkOrderbookSupport(ctx, signal); err != nil {
+		return err
+	}
+
+	if err := e.risk.CheckEntry(ctx, &signal); err != nil {
+		return err
+	}
+
+	equity, err := e.risk.Equity(ctx)
+	if err != nil {
+		return err
+	}
+
+	equity, err = e.sizingEquity(signal, equity)
+	if err != nil {
+		return err
+	}
+
+	scale, err := e.strategySizeScale(signal.StrategyName)
+	if err != nil {
+		return err
+	}
+
+	volScale, err := e.volatilitySizeScale(signal.MarketID)
+	if err != nil {
+		return err
+	}
+	scale *= volScale
+
+	// 거래당 위험이 설정되어 있으면 자산 비율 대신 손절 거리 기준 금액을 쓴다
+	amount := equity * e.cfg.MaxPositionSize / 100
+	riskAmount, riskSized, err := e.riskSizedAmount(ctx, signal)
+	if err != nil {
+		return err
+	}
+	if riskSized {
+		amount = riskAmount
+	}
+
+	amount, err = e.risk.LimitEntryAmount(ctx, signal.MarketID, amount*scale)
+	if err != nil {
+		return err
+	}
+	if riskSized {
+		if err := e.checkRiskSizedMinTotal(ctx, signal.MarketID, amount); err != nil {
+			return err
+		}
+	}
+	if amount < MinOrderAmount {
+		return fmt.Errorf("주문 금액이 최소 주문 금액보다 작습니다: %.0f원", amount)
+	}
+
+	order := Order{MarketID: signal.MarketID, Side: "bid", OrderType: e.entryOrderType(), PriceTime: signal.Timestamp, Urgent: isUrgentSignal(signal)}
+	if order.OrderType == "limit" {
+		order.Price = signal.Price
+		order.Volume = amount / signal.Price
+	} else {
+		order.Price = amount
+	}
+
+	_, err = e.submit(ctx, order, signal.ID)
+	return err
+}
+
+// checkPendingEntry 마켓의 미체결 매수 주문 확인
+// 미체결 매수 주문이 여러 개 쌓이면 모두 체결될 때 의도보다 많이 매수하게 되므로 하나만 허용한다.
+func (e *OrderExecutor) checkPendingEntry(marketID string) error {
+	var count int64
+	err := e.db.Model(&model.Order{}).
+		Where("market_id = ? AND side = ? AND status = ?", marketID, "BUY", OrderStatusWait).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("미체결 주문 조회 실패: %w", err)
+	}
+
+	if count > 0 {
+		return fmt.Errorf("%w: %s", ErrPendingEntryExists, marketID)
+	}
+
+	return nil
+}
+
+// exit 매도 신호 처리
+func (e *OrderExecutor) exit(ctx context.Context, signal model.Signal) error {
+	var position model.Position
+	err := e.db.Where("market_id = ? AND status = ?", signal.MarketID, "OPEN").First(&position).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("포지션 조회 실패: %w", err)
+	}
+
+	if err := e.checkMinHold(signal, position, time.Now()); err != nil {
+		return err
+	}
+
+	// 부분 청산 신호는 보유 수량의 일부만 매도한다
+	volume := position.Quantity
+	if fraction, ok := signal.Parameters["exit_fraction"].(float64); ok && fraction > 0 && fraction < 1 {
+		volume = position.Quantity * fraction
+		if volume*signal.Price < MinOrderAmount {
+			return fmt.Errorf("부분 매도 금액이 최소 주문 금액보다 작습니다: %.0f원", volume*signal.Price)
+		}
+	}
+
+	// 가격이 없는 청산 신호(상장 폐지 등)는 시장가로 매도한다
+	order := Order{MarketID: signal.MarketID, Side: "ask", OrderType: e.entryOrderType(), Volume: volume, PriceTime: signal.Timestamp, Urgent: isUrgentSignal(signal)}
+	if signal.Price <= 0 {
+		order.OrderType = "market"
+	}
+	if order.OrderType == "limit" {
+		order.Price = signal.Price
+	}
+
+	_, err = e.submit(ctx, order, signal.ID)
+	return err
+}
+
+// entryOrderType 신호 주문 유형
+func (e *OrderExecutor) entryOrderType() string {
+	if e.cfg.EntryOrderType == "market" {
+		return "market"
+	}
+	return "limit"
+}
+
+// submit 주문 전송 및 저장
+// 지정가 주문이 가격 범위 초과로 거부되면 설정에 따라 현재가로 재호가하거나 포기한다.
+func (e *OrderExecutor) submit(ctx context.Context, order Order, signalID uint) (*model.Order, error) {
+	if err := e.ensureFreshPrice(ctx, &order, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := e.prepareOrder(&order); err != nil {
+		return nil, err
+	}
+
+	if err := e.checkMarketMinTotal(ctx, order); err != nil {
+		return nil, err
+	}
+
+	if err := e.checkSignalOrderLimit(order, signalID); err != nil {
+		return nil, err
+	}
+
+	if err := e.requestApproval(ctx, order, signalID); err != nil {
+		e.logger.Info("미승인 주문 폐기:", order.MarketID, order.Side, err)
+		return nil, err
+	}
+
+	sentAt := time.Now()
+	resp, err := e.client.CreateOrder(ctx, order.MarketID, order.Side, order.OrderType, order.Volume, order.Price)
+	e.metrics.ObserveOrderLatency(time.Since(sentAt))
+	if errors.Is(err, ErrPriceOutOfRange) && order.OrderType == "limit" {
+		resp, err = e.handlePriceOutOfRange(ctx, &order, err)
+	}
+	if errors.Is(err, ErrInsufficientFunds) {
+		e.metrics.Order(metrics.OrderRejected, order.MarketID, orderSide(order.Side))
+		return nil, e.rejectInsufficientFunds(ctx, order, signalID, err)
+	}
+	if err != nil {
+		e.metrics.Order(metrics.OrderRejected, order.MarketID, orderSide(order.Side))
+		e.notify(ctx, notify.LevelError, fmt.Sprintf("주문 실패: %s %s %v", order.MarketID, order.Side, err))
+		return nil, err
+	}
+
+	record := &model.Order{
+		MarketID:    order.MarketID,
+		OrderID:     resp.UUID,
+		Side:        orderSide(order.Side),
+		OrderType:   order.OrderType,
+		Price:       order.Price,
+		Volume:      order.Volume,
+		Status:      OrderStatusWait,
+		SignalID:    signalID,
+		LastUpdated: time.Now(),
+	}
+	if err := e.db.Create(record).Error; err != nil {
+		return nil, fmt.Errorf("주문 저장 실패: %w", err)
+	}
+	e.recordOrderEvent(record, OrderEventSubmitted)
+	if order.Urgent && order.OrderType == "limit" {
+		e.urgent.add(record.OrderID)
+	}
+
+	e.logger.Info("주문 전송:", record.MarketID, record.Side, record.OrderType, record.Price, record.Volume)
+	return record, nil
+}
+
+// handlePriceOutOfRange 가격 범위 초과 거부 처리
+// 재호가에 성공하면 order의 가격과 수량이 갱신된다.
+func (e *OrderExecutor) handlePriceOutOfRange(ctx context.Context, order *Order, cause error) (*OrderResponse, error) {
+	if e.cfg.PriceOutOfRangeAction != priceActionReprice {
+		e.logger.Info("가격 범위 초과로 주문 포기:", order.MarketID, order.Price)
+		return nil, cause
+	}
+
+	attempts := e.cfg.MaxRepriceAttempts
+	if attempts <= 0 {
+		attempts = defaultMaxRepriceAttempts
+	}
+
+	err := cause
+	for i := 0; i < attempts && errors.Is(err, ErrPriceOutOfRange); i++ {
+		ticker, tickerErr := e.client.GetTicker(ctx, order.MarketID)
+		if tickerErr != nil {
+			return nil, fmt.Errorf("재호가용 현재가 조회 실패: %w", tickerErr)
+		}
+
+		// 매수 금액을 유지하도록 수량도 다시 계산한다
+		if order.Side == "bid" {
+			order.Volume = order.Volume * order.Price / ticker.TradePrice
+		}
+		e.logger.Info("가격 범위 초과로 재호가:", order.MarketID, order.Price, "->", ticker.TradePrice)
+		order.Price = ticker.TradePrice
+		if err := e.prepareOrder(order); err != nil {
+			return nil, err
+		}
+
+		var resp *OrderResponse
+		resp, err = e.client.CreateOrder(ctx, order.MarketID, order.Side, order.OrderType, order.Volume, order.Price)
+		if err == nil {
+			return resp, nil
+		}
+	}
+
+	return nil, err
+}
+
+// orderSide 업비트 주문 방향을 모델 표기로 변환
+func orderSide(side string) string {
+	if side == "bid" {
+		return "BUY"
+	}
+	return "SELL"
+}
@@ -0,0 +1,49 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrStalePrice 주문 가격의 기준이 된 시세가 너무 오래됨
+var ErrStalePrice = errors.New("시세 데이터가 오래되었습니다")
+
+// ensureFreshPrice 주문 직전 가격 데이터의 신선도 확인
+// 신호가 대기열에서 지체되었거나 시세 수신이 끊긴 동안 만들어진 지정가 주문은
+// 현재가를 다시 조회해 가격을 갱신하고, 새 시세도 오래되었으면 주문을 거부한다.
+// 가격 기준 시각이 없는 수동 주문과 시장가 주문은 확인하지 않는다.
+func (e *OrderExecutor) ensureFreshPrice(ctx context.Context, order *Order, now time.Time) error {
+	maxAge := time.Duration(e.cfg.MaxPriceAgeSeconds) * time.Second
+	if maxAge <= 0 || order.OrderType != "limit" || order.PriceTime.IsZero() {
+		return nil
+	}
+
+	age := now.Sub(order.PriceTime)
+	if age <= maxAge {
+		return nil
+	}
+
+	ticker, err := e.client.GetTicker(ctx, order.MarketID)
+	if err != nil {
+		return fmt.Errorf("오래된 시세 재조회 실패: %w", err)
+	}
+	tickerTime := time.UnixMilli(ticker.Timestamp)
+	if ticker.Timestamp > 0 && now.Sub(tickerTime) > maxAge {
+		return fmt.Errorf("%w: %s (%s)", ErrStalePrice, order.MarketID, now.Sub(tickerTime).Truncate(time.Second))
+	}
+
+	// 매수 금액을 유지하도록 수량도 다시 계산한다
+	if order.Side == "bid" {
+		order.Volume = order.Volume * order.Price / ticker.TradePrice
+	}
+	e.logger.Info("오래된 시세로 주문 가격 갱신:", order.MarketID, age.Truncate(time.Second), order.Price, "->", ticker.TradePrice)
+	order.Price = ticker.TradePrice
+	order.PriceTime = now
+	if ticker.Timestamp > 0 {
+		order.PriceTime = tickerTime
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+func TestEnsureFreshPriceRefreshesStaleLimitOrder(t *testing.T) {
+	now := time.Now()
+	client := &fakeExchange{ticker: Ticker{TradePrice: 200, Timestamp: now.Add(-time.Second).UnixMilli()}}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{MaxPriceAgeSeconds: 10})
+
+	order := Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100, Volume: 2, PriceTime: now.Add(-time.Minute)}
+	if err := e.ensureFreshPrice(context.Background(), &order, now); err != nil {
+		t.Fatalf("ensureFreshPrice 오류: %v", err)
+	}
+	// 매수 금액 200원을 유지하도록 수량을 다시 계산한다
+	if order.Price != 200 || order.Volume != 1 {
+		t.Fatalf("갱신된 주문 = %v x %v, want 200 x 1", order.Price, order.Volume)
+	}
+	if now.Sub(order.PriceTime) > 2*time.Second {
+		t.Fatalf("가격 기준 시각 = %v, want 새 시세 시각", order.PriceTime)
+	}
+}
+
+func TestEnsureFreshPriceRejectsStaleTicker(t *testing.T) {
+	now := time.Now()
+	client := &fakeExchange{ticker: Ticker{TradePrice: 200, Timestamp: now.Add(-time.Minute).UnixMilli()}}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{MaxPriceAgeSeconds: 10})
+
+	order := Order{MarketID: "KRW-BTC", Side: "ask", OrderType: "limit", Price: 100, Volume: 1, PriceTime: now.Add(-time.Minute)}
+	if err := e.ensureFreshPrice(context.Background(), &order, now); !errors.Is(err, ErrStalePrice) {
+		t.Fatalf("ensureFreshPrice 오류 = %v, want ErrStalePrice", err)
+	}
+}
+
+func TestEnsureFreshPriceSkipsFreshAndUntimedOrders(t *testing.T) {
+	now := time.Now()
+	client := &fakeExchange{ticker: Ticker{TradePrice: 200}}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{MaxPriceAgeSeconds: 10})
+
+	orders := []Order{
+		{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100, Volume: 1, PriceTime: now.Add(-5 * time.Second)},
+		{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100, Volume: 1},
+		{MarketID: "KRW-BTC", Side: "bid", OrderType: "market", Price: 100, PriceTime: now.Add(-time.Hour)},
+	}
+	for _, order := range orders {
+		if err := e.ensureFreshPrice(context.Background(), &order, now); err != nil || order.Price != 100 {
+			t.Fatalf("주문 %+v: 가격 %v, 오류 %v, want 변경 없음", order, order.Price, err)
+		}
+	}
+	if client.tickerCalls != 0 {
+		t.Fatalf("현재가 조회 %d번, want 0", client.tickerCalls)
+	}
+}
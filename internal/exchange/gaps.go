@@ -0,0 +1,140 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+const (
+	defaultGapCheckInterval = 10 * time.Minute
+	defaultGapLookback      = 24 * time.Hour
+)
+
+// CandleGap 저장된 캔들 사이의 빈 구간 [From, To)
+type CandleGap struct {
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Missing int       `json:"missing"` // 빠진 캔들 수
+}
+
+// nextCandleTime 다음 캔들 시작 시각
+// months는 달마다 길이가 다르므로 달력 기준으로 계산한다.
+func nextCandleTime(t time.Time, timeframe string, duration time.Duration) time.Time {
+	if timeframe == "months" {
+		return t.AddDate(0, 1, 0)
+	}
+	return t.Add(duration)
+}
+
+// DetectCandleGaps 저장된 캔들에서 빠진 구간 검출
+// from 이후 첫 저장 캔들부터 to까지, 연속한 두 캔들 사이와 마지막 캔들 이후의 빈 구간을 반환한다.
+// 거래가 없던 구간은 업비트가 캔들을 만들지 않으므로 유동성이 낮은 마켓에서는 실제 누락이 아닐 수 있다.
+func (d *DataCollector) DetectCandleGaps(marketID, timeframe string, from, to time.Time) ([]CandleGap, error) {
+	duration, err := TimeframeDuration(timeframe)
+	if err != nil {
+		return nil, err
+	}
+
+	var timestamps []time.Time
+	err = d.db.Model(&model.Candlestick{}).
+		Where("market_id = ? AND timeframe = ? AND timestamp >= ? AND timestamp < ?", marketID, timeframe, from, to).
+		Order("timestamp").
+		Pluck("timestamp", &timestamps).Error
+	if err != nil {
+		return nil, fmt.Errorf("캔들 조회 실패: %w", err)
+	}
+
+	return findGaps(timestamps, timeframe, duration, to), nil
+}
+
+// findGaps 시간순 캔들 시각 목록에서 빈 구간 계산
+// 아직 마감되지 않은 to 직전 캔들은 누락으로 보지 않는다.
+func findGaps(timestamps []time.Time, timeframe string, duration time.Duration, to time.Time) []CandleGap {
+	var gaps []CandleGap
+	if len(timestamps) == 0 {
+		return gaps
+	}
+
+	addGap := func(start, end time.Time) {
+		missing := 0
+		for t := start; t.Before(end); t = nextCandleTime(t, timeframe, duration) {
+			missing++
+		}
+		if missing > 0 {
+			gaps = append(gaps, CandleGap{From: start, To: end, Missing: missing})
+		}
+	}
+
+	for i := 1; i < len(timestamps); i++ {
+		expected := nextCandleTime(timestamps[i-1], timeframe, duration)
+		if timestamps[i].After(expected) {
+			addGap(expected, timestamps[i])
+		}
+	}
+
+	// 마지막 저장 캔들 이후 마감된 캔들이 없으면 누락
+	expected := nextCandleTime(timestamps[len(timestamps)-1], timeframe, duration)
+	if closedBefore := to.Add(-duration); expected.Before(closedBefore) {
+		addGap(expected, closedBefore)
+	}
+
+	return gaps
+}
+
+// FillCandleGaps 빈 구간을 업비트에서 다시 받아 저장
+func (d *DataCollector) FillCandleGaps(ctx context.Context, marketID, timeframe string, gaps []CandleGap) error {
+	for _, gap := range gaps {
+		if err := d.backfillRange(ctx, marketID, timeframe, gap.From, gap.To); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkGaps 주기적으로 수집 중인 캔들의 빈 구간을 검사하고 알림 (설정 시 백필)
+func (d *DataCollector) checkGaps(ctx context.Context, timeframes []string) {
+	defer d.wg.Done()
+
+	interval := time.Duration(d.cfg.GapCheck.IntervalMinutes) * time.Minute
+	if interval <= 0 {
+		interval = defaultGapCheckInterval
+	}
+	lookback := time.Duration(d.cfg.GapCheck.LookbackHours) * time.Hour
+	if lookback <= 0 {
+		lookback = defaultGapLookback
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, timeframe := range timeframes {
+				for _, marketID := range d.activeMarkets() {
+					gaps, err := d.DetectCandleGaps(marketID, timeframe, now.Add(-lookback), now)
+					if err != nil {
+						d.logger.Error("캔들 누락 검사 실패:", marketID, timeframe, err)
+						continue
+					}
+					if len(gaps) == 0 {
+						continue
+					}
+
+					d.logger.Warn("캔들 누락 감지:", marketID, timeframe, len(gaps), "구간", gaps[0].From, "~", gaps[len(gaps)-1].To)
+					if !d.cfg.GapCheck.Backfill {
+						continue
+					}
+					if err := d.FillCandleGaps(ctx, marketID, timeframe, gaps); err != nil {
+						d.logger.Error("누락 캔들 백필 실패:", marketID, timeframe, err)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestFindGapsBetweenAndAfterCandles(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	timestamps := []time.Time{start, start.Add(time.Minute), start.Add(4 * time.Minute)}
+
+	// 9:02~9:04 누락, 9:05~9:09 누락 (9:09 캔들은 아직 마감 전)
+	gaps := findGaps(timestamps, "minutes/1", time.Minute, start.Add(10*time.Minute))
+	if len(gaps) != 2 {
+		t.Fatalf("빈 구간 = %+v, want 2개", gaps)
+	}
+	if !gaps[0].From.Equal(start.Add(2*time.Minute)) || !gaps[0].To.Equal(start.Add(4*time.Minute)) || gaps[0].Missing != 2 {
+		t.Fatalf("첫 빈 구간 = %+v, want 9:02~9:04 2개", gaps[0])
+	}
+	if !gaps[1].From.Equal(start.Add(5*time.Minute)) || gaps[1].Missing != 4 {
+		t.Fatalf("마지막 빈 구간 = %+v, want 9:05부터 4개", gaps[1])
+	}
+}
+
+func TestFindGapsUsesCalendarMonths(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	if gaps := findGaps([]time.Time{jan, feb, mar}, "months", 30*24*time.Hour, mar.Add(24*time.Hour)); len(gaps) != 0 {
+		t.Fatalf("연속된 월봉 빈 구간 = %+v, want 없음", gaps)
+	}
+}
+
+func TestDetectAndFillCandleGaps(t *testing.T) {
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	server := &candleServer{start: end.Add(-60 * time.Minute), end: end}
+	d, db := newBackfillCollector(t, server, config.BackfillConfig{})
+
+	// 최근 60분 중 20~29분 전 캔들이 빠져 있다
+	var stored []model.Candlestick
+	for i := 1; i <= 60; i++ {
+		if i >= 20 && i < 30 {
+			continue
+		}
+		ts := end.Add(-time.Duration(i) * time.Minute)
+		stored = append(stored, model.Candlestick{MarketID: "KRW-BTC", Timeframe: "minutes/1", Timestamp: ts, Open: 100, High: 100, Low: 100, Close: 100})
+	}
+	if err := d.saveCandles(stored); err != nil {
+		t.Fatal(err)
+	}
+
+	from := end.Add(-60 * time.Minute)
+	gaps, err := d.DetectCandleGaps("KRW-BTC", "minutes/1", from, end)
+	if err != nil {
+		t.Fatalf("DetectCandleGaps 오류: %v", err)
+	}
+	if len(gaps) != 1 || gaps[0].Missing != 10 || !gaps[0].From.Equal(end.Add(-29*time.Minute)) {
+		t.Fatalf("빈 구간 = %+v, want 29분 전부터 10개", gaps)
+	}
+
+	if err := d.FillCandleGaps(context.Background(), "KRW-BTC", "minutes/1", gaps); err != nil {
+		t.Fatalf("FillCandleGaps 오류: %v", err)
+	}
+	if count, _ := storedCandles(t, db); count != 60 {
+		t.Fatalf("백필 후 캔들 수 = %d, want 60", count)
+	}
+	if gaps, _ := d.DetectCandleGaps("KRW-BTC", "minutes/1", from, end); len(gaps) != 0 {
+		t.Fatalf("백필 후 빈 구간 = %+v, want 없음", gaps)
+	}
+}
@@ -0,0 +1,269 @@
+package exchange
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/storage"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// redirectTransport 업비트 주소로 가는 요청을 테스트 서버로 보내는 전송기
+type redirectTransport struct {
+	target *url.URL
+}
+
+// RoundTrip 요청 주소의 호스트만 테스트 서버로 바꿔 전송
+func (r redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient handler가 업비트 API 응답을 대신하는 클라이언트 생성
+func newTestClient(t *testing.T, handler http.Handler, opts ...ClientOption) *UpbitClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("테스트 서버 주소 파싱 실패: %v", err)
+	}
+
+	opts = append(opts, WithHTTPClient(&http.Client{Transport: redirectTransport{target: target}}))
+	return NewUpbitClient("access", "secret", opts...)
+}
+
+// newTestDB 모든 모델을 마이그레이션한 테스트용 sqlite 데이터베이스
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("테스트 데이터베이스 열기 실패: %v", err)
+	}
+	if err := db.AutoMigrate(storage.Models()...); err != nil {
+		t.Fatalf("마이그레이션 실패: %v", err)
+	}
+	return db
+}
+
+// fakeExchange 주문 실행기 테스트용 거래소
+// CreateOrder는 createErrs를 앞에서부터 하나씩 돌려주고, 다 쓰면 대기 상태 주문을 만든다.
+type fakeExchange struct {
+	mu          sync.Mutex
+	created     []Order
+	createErrs  []error
+	cancelled   []string
+	ticker      Ticker
+	tickerErr   error
+	tickerCalls int
+	orderbook   *Orderbook
+	orders      map[string]*OrderResponse // GetOrder 응답
+	orderCalls  int
+	trades      map[string][]OrderTrade // GetOrderTrades 응답
+	accounts    []Account
+	chance      *OrderChance
+	chanceErr   error
+}
+
+func (f *fakeExchange) CreateOrder(ctx context.Context, marketID, side, orderType string, volume, price float64) (*OrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.created = append(f.created, Order{MarketID: marketID, Side: side, OrderType: orderType, Volume: volume, Price: price})
+	if len(f.createErrs) > 0 {
+		err := f.createErrs[0]
+		f.createErrs = f.createErrs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &OrderResponse{
+		UUID:      "order-" + strconv.Itoa(len(f.created)),
+		MarketID:  marketID,
+		Side:      side,
+		OrderType: orderType,
+		State:     "wait",
+		Price:     strconv.FormatFloat(price, 'f', -1, 64),
+		Volume:    strconv.FormatFloat(volume, 'f', -1, 64),
+	}, nil
+}
+
+func (f *fakeExchange) CancelOrder(ctx context.Context, uuid string) (*OrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cancelled = append(f.cancelled, uuid)
+	if order, ok := f.orders[uuid]; ok {
+		return order, nil
+	}
+	return &OrderResponse{UUID: uuid, State: "cancel"}, nil
+}
+
+func (f *fakeExchange) GetOrder(ctx context.Context, uuid string) (*OrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.orderCalls++
+	if order, ok := f.orders[uuid]; ok {
+		return order, nil
+	}
+	return &OrderResponse{UUID: uuid, State: "wait"}, nil
+}
+
+func (f *fakeExchange) GetOrderTrades(ctx context.Context, uuid string) ([]OrderTrade, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.trades[uuid], nil
+}
+
+func (f *fakeExchange) GetAccounts(ctx context.Context) ([]Account, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Account(nil), f.accounts...), nil
+}
+
+func (f *fakeExchange) GetOrderChance(ctx context.Context, marketID string) (*OrderChance, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.chanceErr != nil {
+		return nil, f.chanceErr
+	}
+	if f.chance == nil {
+		return &OrderChance{BidFee: "0.0005", AskFee: "0.0005"}, nil
+	}
+	return f.chance, nil
+}
+
+func (f *fakeExchange) GetTicker(ctx context.Context, marketID string) (*Ticker, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tickerCalls++
+	if f.tickerErr != nil {
+		return nil, f.tickerErr
+	}
+	ticker := f.ticker
+	ticker.MarketID = marketID
+	return &ticker, nil
+}
+
+func (f *fakeExchange) GetOrderbook(ctx context.Context, marketID string) (*Orderbook, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.orderbook == nil {
+		return &Orderbook{MarketID: marketID}, nil
+	}
+	return f.orderbook, nil
+}
+
+// createdOrders 지금까지 들어온 주문 요청
+func (f *fakeExchange) createdOrders() []Order {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Order(nil), f.created...)
+}
+
+// cancelledOrders 지금까지 들어온 취소 요청
+func (f *fakeExchange) cancelledOrders() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.cancelled...)
+}
+
+// setOrderState 다음 조회에서 돌려줄 주문 상태와 체결 내역 설정
+func (f *fakeExchange) setOrderState(uuid string, resp *OrderResponse, trades []OrderTrade) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.orders == nil {
+		f.orders = make(map[string]*OrderResponse)
+	}
+	if f.trades == nil {
+		f.trades = make(map[string][]OrderTrade)
+	}
+	f.orders[uuid] = resp
+	f.trades[uuid] = trades
+}
+
+// getOrderCalls GetOrder 호출 수
+func (f *fakeExchange) getOrderCalls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.orderCalls
+}
+
+// fakeRisk 모든 진입을 허용하고 요청 금액을 그대로 쓰는 위험 관리자
+// sizeVolume이 0보다 크면 거래당 위험 기준 수량으로 돌려준다.
+type fakeRisk struct {
+	mu         sync.Mutex
+	equity     float64
+	entryErr   error
+	realized   []float64
+	sizeVolume float64
+	closed     []model.Position
+}
+
+func (r *fakeRisk) CheckEntry(ctx context.Context, signal *model.Signal) error { return r.entryErr }
+
+func (r *fakeRisk) LimitEntryAmount(ctx context.Context, marketID string, amount float64) (float64, error) {
+	return amount, nil
+}
+
+func (r *fakeRisk) Equity(ctx context.Context) (float64, error) { return r.equity, nil }
+
+func (r *fakeRisk) RecordRealized(marketID string, profit, profitPercent float64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.realized = append(r.realized, profit)
+	return nil
+}
+
+func (r *fakeRisk) OnPositionClosed(position *model.Position) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = append(r.closed, *position)
+}
+
+func (r *fakeRisk) SizePosition(ctx context.Context, entryPrice, stopPrice float64) (float64, error) {
+	return r.sizeVolume, nil
+}
+
+// newTestExecutor 테스트 거래소와 sqlite를 쓰는 주문 실행기
+func newTestExecutor(t *testing.T, client *fakeExchange, cfg config.TradingConfig, opts ...ExecutorOption) (*OrderExecutor, *gorm.DB) {
+	t.Helper()
+	db := newTestDB(t)
+	return NewOrderExecutor(db, client, &fakeRisk{equity: 1000000}, nil, nil, &cfg, opts...), db
+}
+
+// recordingNotifier 보낸 알림을 기록하는 알림기
+type recordingNotifier struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (n *recordingNotifier) Send(ctx context.Context, level, message string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.messages = append(n.messages, level+" "+message)
+	return nil
+}
+
+// sent 지금까지 보낸 알림
+func (n *recordingNotifier) sent() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.messages...)
+}
@@ -0,0 +1,118 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
+	"gorm.io/gorm"
+)
+
+// rejectedOrderPrefix 거래소가 거부해 주문 UUID가 없는 주문 기록의 주문 번호 접두사
+const rejectedOrderPrefix = "rejected-"
+
+// exitReasonReconciled 실제 잔고가 없어 닫은 포지션의 청산 사유
+const exitReasonReconciled = "RECONCILED"
+
+// rejectInsufficientFunds 잔고 부족으로 거부된 주문 처리
+// 다시 보내도 같은 오류가 나므로 재시도하지 않고 취소 상태로 기록한 뒤 알림을 보낸다.
+// 매도 주문이 거부되었으면 포지션 수량이 실제 보유 수량보다 많은 것이므로 잔고에 맞춰 조정한다.
+func (e *OrderExecutor) rejectInsufficientFunds(ctx context.Context, order Order, signalID uint, cause error) error {
+	reason := APIErrorInsufficientFundsBid
+	var apiErr *UpbitAPIError
+	if errors.As(cause, &apiErr) && apiErr.Name != "" {
+		reason = apiErr.Name
+	}
+
+	e.logger.Error("잔고 부족으로 주문 취소:", order.MarketID, order.Side, order.Price, order.Volume)
+
+	now := time.Now()
+	record := &model.Order{
+		MarketID:    order.MarketID,
+		OrderID:     rejectedOrderPrefix + uuid.NewString(),
+		Side:        orderSide(order.Side),
+		OrderType:   order.OrderType,
+		Price:       order.Price,
+		Volume:      order.Volume,
+		Status:      OrderStatusCancel,
+		SignalID:    signalID,
+		LastUpdated: now,
+		Reason:      reason,
+	}
+	if err := e.db.Create(record).Error; err != nil {
+		e.logger.Error("거부된 주문 저장 실패:", order.MarketID, err)
+	} else {
+		e.events.Publish(EventOrder, record)
+	}
+
+	e.publish(notify.Event{
+		Level:   notify.LevelError,
+		Type:    "잔고 부족",
+		Market:  order.MarketID,
+		Price:   order.Price,
+		Message: fmt.Sprintf("잔고 부족으로 주문 취소: %s %s 가격 %s 수량 %s (%s)", order.MarketID, record.Side, strconv.FormatFloat(order.Price, 'f', -1, 64), strconv.FormatFloat(order.Volume, 'f', -1, 64), reason),
+	})
+
+	if order.Side == "ask" {
+		if err := e.reconcilePositionQuantity(ctx, order.MarketID, now); err != nil {
+			e.logger.Error("포지션 수량 조정 실패:", order.MarketID, err)
+		}
+	}
+
+	return cause
+}
+
+// reconcilePositionQuantity 열린 포지션 수량을 실제 보유 수량(주문 대기 수량 포함)에 맞춤
+// 보유 수량이 포지션보다 적을 때만 줄이며, 매수 수수료도 남은 수량 비율만큼만 남긴다.
+// 보유 수량이 없으면 포지션을 닫는다.
+func (e *OrderExecutor) reconcilePositionQuantity(ctx context.Context, marketID string, now time.Time) error {
+	accounts, err := e.client.GetAccounts(ctx)
+	if err != nil {
+		return fmt.Errorf("잔고 조회 실패: %w", err)
+	}
+
+	held := 0.0
+	currency := currencyOf(marketID)
+	for _, account := range accounts {
+		if account.Currency == currency {
+			held = RoundVolume(account.Quantity())
+			break
+		}
+	}
+
+	var position model.Position
+	err = e.db.Where("market_id = ? AND status = ?", marketID, "OPEN").First(&position).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("포지션 조회 실패: %w", err)
+	}
+	if held >= position.Quantity {
+		return nil
+	}
+
+	e.logger.Warn("포지션 수량을 실제 보유 수량으로 조정:", marketID, position.Quantity, "->", held)
+	if position.Quantity > 0 {
+		position.EntryFee *= held / position.Quantity
+	}
+	position.Quantity = held
+	if held <= 0 {
+		position.Quantity = 0
+		position.EntryFee = 0
+		position.Status = "CLOSED"
+		position.ExitTime = now
+		position.ExitReason = exitReasonReconciled
+	}
+	if err := e.db.Save(&position).Error; err != nil {
+		return fmt.Errorf("포지션 저장 실패: %w", err)
+	}
+
+	e.events.Publish(EventPosition, position)
+	return nil
+}
@@ -0,0 +1,82 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
+)
+
+func TestInsufficientAskFundsReconcilesPositionAndNotifies(t *testing.T) {
+	client := &fakeExchange{
+		createErrs: []error{
+			&UpbitAPIError{StatusCode: http.StatusBadRequest, Name: APIErrorInsufficientFundsAsk, Message: "매도가능수량이 부족합니다."},
+		},
+		accounts: []Account{{Currency: "BTC", Balance: "0.4", Locked: "0", AvgBuyPrice: "100000"}},
+	}
+	notifier := &recordingNotifier{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{}, WithExecutorNotifier(notifier))
+
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, EntryFee: 50, Status: "OPEN"}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := e.submit(context.Background(), Order{MarketID: "KRW-BTC", Side: "ask", OrderType: "limit", Price: 100000, Volume: 1}, 0)
+	if !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("submit 오류 = %v, want ErrInsufficientFunds", err)
+	}
+	if n := len(client.createdOrders()); n != 1 {
+		t.Fatalf("주문 요청 %d건, want 1 (재시도 없음)", n)
+	}
+
+	var record model.Order
+	if err := db.Where("market_id = ? AND side = ?", "KRW-BTC", "SELL").First(&record).Error; err != nil {
+		t.Fatalf("거부된 주문 기록 없음: %v", err)
+	}
+	if record.Status != OrderStatusCancel || record.Reason != APIErrorInsufficientFundsAsk {
+		t.Fatalf("거부된 주문 = %s %s, want CANCEL insufficient_funds_ask", record.Status, record.Reason)
+	}
+
+	// 포지션 수량과 매수 수수료가 실제 보유 수량(0.4) 비율로 줄어든다
+	if err := db.First(&position, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if position.Status != "OPEN" || position.Quantity != 0.4 || position.EntryFee != 20 {
+		t.Fatalf("조정된 포지션 = %s 수량 %v 수수료 %v, want OPEN 0.4 20", position.Status, position.Quantity, position.EntryFee)
+	}
+
+	sent := notifier.sent()
+	if len(sent) != 1 || !strings.HasPrefix(sent[0], notify.LevelError) || !strings.Contains(sent[0], APIErrorInsufficientFundsAsk) {
+		t.Fatalf("알림 = %v, want 잔고 부족 오류 알림 1건", sent)
+	}
+}
+
+func TestInsufficientAskFundsClosesPositionWithoutHoldings(t *testing.T) {
+	client := &fakeExchange{createErrs: []error{
+		&UpbitAPIError{StatusCode: http.StatusBadRequest, Name: APIErrorInsufficientFundsAsk},
+	}}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.submit(context.Background(), Order{MarketID: "KRW-BTC", Side: "ask", OrderType: "limit", Price: 100000, Volume: 1}, 0); !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("submit 오류 = %v, want ErrInsufficientFunds", err)
+	}
+
+	if err := db.First(&position, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if position.Status != "CLOSED" || position.Quantity != 0 || position.ExitReason != exitReasonReconciled {
+		t.Fatalf("포지션 = %s 수량 %v 사유 %s, want CLOSED 0 %s", position.Status, position.Quantity, position.ExitReason, exitReasonReconciled)
+	}
+}
@@ -0,0 +1,79 @@
+package exchange
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// parseTestJWT Bearer 토큰을 비밀 키로 검증해 클레임 반환
+func parseTestJWT(t *testing.T, bearer, secret string) jwt.MapClaims {
+	t.Helper()
+
+	if !strings.HasPrefix(bearer, "Bearer ") {
+		t.Fatalf("인증 헤더 = %q, want Bearer 접두사", bearer)
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(strings.TrimPrefix(bearer, "Bearer "), claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		t.Fatalf("JWT 검증 실패: %v", err)
+	}
+	return claims
+}
+
+func TestCreateJWTSignsAccessKeyAndNonce(t *testing.T) {
+	c := NewUpbitClient("access", "secret")
+
+	first, err := c.createJWT(nil)
+	if err != nil {
+		t.Fatalf("createJWT 오류: %v", err)
+	}
+	claims := parseTestJWT(t, first, "secret")
+	if claims["access_key"] != "access" || claims["nonce"] == "" {
+		t.Fatalf("클레임 = %v, want access_key, nonce", claims)
+	}
+	if _, ok := claims["query_hash"]; ok {
+		t.Fatal("파라미터 없는 요청에 query_hash가 포함됨")
+	}
+
+	// nonce는 요청마다 달라야 한다
+	second, _ := c.createJWT(nil)
+	if parseTestJWT(t, second, "secret")["nonce"] == claims["nonce"] {
+		t.Fatal("두 토큰의 nonce가 같음")
+	}
+}
+
+func TestCreateJWTIncludesQueryHash(t *testing.T) {
+	c := NewUpbitClient("access", "secret")
+
+	token, err := c.createJWT(map[string]string{"market": "KRW-BTC", "side": "bid"})
+	if err != nil {
+		t.Fatalf("createJWT 오류: %v", err)
+	}
+	claims := parseTestJWT(t, token, "secret")
+
+	want := fmt.Sprintf("%x", sha512.Sum512([]byte("market=KRW-BTC&side=bid")))
+	if claims["query_hash"] != want || claims["query_hash_alg"] != "SHA512" {
+		t.Fatalf("query_hash = %v (%v), want %s", claims["query_hash"], claims["query_hash_alg"], want)
+	}
+}
+
+func TestCreateJWTRejectedWithWrongSecret(t *testing.T) {
+	c := NewUpbitClient("access", "secret")
+
+	token, err := c.createJWT(nil)
+	if err != nil {
+		t.Fatalf("createJWT 오류: %v", err)
+	}
+	_, err = jwt.Parse(strings.TrimPrefix(token, "Bearer "), func(*jwt.Token) (interface{}, error) {
+		return []byte("other"), nil
+	})
+	if err == nil {
+		t.Fatal("다른 비밀 키로 검증이 통과함")
+	}
+}
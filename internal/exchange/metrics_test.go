@@ -0,0 +1,119 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/metrics"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// counterValue 레지스트리에서 이름과 라벨이 일치하는 카운터 값 조회 (없으면 0)
+func counterValue(t *testing.T, reg *prometheus.Registry, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("지표 수집 실패: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			matched := 0
+			for _, pair := range metric.GetLabel() {
+				if labels[pair.GetName()] == pair.GetValue() {
+					matched++
+				}
+			}
+			if matched == len(labels) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+// orderCount orders_total 값 조회
+func orderCount(t *testing.T, reg *prometheus.Registry, result, market, side string) float64 {
+	t.Helper()
+	return counterValue(t, reg, "upbit_bot_orders_total", map[string]string{"result": result, "market": market, "side": side})
+}
+
+// latencyCount 주문 왕복 시간 히스토그램 표본 수 조회
+func latencyCount(t *testing.T, reg *prometheus.Registry) uint64 {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("지표 수집 실패: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() == "upbit_bot_order_round_trip_seconds" {
+			return family.GetMetric()[0].GetHistogram().GetSampleCount()
+		}
+	}
+	return 0
+}
+
+func TestExecutorRecordsOrderMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{}, WithExecutorMetrics(metrics.New(reg)))
+	ctx := context.Background()
+
+	if _, err := e.submit(ctx, limitBid(), 0); err != nil {
+		t.Fatalf("주문 전송 실패: %v", err)
+	}
+	if got := orderCount(t, reg, metrics.OrderPlaced, "KRW-BTC", "BUY"); got != 1 {
+		t.Fatalf("placed 주문 수 = %v, want 1", got)
+	}
+	if got := latencyCount(t, reg); got != 1 {
+		t.Fatalf("주문 왕복 시간 표본 수 = %d, want 1", got)
+	}
+
+	var order model.Order
+	if err := db.Where("order_id = ?", "order-1").First(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+	e.applyOrderResponse(&order, &OrderResponse{UUID: "order-1", State: "done", ExecutedVolume: "0.1"})
+	if got := orderCount(t, reg, metrics.OrderFilled, "KRW-BTC", "BUY"); got != 1 {
+		t.Fatalf("filled 주문 수 = %v, want 1", got)
+	}
+
+	// 거래소가 거부한 주문도 왕복 시간을 기록하고 rejected로 집계한다
+	client.createErrs = []error{errors.New("연결 실패")}
+	if _, err := e.submit(ctx, limitBid(), 0); err == nil {
+		t.Fatal("거래소 오류인데 주문 전송 성공")
+	}
+	if got := orderCount(t, reg, metrics.OrderRejected, "KRW-BTC", "BUY"); got != 1 {
+		t.Fatalf("rejected 주문 수 = %v, want 1", got)
+	}
+	if got := orderCount(t, reg, metrics.OrderPlaced, "KRW-BTC", "BUY"); got != 1 {
+		t.Fatalf("거부 후 placed 주문 수 = %v, want 1", got)
+	}
+	if got := latencyCount(t, reg); got != 2 {
+		t.Fatalf("주문 왕복 시간 표본 수 = %d, want 2", got)
+	}
+}
+
+func TestClientCountsAPIErrorsByName(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"name":"invalid_price_bid","message":"주문 가격 단위를 잘못 입력하셨습니다."}}`))
+	}), WithClientMetrics(metrics.New(reg)))
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetTicker(context.Background(), "KRW-BTC"); err == nil {
+			t.Fatal("오류 응답인데 조회 성공")
+		}
+	}
+
+	if got := counterValue(t, reg, "upbit_bot_upbit_api_errors_total", map[string]string{"name": "invalid_price_bid"}); got != 2 {
+		t.Fatalf("invalid_price_bid 오류 수 = %v, want 2", got)
+	}
+}
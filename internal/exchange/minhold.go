@@ -0,0 +1,67 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// minHoldParam 진입 후 최소 보유 시간 전략 파라미터 (분)
+const minHoldParam = "min_hold_minutes"
+
+// ErrMinHoldTime 최소 보유 시간이 지나지 않아 청산 보류
+var ErrMinHoldTime = errors.New("최소 보유 시간이 지나지 않았습니다")
+
+// hardExitReasons 최소 보유 시간과 관계없이 즉시 청산하는 사유
+var hardExitReasons = map[string]bool{
+	"STOP":     true,
+	"DELISTED": true,
+}
+
+// checkMinHold 진입 직후 청산 신호 보류 확인
+// 스프레드 때문에 진입 직후 목표가에 닿는 등 잡음성 청산을 막기 위해, 전략 설정의 최소 보유 시간 동안은
+// 손절(전략 손절률 이상 하락 또는 손절/상장 폐지 사유 신호)만 허용하고 나머지 청산은 거부한다.
+func (e *OrderExecutor) checkMinHold(signal model.Signal, position model.Position, now time.Time) error {
+	query := e.db.Where("market_id = ?", signal.MarketID)
+	if signal.StrategyName != "" {
+		query = query.Where("strategy_name = ?", signal.StrategyName)
+	}
+
+	var strategyConfig model.StrategyConfig
+	err := query.First(&strategyConfig).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("전략 설정 조회 실패: %w", err)
+	}
+
+	minutes, _ := strategyConfig.Parameters[minHoldParam].(float64)
+	if minutes <= 0 {
+		return nil
+	}
+
+	hold := time.Duration(minutes * float64(time.Minute))
+	held := now.Sub(position.EntryTime)
+	if held >= hold || isHardStop(signal, position, strategyConfig.StopLoss) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s (보유 %s, 최소 %s)", ErrMinHoldTime, signal.MarketID, held.Round(time.Second), hold)
+}
+
+// isHardStop 최소 보유 시간 중에도 허용하는 손절 청산인지 여부
+// stopLoss는 진입가 대비 손절률(%)이다.
+func isHardStop(signal model.Signal, position model.Position, stopLoss float64) bool {
+	if reason, _ := signal.Parameters["exit_reason"].(string); hardExitReasons[reason] {
+		return true
+	}
+
+	if stopLoss <= 0 || position.EntryPrice <= 0 || signal.Price <= 0 {
+		return false
+	}
+	return signal.Price <= position.EntryPrice*(1-stopLoss/100)
+}
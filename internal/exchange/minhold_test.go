@@ -0,0 +1,59 @@
+package exchange
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestCheckMinHold(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	cfg := model.StrategyConfig{MarketID: "KRW-BTC", StrategyName: "test", Timeframe: "minutes/1", StopLoss: 2, Enabled: true, Parameters: model.Parameters{minHoldParam: 30.0}}
+	if err := db.Create(&cfg).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: now.Add(-10 * time.Minute), Quantity: 1, Status: "OPEN"}
+	sell := func(price float64, params model.Parameters) model.Signal {
+		return model.Signal{MarketID: "KRW-BTC", StrategyName: "test", SignalType: "SELL", Price: price, Parameters: params}
+	}
+
+	cases := []struct {
+		name   string
+		signal model.Signal
+		held   time.Duration
+		block  bool
+	}{
+		{"보유 시간 미달 목표가 청산", sell(101000, nil), 10 * time.Minute, true},
+		{"보유 시간 경과", sell(101000, nil), 31 * time.Minute, false},
+		{"손절률 이상 하락", sell(97900, nil), 10 * time.Minute, false},
+		{"손절 사유 신호", sell(99500, model.Parameters{"exit_reason": "STOP"}), 10 * time.Minute, false},
+		{"상장 폐지 신호", sell(0, model.Parameters{"exit_reason": "DELISTED"}), time.Minute, false},
+	}
+	for _, tc := range cases {
+		position.EntryTime = now.Add(-tc.held)
+		err := e.checkMinHold(tc.signal, position, now)
+		if blocked := errors.Is(err, ErrMinHoldTime); blocked != tc.block {
+			t.Fatalf("%s: checkMinHold 오류 = %v, want 보류 %v", tc.name, err, tc.block)
+		}
+	}
+}
+
+func TestCheckMinHoldIgnoresOtherStrategies(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	cfg := model.StrategyConfig{MarketID: "KRW-BTC", StrategyName: "slow", Timeframe: "minutes/1", Enabled: true, Parameters: model.Parameters{minHoldParam: 30.0}}
+	if err := db.Create(&cfg).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: now, Quantity: 1, Status: "OPEN"}
+	signal := model.Signal{MarketID: "KRW-BTC", StrategyName: "fast", SignalType: "SELL", Price: 101000}
+	if err := e.checkMinHold(signal, position, now); err != nil {
+		t.Fatalf("최소 보유 시간이 없는 전략 오류 = %v, want nil", err)
+	}
+}
@@ -0,0 +1,125 @@
+package exchange
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/metrics"
+)
+
+// ClientOption 업비트 클라이언트 옵션
+type ClientOption func(*UpbitClient)
+
+// WithWebSocketMarketsPerConnection 웹소켓 연결당 최대 구독 마켓 수 설정
+// 0 이하이면 분할하지 않고 하나의 연결로 모든 마켓을 구독한다.
+func WithWebSocketMarketsPerConnection(n int) ClientOption {
+	return func(c *UpbitClient) {
+		c.wsMarketsPerConn = n
+	}
+}
+
+// WithHTTPClient REST 요청에 사용할 HTTP 클라이언트 설정 (프록시, 전송 계층 교체 등)
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *UpbitClient) {
+		if client != nil {
+			c.httpClient = client
+		}
+	}
+}
+
+// WithClientMetrics 업비트 API 오류 지표 설정
+func WithClientMetrics(m *metrics.Metrics) ClientOption {
+	return func(c *UpbitClient) {
+		c.metrics = m
+	}
+}
+
+// WithErrorRateWindow API 오류율 집계 구간 설정
+func WithErrorRateWindow(window time.Duration) ClientOption {
+	return func(c *UpbitClient) {
+		c.errorRate = newErrorRateTracker(window)
+	}
+}
+
+// WithWebSocketPongTimeout 핑 전송 후 퐁 응답 제한 시간 설정
+func WithWebSocketPongTimeout(timeout time.Duration) ClientOption {
+	return func(c *UpbitClient) {
+		if timeout > 0 {
+			c.wsPongTimeout = timeout
+		}
+	}
+}
+
+// WithWebSocketSubscribeTimeout 구독 요청 후 첫 데이터 수신 제한 시간 설정
+func WithWebSocketSubscribeTimeout(timeout time.Duration) ClientOption {
+	return func(c *UpbitClient) {
+		if timeout > 0 {
+			c.wsSubscribeTimeout = timeout
+		}
+	}
+}
+
+// WithWebSocketFormat 웹소켓 메시지 형식 설정 (WebSocketFormatDefault, WebSocketFormatSimple)
+// SIMPLE 형식은 필드 이름이 축약되어 수신량이 줄어들며, 수신 시 기본 형식과 같은 데이터로 변환된다.
+func WithWebSocketFormat(format string) ClientOption {
+	return func(c *UpbitClient) {
+		if format != "" {
+			c.wsFormat = format
+		}
+	}
+}
+
+// WithOrderRateLimit 주문 그룹(주문, 취소, 주문/체결 조회, 계정 조회) 초당 요청 수 설정
+func WithOrderRateLimit(perSecond float64) ClientOption {
+	return func(c *UpbitClient) {
+		if perSecond > 0 {
+			c.orderLimiter.setLimit(perSecond)
+		}
+	}
+}
+
+// WithQuotationRateLimit 시세 그룹(마켓, 현재가, 호가, 캔들 조회) 초당 요청 수 설정
+func WithQuotationRateLimit(perSecond float64) ClientOption {
+	return func(c *UpbitClient) {
+		if perSecond > 0 {
+			c.quotationLimiter = newRateLimiter(perSecond)
+		}
+	}
+}
+
+// WithRetry 일시적 REST 오류 재시도 횟수와 첫 대기 시간 설정
+// 대기 시간은 재시도마다 두 배로 늘어나며, 0 이하의 값은 기본값을 유지한다.
+func WithRetry(maxRetries int, initialBackoff time.Duration) ClientOption {
+	return func(c *UpbitClient) {
+		if maxRetries > 0 {
+			c.maxRetries = maxRetries
+		}
+		if initialBackoff > 0 {
+			c.retryBackoff = initialBackoff
+		}
+	}
+}
+
+// WithListPageSize 목록 조회 API 페이지 크기 설정 (최대 100)
+func WithListPageSize(n int) ClientOption {
+	return func(c *UpbitClient) {
+		if n > 0 && n <= maxListPageSize {
+			c.listPageSize = n
+		}
+	}
+}
+
+// WithCandleChunking 요청당 최대 개수(200)를 넘는 캔들 조회를 나눠서 처리할지 설정
+// 끄면 초과 요청은 최대 개수로 제한된다.
+func WithCandleChunking(enabled bool) ClientOption {
+	return func(c *UpbitClient) {
+		c.candleChunking = enabled
+	}
+}
+
+// WithOrderPriority 주문 그룹 한도가 빠듯할 때 주문 생성/취소/대조 요청을 조회 요청보다 먼저 보낼지 설정
+func WithOrderPriority(enabled bool) ClientOption {
+	return func(c *UpbitClient) {
+		c.orderLimiter.setPrioritize(enabled)
+	}
+}
@@ -0,0 +1,102 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/metrics"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
+)
+
+// 주문 이벤트 유형 (model.OrderEvent.EventType)
+const (
+	OrderEventSubmitted       = "SUBMITTED"
+	OrderEventPartiallyFilled = "PARTIALLY_FILLED"
+	OrderEventFilled          = "FILLED"
+	OrderEventCancelled       = "CANCELLED"
+)
+
+// orderEventType 주문 기록 변경에 해당하는 이벤트 유형 (변경이 없으면 빈 문자열)
+func orderEventType(before, after model.Order) string {
+	switch {
+	case after.Status == OrderStatusDone && before.Status != OrderStatusDone:
+		return OrderEventFilled
+	case after.Status == OrderStatusCancel && before.Status != OrderStatusCancel:
+		return OrderEventCancelled
+	case after.ExecutedVolume > before.ExecutedVolume:
+		return OrderEventPartiallyFilled
+	default:
+		return ""
+	}
+}
+
+// recordOrderEvent 주문 이벤트 알림 및 저장
+// 이력 저장 실패가 주문 처리를 막지 않도록 오류는 로그만 남긴다.
+func (e *OrderExecutor) recordOrderEvent(order *model.Order, eventType string) {
+	e.notifyOrderEvent(order, eventType)
+	e.events.Publish(EventOrder, order)
+	if result, ok := orderEventMetrics[eventType]; ok {
+		e.metrics.Order(result, order.MarketID, order.Side)
+	}
+
+	if !e.cfg.RecordOrderEvents {
+		return
+	}
+
+	event := &model.OrderEvent{
+		OrderID:        order.OrderID,
+		MarketID:       order.MarketID,
+		EventType:      eventType,
+		ExecutedVolume: order.ExecutedVolume,
+		Timestamp:      time.Now(),
+	}
+	if err := e.db.Create(event).Error; err != nil {
+		e.logger.Error("주문 이벤트 저장 실패:", order.OrderID, eventType, err)
+	}
+}
+
+// orderEventMetrics 주문 이벤트별 주문 지표 결과
+var orderEventMetrics = map[string]string{
+	OrderEventSubmitted: metrics.OrderPlaced,
+	OrderEventFilled:    metrics.OrderFilled,
+	OrderEventCancelled: metrics.OrderCancelled,
+}
+
+// orderEventLabels 알림을 보내는 주문 이벤트 (부분 체결은 알림이 너무 잦아 제외)
+var orderEventLabels = map[string]string{
+	OrderEventSubmitted: "주문 전송",
+	OrderEventFilled:    "주문 체결",
+	OrderEventCancelled: "주문 취소",
+}
+
+// notifyOrderEvent 주문 이벤트 알림
+func (e *OrderExecutor) notifyOrderEvent(order *model.Order, eventType string) {
+	label, ok := orderEventLabels[eventType]
+	if !ok {
+		return
+	}
+
+	message := fmt.Sprintf("%s: %s %s %s 가격 %s 수량 %s", label, order.MarketID, order.Side, order.OrderType,
+		strconv.FormatFloat(order.Price, 'f', -1, 64), strconv.FormatFloat(order.Volume, 'f', -1, 64))
+	if eventType == OrderEventFilled || eventType == OrderEventCancelled {
+		message += " 체결 " + strconv.FormatFloat(order.ExecutedVolume, 'f', -1, 64)
+	}
+	e.publish(notify.Event{Level: notify.LevelInfo, Type: label, Market: order.MarketID, Price: order.Price, Message: message})
+}
+
+// publish 이벤트 알림 전송 (실패는 로그만 남김)
+func (e *OrderExecutor) publish(event notify.Event) {
+	if err := notify.Publish(context.Background(), e.notifier, event); err != nil {
+		e.logger.Error("알림 전송 실패:", err)
+	}
+}
+
+// notify 알림 전송 (실패는 로그만 남김)
+func (e *OrderExecutor) notify(ctx context.Context, level, message string) {
+	if err := e.notifier.Send(ctx, level, message); err != nil {
+		e.logger.Error("알림 전송 실패:", err)
+	}
+}
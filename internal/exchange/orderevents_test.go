@@ -0,0 +1,76 @@
+package exchange
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestOrderEventType(t *testing.T) {
+	wait := model.Order{Status: OrderStatusWait}
+	cases := []struct {
+		after model.Order
+		want  string
+	}{
+		{model.Order{Status: OrderStatusWait}, ""},
+		{model.Order{Status: OrderStatusWait, ExecutedVolume: 0.5}, OrderEventPartiallyFilled},
+		{model.Order{Status: OrderStatusDone, ExecutedVolume: 1}, OrderEventFilled},
+		{model.Order{Status: OrderStatusCancel}, OrderEventCancelled},
+	}
+	for _, tc := range cases {
+		if got := orderEventType(wait, tc.after); got != tc.want {
+			t.Fatalf("orderEventType(%+v) = %q, want %q", tc.after, got, tc.want)
+		}
+	}
+}
+
+func TestOrderLifecycleIsRecordedAsEvents(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{RecordOrderEvents: true})
+
+	if _, err := e.submit(context.Background(), Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100000, Volume: 1}, 0); err != nil {
+		t.Fatalf("주문 전송 실패: %v", err)
+	}
+
+	var order model.Order
+	if err := db.Where("order_id = ?", "order-1").First(&order).Error; err != nil {
+		t.Fatalf("주문 기록 조회 실패: %v", err)
+	}
+	e.applyOrderResponse(&order, &OrderResponse{UUID: "order-1", State: "wait", ExecutedVolume: "0.4"})
+	e.applyOrderResponse(&order, &OrderResponse{UUID: "order-1", State: "wait", ExecutedVolume: "0.4"})
+	e.applyOrderResponse(&order, &OrderResponse{UUID: "order-1", State: "done", ExecutedVolume: "1"})
+
+	var events []model.OrderEvent
+	if err := db.Where("order_id = ?", "order-1").Order("id").Find(&events).Error; err != nil {
+		t.Fatalf("주문 이벤트 조회 실패: %v", err)
+	}
+	types := make([]string, len(events))
+	for i, event := range events {
+		types[i] = event.EventType
+	}
+	want := []string{OrderEventSubmitted, OrderEventPartiallyFilled, OrderEventFilled}
+	if !reflect.DeepEqual(types, want) {
+		t.Fatalf("주문 이벤트 = %v, want %v", types, want)
+	}
+	if events[1].ExecutedVolume != 0.4 || events[2].ExecutedVolume != 1 {
+		t.Fatalf("체결 수량 = %v, %v, want 0.4, 1", events[1].ExecutedVolume, events[2].ExecutedVolume)
+	}
+}
+
+func TestOrderEventsNotStoredWhenDisabled(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+
+	if _, err := e.submit(context.Background(), Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100000, Volume: 1}, 0); err != nil {
+		t.Fatalf("주문 전송 실패: %v", err)
+	}
+
+	var count int64
+	if err := db.Model(&model.OrderEvent{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("저장된 주문 이벤트 수 = %d, want 0", count)
+	}
+}
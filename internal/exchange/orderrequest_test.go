@@ -0,0 +1,82 @@
+package exchange
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// capturedOrder 주문 API가 받은 요청 본문과 인증 헤더
+type capturedOrder struct {
+	body  map[string]string
+	token string
+}
+
+// newOrderCaptureClient 주문 요청을 기록하는 클라이언트
+func newOrderCaptureClient(t *testing.T, captured *capturedOrder) *UpbitClient {
+	t.Helper()
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&captured.body); err != nil {
+			t.Errorf("주문 요청 디코딩 실패: %v", err)
+		}
+		captured.token = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"uuid":"order-1","state":"wait"}`))
+	}))
+}
+
+func TestCreateOrderSendsOnlyFieldsForOrderType(t *testing.T) {
+	cases := []struct {
+		name                  string
+		side, orderType       string
+		volume, price         float64
+		wantType              string
+		wantPrice, wantVolume string
+	}{
+		{"시장가 매수", "bid", "market", 0.5, 10000, "price", "10000", ""},
+		{"시장가 매도", "ask", "market", 0.5, 10000, "market", "", "0.5"},
+		{"지정가", "bid", "limit", 0.5, 10000, "limit", "10000", "0.5"},
+	}
+
+	for _, tc := range cases {
+		var captured capturedOrder
+		c := newOrderCaptureClient(t, &captured)
+
+		if _, err := c.CreateOrder(context.Background(), "KRW-BTC", tc.side, tc.orderType, tc.volume, tc.price); err != nil {
+			t.Fatalf("%s: 주문 실패: %v", tc.name, err)
+		}
+
+		body := captured.body
+		if body["ord_type"] != tc.wantType || body["price"] != tc.wantPrice || body["volume"] != tc.wantVolume {
+			t.Fatalf("%s: 요청 본문 = %v, want ord_type=%s price=%q volume=%q", tc.name, body, tc.wantType, tc.wantPrice, tc.wantVolume)
+		}
+		if _, ok := body["volume"]; ok && tc.wantVolume == "" {
+			t.Fatalf("%s: volume 필드가 포함됨: %v", tc.name, body)
+		}
+		if _, ok := body["price"]; ok && tc.wantPrice == "" {
+			t.Fatalf("%s: price 필드가 포함됨: %v", tc.name, body)
+		}
+	}
+}
+
+func TestCreateOrderQueryHashMatchesBody(t *testing.T) {
+	var captured capturedOrder
+	c := newOrderCaptureClient(t, &captured)
+
+	if _, err := c.CreateOrder(context.Background(), "KRW-BTC", "bid", "market", 0, 10000); err != nil {
+		t.Fatalf("주문 실패: %v", err)
+	}
+
+	query := url.Values{}
+	for key, value := range captured.body {
+		query.Add(key, value)
+	}
+	want := fmt.Sprintf("%x", sha512.Sum512([]byte(query.Encode())))
+	if claims := parseTestJWT(t, captured.token, "secret"); claims["query_hash"] != want {
+		t.Fatalf("query_hash = %v, want 요청 본문 해시 %s", claims["query_hash"], want)
+	}
+}
@@ -0,0 +1,99 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// GetOpenOrders 미체결 주문 목록 조회
+// 재시작 시 거래소에 남아 있는 주문을 DB 주문 기록과 대조하는 데 사용한다. 모든 페이지를 이어서 반환한다.
+func (c *UpbitClient) GetOpenOrders(ctx context.Context, marketID string) ([]OrderResponse, error) {
+	var orders []OrderResponse
+	for page := 1; ; page++ {
+		params := map[string]string{
+			"state": "wait",
+			"page":  strconv.Itoa(page),
+			"limit": strconv.Itoa(maxListPageSize),
+		}
+		if marketID != "" {
+			params["market"] = marketID
+		}
+
+		var result []OrderResponse
+		if err := c.doRequest(ctx, c.orderLimiter.Urgent(), c.orderListRequest(ctx, "/orders/open", params), http.StatusOK, &result); err != nil {
+			return nil, err
+		}
+		orders = append(orders, result...)
+
+		if len(result) < maxListPageSize {
+			return orders, nil
+		}
+	}
+}
+
+// GetClosedOrders 종료된 주문(체결 완료, 취소) 목록의 한 페이지 조회
+func (c *UpbitClient) GetClosedOrders(ctx context.Context, marketID string, page, limit int) ([]OrderResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 || limit > maxListPageSize {
+		limit = maxListPageSize
+	}
+
+	params := map[string]string{
+		"page":     strconv.Itoa(page),
+		"limit":    strconv.Itoa(limit),
+		"order_by": "desc",
+	}
+	if marketID != "" {
+		params["market"] = marketID
+	}
+
+	var orders []OrderResponse
+	if err := c.doRequest(ctx, c.orderLimiter, c.orderListRequest(ctx, "/orders/closed", params), http.StatusOK, &orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// CancelAllOrders 미체결 주문 전체 취소 (marketID가 비어 있으면 모든 마켓)
+// 일부 취소가 실패해도 나머지 주문은 계속 취소하며, 실패한 주문 UUID를 모은 오류를 함께 반환한다.
+// 취소 요청은 주문 요청 한도를 따르므로 주문이 많으면 한도에 맞춰 대기한다.
+func (c *UpbitClient) CancelAllOrders(ctx context.Context, marketID string) ([]OrderResponse, error) {
+	orders, err := c.GetOpenOrders(ctx, marketID)
+	if err != nil {
+		return nil, fmt.Errorf("미체결 주문 조회 실패: %w", err)
+	}
+
+	var cancelled []OrderResponse
+	var errs []error
+	for _, order := range orders {
+		resp, err := c.CancelOrder(ctx, order.UUID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("주문 취소 실패 (%s): %w", order.UUID, err))
+			continue
+		}
+		cancelled = append(cancelled, *resp)
+	}
+
+	return cancelled, errors.Join(errs...)
+}
+
+// orderListRequest 주문 목록 조회 요청 생성 함수
+// 쿼리 문자열과 JWT의 query_hash를 같은 파라미터로 만들어 필터가 서명에 모두 포함되게 한다.
+func (c *UpbitClient) orderListRequest(ctx context.Context, path string, params map[string]string) func() (*http.Request, error) {
+	query := url.Values{}
+	for key, value := range params {
+		query.Add(key, value)
+	}
+	endpoint := fmt.Sprintf("%s%s?%s", upbitAPIURL, path, query.Encode())
+
+	return func() (*http.Request, error) {
+		return c.newAuthRequest(ctx, "GET", endpoint, params, nil)
+	}
+}
@@ -0,0 +1,58 @@
+package exchange
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// 주문 상태 (model.Order.Status)
+const (
+	OrderStatusWait   = "WAIT"
+	OrderStatusDone   = "DONE"
+	OrderStatusCancel = "CANCEL"
+)
+
+// orderStates 업비트 주문 상태별 모델 상태
+var orderStates = map[string]string{
+	"wait":   OrderStatusWait, // 체결 대기
+	"watch":  OrderStatusWait, // 예약 주문 대기
+	"done":   OrderStatusDone, // 전체 체결 완료
+	"cancel": OrderStatusCancel,
+}
+
+// MapOrderState 업비트 주문 상태를 모델 상태로 변환
+// 알 수 없는 상태는 잘못 종료 처리하지 않도록 미체결(WAIT)로 간주하고 known=false를 반환한다.
+func MapOrderState(state string) (status string, known bool) {
+	status, known = orderStates[state]
+	if !known {
+		return OrderStatusWait, false
+	}
+	return status, true
+}
+
+// IsTerminalStatus 더 이상 변하지 않는 주문 상태인지 여부
+func IsTerminalStatus(status string) bool {
+	return status == OrderStatusDone || status == OrderStatusCancel
+}
+
+// applyOrderResponse 주문 조회 응답을 주문 기록에 반영
+// 상태나 체결 수량이 바뀌었으면 주문 이벤트도 남긴다.
+func (e *OrderExecutor) applyOrderResponse(order *model.Order, resp *OrderResponse) {
+	previous := *order
+	status, known := MapOrderState(resp.State)
+	if !known {
+		e.logger.Error("알 수 없는 주문 상태, 미체결로 처리:", order.OrderID, resp.State)
+	}
+
+	if executed, err := strconv.ParseFloat(resp.ExecutedVolume, 64); err == nil {
+		order.ExecutedVolume = executed
+	}
+	order.Status = status
+	order.LastUpdated = time.Now()
+
+	if eventType := orderEventType(previous, *order); eventType != "" {
+		e.recordOrderEvent(order, eventType)
+	}
+}
@@ -0,0 +1,56 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestMapOrderState(t *testing.T) {
+	cases := []struct {
+		state  string
+		status string
+		known  bool
+	}{
+		{"wait", OrderStatusWait, true},
+		{"watch", OrderStatusWait, true},
+		{"done", OrderStatusDone, true},
+		{"cancel", OrderStatusCancel, true},
+		{"prevented", OrderStatusWait, false},
+		{"", OrderStatusWait, false},
+	}
+	for _, c := range cases {
+		status, known := MapOrderState(c.state)
+		if status != c.status || known != c.known {
+			t.Errorf("MapOrderState(%q) = %s, %v, want %s, %v", c.state, status, known, c.status, c.known)
+		}
+	}
+}
+
+func TestApplyOrderResponseKeepsUnknownStateOpen(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{RecordOrderEvents: true})
+	order := &model.Order{MarketID: "KRW-BTC", OrderID: "order-1", Side: "BUY", OrderType: "limit", Status: OrderStatusWait, LastUpdated: time.Now()}
+
+	e.applyOrderResponse(order, &OrderResponse{UUID: "order-1", State: "mystery", ExecutedVolume: "0.5"})
+	if order.Status != OrderStatusWait || IsTerminalStatus(order.Status) {
+		t.Fatalf("알 수 없는 상태 적용 후 상태 = %s, want WAIT", order.Status)
+	}
+	if order.ExecutedVolume != 0.5 {
+		t.Fatalf("체결 수량 = %v, want 0.5", order.ExecutedVolume)
+	}
+
+	e.applyOrderResponse(order, &OrderResponse{UUID: "order-1", State: "done", ExecutedVolume: "1"})
+	if order.Status != OrderStatusDone || !IsTerminalStatus(order.Status) {
+		t.Fatalf("done 적용 후 상태 = %s, want DONE", order.Status)
+	}
+
+	var events []model.OrderEvent
+	if err := db.Order("id").Find(&events).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].EventType != OrderEventPartiallyFilled || events[1].EventType != OrderEventFilled {
+		t.Fatalf("주문 이벤트 = %+v, want 부분 체결, 체결", events)
+	}
+}
@@ -0,0 +1,441 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+)
+
+// Exchange 주문 실행기가 사용하는 거래소 기능
+// 실거래는 UpbitClient, 모의 거래는 PaperClient가 구현한다.
+type Exchange interface {
+	CreateOrder(ctx context.Context, marketID, side, orderType string, volume, price float64) (*OrderResponse, error)
+	CancelOrder(ctx context.Context, uuid string) (*OrderResponse, error)
+	GetOrder(ctx context.Context, uuid string) (*OrderResponse, error)
+	GetOrderTrades(ctx context.Context, uuid string) ([]OrderTrade, error)
+	GetAccounts(ctx context.Context) ([]Account, error)
+	GetOrderChance(ctx context.Context, marketID string) (*OrderChance, error)
+	GetTicker(ctx context.Context, marketID string) (*Ticker, error)
+	GetOrderbook(ctx context.Context, marketID string) (*Orderbook, error)
+}
+
+// 거래 모드
+const (
+	TradingModeLive  = "live"
+	TradingModePaper = "paper"
+)
+
+// 모의 주문 상태 (업비트 응답 형식)
+const (
+	paperStateWait   = "wait"
+	paperStateDone   = "done"
+	paperStateCancel = "cancel"
+)
+
+// paperOrder 모의 주문
+type paperOrder struct {
+	response OrderResponse
+	price    float64 // 지정가 또는 시장가 매수 총액
+	volume   float64
+	paidFee  float64
+	trades   []OrderTrade
+}
+
+// paperBalance 모의 코인 잔고
+type paperBalance struct {
+	balance  float64
+	locked   float64
+	avgPrice float64
+}
+
+// PaperClient 실제 시세로 체결을 흉내 내는 모의 거래 클라이언트
+// 시세 조회는 UpbitClient를 그대로 쓰고, 주문과 잔고는 메모리의 가상 계좌로 처리한다.
+// 시장가 주문은 현재가로 바로 체결하고, 지정가 주문은 주문·잔고 조회 시점의 현재가가 지정가에 닿으면 체결한다.
+// 주문과 체결 응답 형식이 실거래와 같으므로 주문 실행기가 같은 경로로 주문과 체결 내역을 DB에 기록한다.
+type PaperClient struct {
+	*UpbitClient
+
+	feeRate float64 // 수수료율 (%)
+	logger  *utils.Logger
+
+	mu       sync.Mutex
+	krw      float64
+	krwLock  float64
+	balances map[string]*paperBalance
+	orders   map[string]*paperOrder
+}
+
+// NewPaperClient 새로운 모의 거래 클라이언트 생성
+// initialKRW는 가상 계좌의 시작 원화 잔고, feeRate는 체결마다 차감할 수수료율(%)이다.
+func NewPaperClient(client *UpbitClient, initialKRW, feeRate float64) *PaperClient {
+	if feeRate <= 0 {
+		feeRate = defaultFeeRate
+	}
+
+	return &PaperClient{
+		UpbitClient: client,
+		feeRate:     feeRate,
+		logger:      utils.NewLogger("paper"),
+		krw:         initialKRW,
+		balances:    make(map[string]*paperBalance),
+		orders:      make(map[string]*paperOrder),
+	}
+}
+
+// CreateOrder 모의 주문 생성
+// 주문에 필요한 잔고는 바로 묶어 두며, 잔고가 부족하면 업비트와 같은 오류를 돌려준다.
+func (p *PaperClient) CreateOrder(ctx context.Context, marketID, side, orderType string, volume, price float64) (*OrderResponse, error) {
+	if orderType == "limit" {
+		price = NormalizePrice(marketID, price)
+	}
+
+	ticker, err := p.UpbitClient.GetTicker(ctx, marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	order := &paperOrder{
+		response: OrderResponse{
+			UUID:      uuid.New().String(),
+			Side:      side,
+			OrderType: orderType,
+			State:     paperStateWait,
+			MarketID:  marketID,
+			CreatedAt: time.Now().Format(time.RFC3339),
+		},
+		price:  price,
+		volume: volume,
+	}
+	if orderType == "market" && side == "bid" {
+		order.response.OrderType = "price"
+	}
+
+	if err := p.lock(order); err != nil {
+		return nil, err
+	}
+	p.orders[order.response.UUID] = order
+
+	p.tryFill(order, ticker.TradePrice, time.Now())
+	p.logger.Info("모의 주문:", marketID, side, orderType, price, volume, order.response.State)
+
+	response := order.snapshot()
+	return &response, nil
+}
+
+// CancelOrder 모의 주문 취소 (묶인 잔고 해제)
+func (p *PaperClient) CancelOrder(ctx context.Context, id string) (*OrderResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	order, err := p.order(id)
+	if err != nil {
+		return nil, err
+	}
+	if order.response.State == paperStateWait {
+		p.unlock(order)
+		order.response.State = paperStateCancel
+	}
+
+	response := order.snapshot()
+	return &response, nil
+}
+
+// CancelAllOrders 미체결 모의 주문 전체 취소 (marketID가 비어 있으면 모든 마켓)
+// 임베드한 UpbitClient의 구현은 실제 계정의 주문을 취소하므로 모의 거래에서는 이 구현을 써야 한다.
+func (p *PaperClient) CancelAllOrders(ctx context.Context, marketID string) ([]OrderResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var cancelled []OrderResponse
+	for _, order := range p.orders {
+		if order.response.State != paperStateWait || (marketID != "" && order.response.MarketID != marketID) {
+			continue
+		}
+		p.unlock(order)
+		order.response.State = paperStateCancel
+		cancelled = append(cancelled, order.snapshot())
+	}
+
+	return cancelled, nil
+}
+
+// GetOrder 모의 주문 조회
+func (p *PaperClient) GetOrder(ctx context.Context, id string) (*OrderResponse, error) {
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	order, err := p.order(id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := order.snapshot()
+	return &response, nil
+}
+
+// GetOrderTrades 모의 주문 체결 내역 조회
+func (p *PaperClient) GetOrderTrades(ctx context.Context, id string) ([]OrderTrade, error) {
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	order, err := p.order(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]OrderTrade(nil), order.trades...), nil
+}
+
+// GetAccounts 가상 계좌 잔고 조회
+func (p *PaperClient) GetAccounts(ctx context.Context) ([]Account, error) {
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	accounts := []Account{{
+		Currency:    "KRW",
+		Balance:     formatAmount(p.krw),
+		Locked:      formatAmount(p.krwLock),
+		AvgBuyPrice: "0",
+	}}
+	for currency, b := range p.balances {
+		accounts = append(accounts, Account{
+			Currency:    currency,
+			Balance:     formatAmount(b.balance),
+			Locked:      formatAmount(b.locked),
+			AvgBuyPrice: formatAmount(b.avgPrice),
+		})
+	}
+
+	return accounts, nil
+}
+
+// GetOrderChance 모의 주문 가능 정보 (설정 수수료율과 업비트 최소 주문 금액)
+func (p *PaperClient) GetOrderChance(ctx context.Context, marketID string) (*OrderChance, error) {
+	fee := formatAmount(p.feeRate / 100)
+	minTotal := json.Number(formatAmount(MinOrderAmount))
+
+	return &OrderChance{
+		BidFee:      fee,
+		AskFee:      fee,
+		MakerBidFee: fee,
+		MakerAskFee: fee,
+		Market: OrderChanceMarket{
+			ID:    marketID,
+			Bid:   OrderChanceConstraint{Currency: "KRW", MinTotal: minTotal},
+			Ask:   OrderChanceConstraint{Currency: "KRW", MinTotal: minTotal},
+			State: "active",
+		},
+	}, nil
+}
+
+// refresh 미체결 지정가 주문을 현재가로 체결 확인
+func (p *PaperClient) refresh(ctx context.Context) error {
+	p.mu.Lock()
+	markets := make(map[string]bool)
+	for _, order := range p.orders {
+		if order.response.State == paperStateWait {
+			markets[order.response.MarketID] = true
+		}
+	}
+	p.mu.Unlock()
+	if len(markets) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(markets))
+	for marketID := range markets {
+		ids = append(ids, marketID)
+	}
+	tickers, err := p.UpbitClient.GetTickers(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	prices := make(map[string]float64, len(tickers))
+	for _, ticker := range tickers {
+		prices[ticker.MarketID] = ticker.TradePrice
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for _, order := range p.orders {
+		if price, ok := prices[order.response.MarketID]; ok && order.response.State == paperStateWait {
+			p.tryFill(order, price, now)
+		}
+	}
+
+	return nil
+}
+
+// lock 주문에 필요한 잔고 묶기
+// 매수는 수수료까지 포함한 원화를, 매도는 코인 수량을 묶는다.
+func (p *PaperClient) lock(order *paperOrder) error {
+	if order.response.Side == "bid" {
+		required := order.cost() * (1 + p.feeRate/100)
+		if required > p.krw {
+			return &UpbitAPIError{StatusCode: http.StatusBadRequest, Name: APIErrorInsufficientFundsBid, Message: "모의 계좌 원화 잔고 부족"}
+		}
+		p.krw -= required
+		p.krwLock += required
+		return nil
+	}
+
+	b := p.balances[currencyOf(order.response.MarketID)]
+	if b == nil || order.volume > b.balance {
+		return &UpbitAPIError{StatusCode: http.StatusBadRequest, Name: APIErrorInsufficientFundsAsk, Message: "모의 계좌 코인 잔고 부족"}
+	}
+	b.balance -= order.volume
+	b.locked += order.volume
+	return nil
+}
+
+// unlock 취소된 주문의 묶인 잔고 해제
+func (p *PaperClient) unlock(order *paperOrder) {
+	if order.response.Side == "bid" {
+		locked := order.cost() * (1 + p.feeRate/100)
+		p.krwLock -= locked
+		p.krw += locked
+		return
+	}
+
+	// 묶인 코인 잔고가 없으면 되돌릴 것이 없다
+	b := p.balances[currencyOf(order.response.MarketID)]
+	if b == nil {
+		p.logger.Warn("모의 계좌에 묶인 코인 잔고 없음:", order.response.MarketID, order.response.UUID)
+		return
+	}
+	b.locked -= order.volume
+	b.balance += order.volume
+}
+
+// tryFill 현재가로 체결 가능하면 전량 체결
+// 시장가는 항상, 지정가 매수는 현재가가 지정가 이하, 지정가 매도는 현재가가 지정가 이상일 때 지정가로 체결한다.
+func (p *PaperClient) tryFill(order *paperOrder, tradePrice float64, now time.Time) {
+	fillPrice := order.price
+	switch {
+	case order.response.OrderType != "limit":
+		fillPrice = tradePrice
+	case order.response.Side == "bid" && tradePrice > order.price:
+		return
+	case order.response.Side == "ask" && tradePrice < order.price:
+		return
+	}
+	if fillPrice <= 0 {
+		return
+	}
+
+	volume := order.volume
+	if order.response.OrderType == "price" {
+		volume = RoundVolume(order.price / fillPrice)
+	}
+	funds := fillPrice * volume
+	fee := funds * p.feeRate / 100
+
+	currency := currencyOf(order.response.MarketID)
+	if order.response.Side == "bid" {
+		p.krwLock -= order.cost() * (1 + p.feeRate/100)
+		p.krw += order.cost()*(1+p.feeRate/100) - funds - fee
+
+		b := p.balances[currency]
+		if b == nil {
+			b = &paperBalance{}
+			p.balances[currency] = b
+		}
+		held := b.balance + b.locked
+		b.avgPrice = (b.avgPrice*held + funds) / (held + volume)
+		b.balance += volume
+	} else {
+		b := p.balances[currency]
+		b.locked -= volume
+		p.krw += funds - fee
+		if b.balance+b.locked <= 0 {
+			delete(p.balances, currency)
+		}
+	}
+
+	order.volume = volume
+	order.paidFee += fee
+	order.response.State = paperStateDone
+	order.trades = append(order.trades, OrderTrade{
+		UUID:      uuid.New().String(),
+		Price:     formatAmount(fillPrice),
+		Volume:    formatAmount(volume),
+		Funds:     formatAmount(funds),
+		CreatedAt: now.Format(time.RFC3339),
+	})
+}
+
+// order 모의 주문 찾기
+func (p *PaperClient) order(id string) (*paperOrder, error) {
+	order, ok := p.orders[id]
+	if !ok {
+		return nil, &UpbitAPIError{StatusCode: http.StatusNotFound, Name: "order_not_found", Message: "모의 주문을 찾을 수 없습니다: " + id}
+	}
+	return order, nil
+}
+
+// cost 주문에 필요한 원화 (수수료 제외)
+func (o *paperOrder) cost() float64 {
+	if o.response.OrderType == "price" {
+		return o.price
+	}
+	return o.price * o.volume
+}
+
+// snapshot 현재 상태의 주문 응답
+func (o *paperOrder) snapshot() OrderResponse {
+	response := o.response
+	executed := 0.0
+	for _, trade := range o.trades {
+		volume, _ := strconv.ParseFloat(trade.Volume, 64)
+		executed += volume
+	}
+
+	if o.response.OrderType != "price" {
+		response.Volume = formatAmount(o.volume)
+		response.RemainingVolume = formatAmount(o.volume - executed)
+	}
+	if o.response.OrderType != "market" {
+		response.Price = formatAmount(o.price)
+	}
+	response.ExecutedVolume = formatAmount(executed)
+	response.PaidFee = formatAmount(o.paidFee)
+	return response
+}
+
+// currencyOf 마켓의 거래 화폐 (KRW-BTC → BTC)
+func currencyOf(marketID string) string {
+	if i := strings.Index(marketID, "-"); i >= 0 {
+		return marketID[i+1:]
+	}
+	return marketID
+}
+
+// formatAmount 금액/수량 문자열 (업비트 응답 형식)
+func formatAmount(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
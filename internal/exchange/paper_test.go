@@ -0,0 +1,191 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// paperTicker 현재가를 바꿀 수 있는 KRW-BTC 현재가 API
+type paperTicker struct {
+	price atomic.Value // float64
+}
+
+func (p *paperTicker) set(price float64) { p.price.Store(price) }
+
+func (p *paperTicker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, `[{"market":"KRW-BTC","trade_price":%v}]`, p.price.Load())
+}
+
+// newTestPaperClient 시작 잔고 100만원, 수수료 0.05%인 모의 거래 클라이언트
+func newTestPaperClient(t *testing.T, price float64) (*PaperClient, *paperTicker) {
+	t.Helper()
+	ticker := &paperTicker{}
+	ticker.set(price)
+	return NewPaperClient(newTestClient(t, ticker), 1000000, 0.05), ticker
+}
+
+// paperBalances 가상 계좌의 화폐별 잔고와 묶인 수량
+func paperBalances(t *testing.T, p *PaperClient) map[string][2]float64 {
+	t.Helper()
+	accounts, err := p.GetAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("GetAccounts 오류: %v", err)
+	}
+	balances := make(map[string][2]float64, len(accounts))
+	for _, account := range accounts {
+		balance, _ := strconv.ParseFloat(account.Balance, 64)
+		locked, _ := strconv.ParseFloat(account.Locked, 64)
+		balances[account.Currency] = [2]float64{balance, locked}
+	}
+	return balances
+}
+
+func TestPaperMarketBuyFillsAtTradePriceMinusFee(t *testing.T) {
+	p, _ := newTestPaperClient(t, 50000000)
+
+	resp, err := p.CreateOrder(context.Background(), "KRW-BTC", "bid", "market", 0, 100000)
+	if err != nil {
+		t.Fatalf("모의 시장가 매수 실패: %v", err)
+	}
+	if resp.State != paperStateDone || resp.ExecutedVolume != "0.002" || resp.PaidFee != "50" {
+		t.Fatalf("주문 응답 = %+v, want done, 0.002 체결, 수수료 50", resp)
+	}
+
+	balances := paperBalances(t, p)
+	if krw := balances["KRW"]; krw[0] != 899950 || krw[1] != 0 {
+		t.Fatalf("원화 잔고 = %v, want 899950 (수수료 포함 차감), 묶인 금액 0", krw)
+	}
+	if btc := balances["BTC"]; btc[0] != 0.002 {
+		t.Fatalf("BTC 잔고 = %v, want 0.002", btc)
+	}
+
+	trades, err := p.GetOrderTrades(context.Background(), resp.UUID)
+	if err != nil || len(trades) != 1 || trades[0].Price != "50000000" || trades[0].Funds != "100000" {
+		t.Fatalf("체결 내역 = %+v, %v, want 50000000에 100000원", trades, err)
+	}
+}
+
+func TestPaperLimitOrderFillsWhenTickerCrosses(t *testing.T) {
+	p, ticker := newTestPaperClient(t, 50000000)
+	ctx := context.Background()
+
+	resp, err := p.CreateOrder(ctx, "KRW-BTC", "bid", "limit", 0.01, 49000000)
+	if err != nil {
+		t.Fatalf("모의 지정가 매수 실패: %v", err)
+	}
+	if resp.State != paperStateWait {
+		t.Fatalf("주문 상태 = %s, want wait (현재가가 지정가보다 높음)", resp.State)
+	}
+	if krw := paperBalances(t, p)["KRW"]; krw[1] != 490245 {
+		t.Fatalf("묶인 원화 = %v, want 490245 (수수료 포함)", krw[1])
+	}
+
+	ticker.set(48900000)
+	order, err := p.GetOrder(ctx, resp.UUID)
+	if err != nil {
+		t.Fatalf("GetOrder 오류: %v", err)
+	}
+	if order.State != paperStateDone || order.ExecutedVolume != "0.01" {
+		t.Fatalf("주문 = %+v, want 현재가가 지정가 아래로 내려가 체결", order)
+	}
+
+	// 지정가로 체결되어 수수료만큼만 더 빠진다
+	if krw := paperBalances(t, p)["KRW"]; math.Abs(krw[0]-509755) > 1e-6 || krw[1] != 0 {
+		t.Fatalf("원화 잔고 = %v, want 509755, 묶인 금액 0", krw)
+	}
+}
+
+func TestPaperCancelReleasesLockedBalance(t *testing.T) {
+	p, _ := newTestPaperClient(t, 50000000)
+	ctx := context.Background()
+
+	if _, err := p.CreateOrder(ctx, "KRW-BTC", "bid", "market", 0, 500000); err != nil {
+		t.Fatal(err)
+	}
+	ask, err := p.CreateOrder(ctx, "KRW-BTC", "ask", "limit", 0.004, 60000000)
+	if err != nil {
+		t.Fatalf("모의 지정가 매도 실패: %v", err)
+	}
+	if btc := paperBalances(t, p)["BTC"]; btc[0] != 0.006 || btc[1] != 0.004 {
+		t.Fatalf("BTC 잔고 = %v, want 0.006, 묶인 수량 0.004", btc)
+	}
+
+	resp, err := p.CancelOrder(ctx, ask.UUID)
+	if err != nil || resp.State != paperStateCancel {
+		t.Fatalf("취소 응답 = %+v, %v, want cancel", resp, err)
+	}
+	if btc := paperBalances(t, p)["BTC"]; btc[0] != 0.01 || btc[1] != 0 {
+		t.Fatalf("취소 후 BTC 잔고 = %v, want 0.01, 묶인 수량 0", btc)
+	}
+}
+
+func TestPaperUnlockWithoutBalanceDoesNotPanic(t *testing.T) {
+	p, _ := newTestPaperClient(t, 50000000)
+	ctx := context.Background()
+
+	if _, err := p.CreateOrder(ctx, "KRW-BTC", "bid", "market", 0, 100000); err != nil {
+		t.Fatal(err)
+	}
+	ask, err := p.CreateOrder(ctx, "KRW-BTC", "ask", "limit", 0.002, 60000000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p.mu.Lock()
+	delete(p.balances, "BTC")
+	p.mu.Unlock()
+
+	resp, err := p.CancelOrder(ctx, ask.UUID)
+	if err != nil || resp.State != paperStateCancel {
+		t.Fatalf("취소 응답 = %+v, %v, want cancel", resp, err)
+	}
+}
+
+func TestPaperRejectsOrdersBeyondBalance(t *testing.T) {
+	p, _ := newTestPaperClient(t, 50000000)
+	ctx := context.Background()
+
+	if _, err := p.CreateOrder(ctx, "KRW-BTC", "bid", "market", 0, 1000000); !IsAPIError(err, APIErrorInsufficientFundsBid) {
+		t.Fatalf("수수료 포함 잔고 초과 매수 오류 = %v, want insufficient_funds_bid", err)
+	}
+	if _, err := p.CreateOrder(ctx, "KRW-BTC", "ask", "market", 0.1, 0); !errors.Is(err, ErrInsufficientFunds) || !IsAPIError(err, APIErrorInsufficientFundsAsk) {
+		t.Fatalf("보유하지 않은 코인 매도 오류 = %v, want insufficient_funds_ask", err)
+	}
+}
+
+func TestPaperOrdersAreRecordedByExecutor(t *testing.T) {
+	p, _ := newTestPaperClient(t, 50000000)
+	db := newTestDB(t)
+	e := NewOrderExecutor(db, p, &fakeRisk{equity: 1000000}, nil, nil, &config.TradingConfig{})
+	ctx := context.Background()
+
+	record, err := e.submit(ctx, Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "market", Price: 100000}, 0)
+	if err != nil {
+		t.Fatalf("모의 주문 전송 실패: %v", err)
+	}
+	if err := e.trackOrder(ctx, record); err != nil {
+		t.Fatalf("trackOrder 오류: %v", err)
+	}
+
+	var trade model.Trade
+	if err := db.Where("order_id = ?", record.OrderID).First(&trade).Error; err != nil {
+		t.Fatalf("모의 체결이 기록되지 않음: %v", err)
+	}
+	if trade.Price != 50000000 || trade.Volume != 0.002 || trade.Fee != 50 {
+		t.Fatalf("체결 기록 = %v x %v, 수수료 %v, want 50000000 x 0.002, 50", trade.Price, trade.Volume, trade.Fee)
+	}
+
+	var position model.Position
+	if err := db.Where("market_id = ? AND status = ?", "KRW-BTC", "OPEN").First(&position).Error; err != nil {
+		t.Fatalf("모의 포지션 없음: %v", err)
+	}
+}
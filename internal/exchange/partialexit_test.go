@@ -0,0 +1,29 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestExitSellsFractionOfPosition(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now().Add(-time.Hour), Quantity: 1, Status: "OPEN", LastPrice: 100000}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	signal := model.Signal{MarketID: "KRW-BTC", SignalType: "SELL", Price: 110000, Timestamp: time.Now(), Parameters: model.Parameters{"exit_fraction": 0.3}}
+	if err := e.exit(context.Background(), signal); err != nil {
+		t.Fatalf("부분 매도 실패: %v", err)
+	}
+
+	created := client.createdOrders()
+	if len(created) != 1 || created[0].Side != "ask" || created[0].Volume != 0.3 {
+		t.Fatalf("매도 주문 = %+v, want 0.3개 매도", created)
+	}
+}
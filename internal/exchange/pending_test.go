@@ -0,0 +1,65 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// buySignal 매수 신호
+func buySignal(marketID string, price float64) model.Signal {
+	return model.Signal{MarketID: marketID, StrategyName: "test", SignalType: "BUY", Price: price, Confidence: 1, Timestamp: time.Now()}
+}
+
+func TestEnterRejectsSecondPendingEntry(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{MaxPositionSize: 10, SinglePendingEntry: true})
+	ctx := context.Background()
+
+	if err := e.enter(ctx, buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("첫 매수 주문 실패: %v", err)
+	}
+	if err := e.enter(ctx, buySignal("KRW-BTC", 100000)); !errors.Is(err, ErrPendingEntryExists) {
+		t.Fatalf("두 번째 매수 오류 = %v, want ErrPendingEntryExists", err)
+	}
+	if n := len(client.createdOrders()); n != 1 {
+		t.Fatalf("주문 요청 수 = %d, want 1", n)
+	}
+
+	// 다른 마켓은 막지 않는다
+	if err := e.enter(ctx, buySignal("KRW-ETH", 100000)); err != nil {
+		t.Fatalf("다른 마켓 매수 주문 실패: %v", err)
+	}
+}
+
+func TestEnterAllowsEntryAfterPendingOrderCompletes(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{MaxPositionSize: 10, SinglePendingEntry: true})
+	ctx := context.Background()
+
+	if err := e.enter(ctx, buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("첫 매수 주문 실패: %v", err)
+	}
+	if err := db.Model(&model.Order{}).Where("order_id = ?", "order-1").Update("status", OrderStatusDone).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := e.enter(ctx, buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("체결 후 매수 주문 실패: %v", err)
+	}
+}
+
+func TestEnterAllowsMultiplePendingEntriesWhenDisabled(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{MaxPositionSize: 10})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := e.enter(ctx, buySignal("KRW-BTC", 100000)); err != nil {
+			t.Fatalf("%d번째 매수 주문 실패: %v", i+1, err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
+	"gorm.io/gorm"
+)
+
+// orderFill 주문의 체결 합계
+type orderFill struct {
+	Volume   float64
+	Notional float64
+	Fee      float64
+}
+
+// filledPortion 기록된 체결 내역으로 계산한 주문의 체결 수량, 평균 체결가, 수수료 합계
+func (e *OrderExecutor) filledPortion(orderID string) (float64, float64, float64, error) {
+	var fill orderFill
+	err := e.db.Model(&model.Trade{}).
+		Select("COALESCE(SUM(volume), 0) AS volume, COALESCE(SUM(price * volume), 0) AS notional, COALESCE(SUM(fee), 0) AS fee").
+		Where("order_id = ?", orderID).
+		Scan(&fill).Error
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("체결 합계 조회 실패: %w", err)
+	}
+	if fill.Volume <= 0 {
+		return 0, 0, 0, nil
+	}
+
+	return fill.Volume, fill.Notional / fill.Volume, fill.Fee, nil
+}
+
+// realizedFill 매도 체결의 실현 손익
+type realizedFill struct {
+	entryPrice float64
+	cost       float64 // 매도 수량의 매수 원가와 매수 수수료
+	profit     float64 // 매수/매도 수수료를 뺀 실현 손익
+}
+
+// realize 매도 체결의 실현 손익 계산
+// 매수 수수료는 보유 수량 중 매도한 비율만큼 나눠 차감하고, 남은 매수 수수료를 돌려준다.
+func realize(entryPrice, quantity, entryFee, price, volume, fee float64) (realizedFill, float64) {
+	share := 1.0
+	if volume < quantity {
+		share = volume / quantity
+	}
+	entryFeePart := entryFee * share
+
+	cost := entryPrice*volume + entryFeePart
+	return realizedFill{
+		entryPrice: entryPrice,
+		cost:       cost,
+		profit:     price*volume - fee - cost,
+	}, entryFee - entryFeePart
+}
+
+// applyFill 체결분을 포지션에 반영 (fee는 체결 내역의 수수료 합계)
+// 매수 체결은 포지션을 열거나 평균 단가로 늘리고, 매도 체결은 수량을 줄이며 모두 팔리면 포지션을 닫는다.
+// 마켓당 포지션 기록은 하나이므로 닫힌 포지션에 새로 매수하면 같은 기록을 다시 연다.
+// 매도 체결의 실현 손익(매수/매도 수수료 차감)은 포지션에 누적하고 위험 관리자의 일별 성과에도 더하며, 포지션이 닫히면 위험 관리자에 알린다.
+// 청산 사유는 실제로 닫힐 때 주문을 낸 신호의 exit_reason 파라미터(STOP 등)로 기록한다.
+func (e *OrderExecutor) applyFill(order *model.Order, price, volume, fee float64, now time.Time) error {
+	var realized realizedFill
+	var position model.Position
+	var closed bool
+	err := e.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("market_id = ?", order.MarketID).First(&position).Error
+		found := err == nil
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("포지션 조회 실패: %w", err)
+		}
+		open := found && position.Status == "OPEN"
+
+		if order.Side == "BUY" {
+			if !open {
+				position = model.Position{
+					Model:        position.Model,
+					MarketID:     order.MarketID,
+					EntryPrice:   price,
+					EntryTime:    now,
+					Quantity:     volume,
+					Status:       "OPEN",
+					ProfitTarget: e.cfg.DefaultProfitTarget,
+					StopLoss:     e.cfg.DefaultStopLoss,
+					LastPrice:    price,
+					HighestPrice: price,
+					EntryFee:     fee,
+				}
+				if found {
+					return tx.Save(&position).Error
+				}
+				return tx.Create(&position).Error
+			}
+
+			total := position.Quantity + volume
+			position.EntryPrice = (position.EntryPrice*position.Quantity + price*volume) / total
+			position.Quantity = total
+			position.LastPrice = price
+			position.EntryFee += fee
+			return tx.Save(&position).Error
+		}
+
+		if !open {
+			return nil
+		}
+		realized, position.EntryFee = realize(position.EntryPrice, position.Quantity, position.EntryFee, price, volume, fee)
+		position.CurrentProfit += realized.profit
+		position.RealizedCost += realized.cost
+		if position.RealizedCost > 0 {
+			position.ProfitPercent = position.CurrentProfit / position.RealizedCost * 100
+		}
+		position.Quantity = RoundVolume(position.Quantity - volume)
+		position.LastPrice = price
+		if position.Quantity <= 0 {
+			position.Quantity = 0
+			position.EntryFee = 0
+			position.Status = "CLOSED"
+			position.ExitPrice = price
+			position.ExitTime = now
+			reason, err := signalExitReason(tx, order.SignalID)
+			if err != nil {
+				return err
+			}
+			position.ExitReason = reason
+			closed = true
+		}
+		return tx.Save(&position).Error
+	})
+	if err != nil {
+		return err
+	}
+	if position.ID != 0 {
+		e.events.Publish(EventPosition, position)
+	}
+	if closed {
+		// 손절 후 재진입 제한 등 위험 관리자의 청산 처리
+		e.risk.OnPositionClosed(&position)
+	}
+	if realized.cost <= 0 {
+		return nil
+	}
+
+	profit := realized.profit
+	if err := e.risk.RecordRealized(order.MarketID, profit, profit/realized.cost*100, now); err != nil {
+		e.logger.Error("실현 손익 기록 실패:", order.MarketID, err)
+	}
+	e.publish(notify.Event{
+		Level:     notify.LevelInfo,
+		Type:      "포지션 매도",
+		Market:    order.MarketID,
+		Price:     price,
+		Profit:    profit,
+		HasProfit: true,
+		Message:   fmt.Sprintf("포지션 매도: %s 수량 %s 평균 매수가 %s 손익 %+.0f원", order.MarketID, strconv.FormatFloat(volume, 'f', -1, 64), strconv.FormatFloat(realized.entryPrice, 'f', -1, 64), profit),
+	})
+	return nil
+}
+
+// signalExitReason 주문을 낸 신호의 청산 사유 (신호 파라미터 exit_reason, 신호나 사유가 없으면 SIGNAL)
+func signalExitReason(tx *gorm.DB, signalID uint) (string, error) {
+	if signalID == 0 {
+		return "SIGNAL", nil
+	}
+
+	var signal model.Signal
+	err := tx.Select("id", "parameters").First(&signal, signalID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "SIGNAL", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("청산 신호 조회 실패: %w", err)
+	}
+
+	if reason, _ := signal.Parameters["exit_reason"].(string); reason != "" {
+		return reason, nil
+	}
+	return "SIGNAL", nil
+}
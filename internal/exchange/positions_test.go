@@ -0,0 +1,114 @@
+package exchange
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestApplyFillComputesNetProfitOnClose(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// 1개를 100,000원(수수료 50원)에 사서 절반씩 110,000원(수수료 27.5원), 90,000원(수수료 22.5원)에 판다
+	if err := e.applyFill(&model.Order{MarketID: "KRW-BTC", Side: "BUY"}, 100000, 1, 50, now); err != nil {
+		t.Fatalf("매수 체결 반영 실패: %v", err)
+	}
+	if err := e.applyFill(&model.Order{MarketID: "KRW-BTC", Side: "SELL"}, 110000, 0.5, 27.5, now.Add(time.Hour)); err != nil {
+		t.Fatalf("첫 매도 체결 반영 실패: %v", err)
+	}
+
+	var position model.Position
+	if err := db.Where("market_id = ?", "KRW-BTC").First(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	if position.Status != "OPEN" || position.Quantity != 0.5 || position.EntryFee != 25 {
+		t.Fatalf("부분 매도 후 포지션 = %+v, want OPEN, 수량 0.5, 남은 매수 수수료 25", position)
+	}
+
+	closedAt := now.Add(2 * time.Hour)
+	if err := e.applyFill(&model.Order{MarketID: "KRW-BTC", Side: "SELL"}, 90000, 0.5, 22.5, closedAt); err != nil {
+		t.Fatalf("두 번째 매도 체결 반영 실패: %v", err)
+	}
+	if err := db.First(&position, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// 매도 대금 100,000 - 매도 수수료 50 - 원가 100,000 - 매수 수수료 50 = -100원
+	if position.Status != "CLOSED" || position.ExitPrice != 90000 || !position.ExitTime.Equal(closedAt) || position.ExitReason != "SIGNAL" {
+		t.Fatalf("청산 포지션 = %+v, want CLOSED, 청산가 90000, SIGNAL", position)
+	}
+	if math.Abs(position.CurrentProfit-(-100)) > 1e-9 {
+		t.Fatalf("실현 손익 = %v, want -100", position.CurrentProfit)
+	}
+	if want := -100.0 / 100050 * 100; math.Abs(position.ProfitPercent-want) > 1e-9 {
+		t.Fatalf("실현 수익률 = %v, want %v", position.ProfitPercent, want)
+	}
+
+	realized := e.risk.(*fakeRisk).realized
+	if len(realized) != 2 || math.Abs(realized[0]-4947.5) > 1e-9 || math.Abs(realized[1]-(-5047.5)) > 1e-9 {
+		t.Fatalf("위험 관리자에 기록된 손익 = %v, want [4947.5 -5047.5]", realized)
+	}
+	// 부분 매도는 청산이 아니므로 위험 관리자에는 마지막 매도에서 한 번만 알린다
+	if closed := e.risk.(*fakeRisk).closed; len(closed) != 1 || closed[0].ID != position.ID || closed[0].Status != "CLOSED" {
+		t.Fatalf("청산 알림 = %+v, want 포지션 %d 한 번", closed, position.ID)
+	}
+}
+
+func TestApplyFillRecordsSignalExitReasonOnlyOnClose(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	signal := model.Signal{MarketID: "KRW-BTC", StrategyName: "TRAILING_STOP", SignalType: "SELL", Timestamp: time.Now(), Parameters: model.Parameters{"exit_reason": "STOP"}}
+	if err := db.Create(&signal).Error; err != nil {
+		t.Fatal(err)
+	}
+	order := &model.Order{MarketID: "KRW-BTC", Side: "SELL", SignalID: signal.ID}
+
+	// 부분 체결로 아직 열려 있는 포지션에는 청산 사유를 남기지 않는다
+	if err := e.applyFill(order, 95000, 0.4, 0, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.First(&position, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if position.Status != "OPEN" || position.ExitReason != "" {
+		t.Fatalf("부분 체결 후 포지션 = %s %q, want OPEN, 사유 없음", position.Status, position.ExitReason)
+	}
+
+	if err := e.applyFill(order, 95000, 0.6, 0, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.First(&position, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if position.Status != "CLOSED" || position.ExitReason != "STOP" {
+		t.Fatalf("청산 포지션 = %s %s, want CLOSED STOP", position.Status, position.ExitReason)
+	}
+	if closed := e.risk.(*fakeRisk).closed; len(closed) != 1 || closed[0].ExitReason != "STOP" || closed[0].MarketID != "KRW-BTC" {
+		t.Fatalf("청산 알림 = %+v, want KRW-BTC STOP", closed)
+	}
+}
+
+func TestApplyFillDefaultsExitReasonWithoutSignal(t *testing.T) {
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.applyFill(&model.Order{MarketID: "KRW-BTC", Side: "SELL"}, 95000, 1, 0, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.First(&position, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if position.Status != "CLOSED" || position.ExitReason != "SIGNAL" {
+		t.Fatalf("청산 포지션 = %s %s, want CLOSED SIGNAL", position.Status, position.ExitReason)
+	}
+}
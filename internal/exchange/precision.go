@@ -0,0 +1,127 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// volumePrecision 주문 수량 소수점 자릿수
+const volumePrecision = 8
+
+// ErrBelowMinOrderAmount 반올림 후 주문 금액이 최소 주문 금액 미만
+var ErrBelowMinOrderAmount = errors.New("주문 금액이 최소 주문 금액보다 작습니다")
+
+// priceUnit KRW 마켓 호가 단위 구간
+type priceUnit struct {
+	min  float64
+	tick float64
+}
+
+// krwPriceUnits KRW 마켓 가격대별 호가 단위 (높은 가격대부터)
+var krwPriceUnits = []priceUnit{
+	{2000000, 1000},
+	{1000000, 500},
+	{500000, 100},
+	{100000, 50},
+	{10000, 10},
+	{1000, 5},
+	{100, 1},
+	{10, 0.1},
+	{1, 0.01},
+	{0.1, 0.001},
+	{0, 0.0001},
+}
+
+// TickSize 가격에 해당하는 호가 단위
+func TickSize(price float64) float64 {
+	for _, unit := range krwPriceUnits {
+		if price >= unit.min {
+			return unit.tick
+		}
+	}
+	return krwPriceUnits[len(krwPriceUnits)-1].tick
+}
+
+// RoundPrice 가격을 호가 단위로 맞춤
+// 매수는 내림, 매도는 올림하여 의도한 가격보다 불리하게 체결되지 않도록 한다.
+func RoundPrice(price float64, side string) float64 {
+	tick := TickSize(price)
+	units := price / tick
+	if side == "bid" {
+		units = math.Floor(units + 1e-9)
+	} else {
+		units = math.Ceil(units - 1e-9)
+	}
+
+	return tickPrice(units, tick)
+}
+
+// NormalizePrice 지정가 주문 가격을 마켓 호가 단위의 가장 가까운 값으로 맞춤
+// KRW 마켓만 호가 단위 표를 적용하고, 다른 마켓의 가격은 그대로 반환한다.
+func NormalizePrice(marketID string, price float64) float64 {
+	if !strings.HasPrefix(marketID, "KRW-") || price <= 0 {
+		return price
+	}
+
+	tick := TickSize(price)
+	return tickPrice(math.Round(price/tick), tick)
+}
+
+// tickPrice 호가 단위 개수를 가격으로 변환
+// 부동소수점 오차를 호가 단위 자릿수에서 정리한다.
+func tickPrice(units, tick float64) float64 {
+	decimals := math.Max(0, -math.Floor(math.Log10(tick)))
+	scale := math.Pow(10, decimals)
+	return math.Round(units*tick*scale) / scale
+}
+
+// RoundVolume 수량을 허용 자릿수로 내림
+func RoundVolume(volume float64) float64 {
+	scale := math.Pow(10, volumePrecision)
+	return math.Floor(volume*scale+1e-6) / scale
+}
+
+// roundOrder 주문 가격과 수량을 거래소 정밀도에 맞춤
+// 시장가 매수는 price가 주문 금액이므로 원 단위로 내림한다.
+func roundOrder(order *Order) {
+	switch {
+	case order.OrderType == "limit":
+		order.Price = RoundPrice(order.Price, order.Side)
+		order.Volume = RoundVolume(order.Volume)
+	case order.Side == "bid":
+		order.Price = math.Floor(order.Price)
+	default:
+		order.Volume = RoundVolume(order.Volume)
+	}
+}
+
+// orderNotional 주문 금액 (시장가 매도는 알 수 없으므로 0)
+func orderNotional(order Order) float64 {
+	switch {
+	case order.OrderType == "limit":
+		return order.Price * order.Volume
+	case order.Side == "bid":
+		return order.Price
+	default:
+		return 0
+	}
+}
+
+// prepareOrder 주문 정밀도 반올림 및 최소 주문 금액 재확인
+// 내림으로 주문 금액이 최소 주문 금액 아래로 떨어지면 거래소 거부 전에 미리 막는다.
+func (e *OrderExecutor) prepareOrder(order *Order) error {
+	roundOrder(order)
+
+	if !e.cfg.EnforceMinNotional {
+		return nil
+	}
+
+	notional := orderNotional(*order)
+	if notional > 0 && notional < MinOrderAmount {
+		return fmt.Errorf("%w: %s 반올림 후 %.2f원", ErrBelowMinOrderAmount, order.MarketID, notional)
+	}
+
+	return nil
+}
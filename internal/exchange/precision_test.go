@@ -0,0 +1,153 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+func TestRoundPriceFavorsOrderSide(t *testing.T) {
+	cases := []struct {
+		price float64
+		side  string
+		want  float64
+	}{
+		{50000123, "bid", 50000000},
+		{50000123, "ask", 50001000},
+		{1234.56, "bid", 1230},
+		{1234.56, "ask", 1235},
+		{5.678, "bid", 5.67},
+		{5.678, "ask", 5.68},
+	}
+	for _, tc := range cases {
+		if got := RoundPrice(tc.price, tc.side); got != tc.want {
+			t.Fatalf("RoundPrice(%v, %s) = %v, want %v", tc.price, tc.side, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizePriceBands(t *testing.T) {
+	cases := []struct {
+		price, want float64
+	}{
+		{2000000, 2000000},
+		{2000400, 2000000},
+		{2000600, 2001000},
+		{1999700, 1999500},
+		{1000000, 1000000},
+		{1000240, 1000000},
+		{1000260, 1000500},
+		{999960, 1000000},
+		{500000, 500000},
+		{500049, 500000},
+		{500051, 500100},
+		{499990, 500000},
+		{100000, 100000},
+		{100024, 100000},
+		{100026, 100050},
+		{99996, 100000},
+		{10000, 10000},
+		{10004, 10000},
+		{10006, 10010},
+		{9999.6, 10000},
+		{1000, 1000},
+		{1002, 1000},
+		{1003, 1005},
+		{999.7, 1000},
+		{100, 100},
+		{100.4, 100},
+		{100.6, 101},
+		{99.96, 100},
+		{10, 10},
+		{10.04, 10},
+		{10.06, 10.1},
+		{9.996, 10},
+		{1.234, 1.23},
+	}
+	for _, tc := range cases {
+		if got := NormalizePrice("KRW-BTC", tc.price); got != tc.want {
+			t.Fatalf("NormalizePrice(%v) = %v, want %v", tc.price, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizePriceSkipsNonKRWMarkets(t *testing.T) {
+	if got := NormalizePrice("BTC-ETH", 0.0123456789); got != 0.0123456789 {
+		t.Fatalf("BTC 마켓 가격 = %v, want 그대로", got)
+	}
+}
+
+func TestCreateOrderNormalizesLimitPrice(t *testing.T) {
+	var captured capturedOrder
+	c := newOrderCaptureClient(t, &captured)
+
+	if _, err := c.CreateOrder(context.Background(), "KRW-BTC", "bid", "limit", 0.1, 1500123.4); err != nil {
+		t.Fatalf("주문 실패: %v", err)
+	}
+	if captured.body["price"] != "1500000" {
+		t.Fatalf("지정가 주문 가격 = %q, want 1500000", captured.body["price"])
+	}
+
+	// 시장가 매수의 price는 주문 금액이므로 호가 단위를 적용하지 않는다
+	if _, err := c.CreateOrder(context.Background(), "KRW-BTC", "bid", "market", 0, 1500123); err != nil {
+		t.Fatalf("주문 실패: %v", err)
+	}
+	if captured.body["price"] != "1500123" {
+		t.Fatalf("시장가 매수 금액 = %q, want 1500123", captured.body["price"])
+	}
+}
+
+func TestRoundVolumeTruncates(t *testing.T) {
+	if got := RoundVolume(0.123456789); got != 0.12345678 {
+		t.Fatalf("RoundVolume = %v, want 0.12345678", got)
+	}
+}
+
+func TestPrepareOrderRejectsBelowMinNotionalAfterRounding(t *testing.T) {
+	e, _ := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{EnforceMinNotional: true})
+
+	// 가격은 호가 단위(5원)로, 수량은 8자리로 내림되어 주문 금액이 5000원 아래로 떨어진다
+	order := Order{MarketID: "KRW-XRP", Side: "bid", OrderType: "limit", Price: 5004, Volume: 0.999999999}
+	if err := e.prepareOrder(&order); !errors.Is(err, ErrBelowMinOrderAmount) {
+		t.Fatalf("prepareOrder 오류 = %v, want ErrBelowMinOrderAmount", err)
+	}
+	if order.Price != 5000 || order.Volume != 0.99999999 {
+		t.Fatalf("반올림 결과 = %v x %v, want 5000 x 0.99999999", order.Price, order.Volume)
+	}
+
+	order = Order{MarketID: "KRW-XRP", Side: "bid", OrderType: "limit", Price: 5004, Volume: 1.01}
+	if err := e.prepareOrder(&order); err != nil {
+		t.Fatalf("최소 금액 이상 주문 오류 = %v, want nil", err)
+	}
+}
+
+func TestPrepareOrderOnlyRoundsWhenDisabled(t *testing.T) {
+	e, _ := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+
+	order := Order{MarketID: "KRW-XRP", Side: "bid", OrderType: "market", Price: 4999.7}
+	if err := e.prepareOrder(&order); err != nil {
+		t.Fatalf("prepareOrder 오류 = %v, want nil", err)
+	}
+	if order.Price != 4999 {
+		t.Fatalf("시장가 매수 금액 = %v, want 4999", order.Price)
+	}
+}
+
+func TestSubmitSkipsOrderBelowMinNotional(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{EnforceMinNotional: true})
+
+	_, err := e.submit(context.Background(), Order{MarketID: "KRW-XRP", Side: "bid", OrderType: "market", Price: 5000.9}, 0)
+	if err != nil {
+		t.Fatalf("최소 금액 주문 전송 실패: %v", err)
+	}
+	_, err = e.submit(context.Background(), Order{MarketID: "KRW-XRP", Side: "bid", OrderType: "market", Price: 4999.9}, 0)
+	if !errors.Is(err, ErrBelowMinOrderAmount) {
+		t.Fatalf("submit 오류 = %v, want ErrBelowMinOrderAmount", err)
+	}
+	if n := len(client.createdOrders()); n != 1 {
+		t.Fatalf("주문 요청 수 = %d, want 1", n)
+	}
+}
@@ -0,0 +1,118 @@
+package exchange
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter 요청 한도 대기
+type requestLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// priorityLimiter 우선순위가 있는 요청 한도 제한기
+// 주문 그룹 한도를 주문 생성/취소/대조 같은 실행 요청과 잔고·체결 조회 같은 일반 요청이 함께 쓰므로,
+// 한도가 빠듯할 때 실행 요청이 먼저 나가도록 일반 요청은 미리 자리를 잡지 않고 실행 요청이 모두 지나간 뒤 진행한다.
+type priorityLimiter struct {
+	limiter *rate.Limiter
+
+	mu         sync.Mutex
+	prioritize bool
+	pending    int           // 대기 중인 실행 요청 수
+	idle       chan struct{} // 대기 중인 실행 요청이 없어지면 닫힘
+}
+
+// newPriorityLimiter 초당 요청 수 제한기 생성
+func newPriorityLimiter(perSecond float64) *priorityLimiter {
+	idle := make(chan struct{})
+	close(idle)
+	return &priorityLimiter{
+		limiter:    newRateLimiter(perSecond),
+		prioritize: true,
+		idle:       idle,
+	}
+}
+
+// setLimit 초당 요청 수 변경
+func (l *priorityLimiter) setLimit(perSecond float64) {
+	l.limiter.SetLimit(rate.Limit(perSecond))
+}
+
+// setPrioritize 실행 요청 우선 처리 여부 설정
+func (l *priorityLimiter) setPrioritize(enabled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.prioritize = enabled
+}
+
+// Wait 일반 요청 대기
+// 실행 요청이 대기 중이면 먼저 보내도록 기다리고, 바로 쓸 수 있는 한도가 없으면 예약 없이 다시 확인한다.
+func (l *priorityLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		prioritize, busy, idle := l.prioritize, l.pending > 0, l.idle
+		l.mu.Unlock()
+
+		if !prioritize {
+			return l.limiter.Wait(ctx)
+		}
+
+		if busy {
+			select {
+			case <-idle:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		reservation := l.limiter.Reserve()
+		delay := reservation.Delay()
+		if delay == 0 {
+			return nil
+		}
+		reservation.Cancel()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// Urgent 실행 요청용 대기
+func (l *priorityLimiter) Urgent() requestLimiter {
+	return urgentLimiter{l}
+}
+
+// urgentLimiter 일반 요청보다 먼저 한도를 쓰는 실행 요청 대기
+type urgentLimiter struct {
+	l *priorityLimiter
+}
+
+// Wait 실행 요청 대기
+func (u urgentLimiter) Wait(ctx context.Context) error {
+	u.l.mu.Lock()
+	if u.l.pending == 0 {
+		u.l.idle = make(chan struct{})
+	}
+	u.l.pending++
+	u.l.mu.Unlock()
+
+	defer func() {
+		u.l.mu.Lock()
+		u.l.pending--
+		if u.l.pending == 0 {
+			close(u.l.idle)
+		}
+		u.l.mu.Unlock()
+	}()
+
+	return u.l.limiter.Wait(ctx)
+}
@@ -0,0 +1,93 @@
+package exchange
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// defaultOrderRequestsPerSecond 주문 그룹 기본 초당 요청 수
+	defaultOrderRequestsPerSecond = 8
+	// defaultQuotationRequestsPerSecond 시세 그룹 기본 초당 요청 수
+	defaultQuotationRequestsPerSecond = quotationRequestsPerSecond
+)
+
+// newRateLimiter 초당 요청 수 제한기 생성
+// 업비트는 초 단위 구간으로 요청 수를 세므로, 몰아서 보내지 않도록 버스트 없이 고르게 분산한다.
+func newRateLimiter(perSecond float64) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(perSecond), 1)
+}
+
+// RateLimitStatus 업비트 Remaining-Req 헤더로 받은 남은 요청 수
+type RateLimitStatus struct {
+	Group     string    `json:"group"`
+	PerMinute int       `json:"per_minute"`
+	PerSecond int       `json:"per_second"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// rateLimitState 최근 요청 한도 상태
+type rateLimitState struct {
+	mu     sync.Mutex
+	status RateLimitStatus
+}
+
+// update Remaining-Req 헤더 반영
+// 헤더가 없거나 형식이 잘못되면 기존 상태를 유지한다.
+func (s *rateLimitState) update(header string, now time.Time) {
+	status, ok := parseRemainingReq(header)
+	if !ok {
+		return
+	}
+	status.UpdatedAt = now
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+// parseRemainingReq "group=default; min=1799; sec=29" 형식 헤더 파싱
+func parseRemainingReq(header string) (RateLimitStatus, bool) {
+	var status RateLimitStatus
+	found := false
+	for _, part := range strings.Split(header, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+
+		switch strings.TrimSpace(key) {
+		case "group":
+			status.Group = strings.TrimSpace(value)
+			found = true
+		case "min":
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return RateLimitStatus{}, false
+			}
+			status.PerMinute = n
+			found = true
+		case "sec":
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return RateLimitStatus{}, false
+			}
+			status.PerSecond = n
+			found = true
+		}
+	}
+
+	return status, found
+}
+
+// LastRateLimit 가장 최근 응답의 요청 한도 상태
+func (c *UpbitClient) LastRateLimit() RateLimitStatus {
+	c.rateLimit.mu.Lock()
+	defer c.rateLimit.mu.Unlock()
+
+	return c.rateLimit.status
+}
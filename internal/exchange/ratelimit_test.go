@@ -0,0 +1,246 @@
+package exchange
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// rateLimitHandler 요청 경로에 맞는 빈 응답을 돌려주는 업비트 API
+func rateLimitHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/v1/accounts") {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"market":"KRW-BTC","trade_price":100}]`))
+	})
+}
+
+func TestQuotationRequestsAreSpacedByRateLimit(t *testing.T) {
+	c := newTestClient(t, rateLimitHandler(), WithQuotationRateLimit(20))
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := c.GetTicker(ctx, "KRW-BTC"); err != nil {
+			t.Fatalf("현재가 조회 실패: %v", err)
+		}
+	}
+
+	// 버스트 없이 초당 20회: 첫 요청 이후 50ms 간격
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("5회 요청 소요 시간 = %v, want 200ms 이상", elapsed)
+	}
+}
+
+func TestRateLimitGroupsAreIndependent(t *testing.T) {
+	c := newTestClient(t, rateLimitHandler(), WithQuotationRateLimit(1), WithOrderRateLimit(100))
+	ctx := context.Background()
+
+	if _, err := c.GetTicker(ctx, "KRW-BTC"); err != nil {
+		t.Fatalf("현재가 조회 실패: %v", err)
+	}
+
+	// 시세 그룹 한도를 다 썼어도 주문 그룹 요청은 기다리지 않는다
+	start := time.Now()
+	if _, err := c.GetAccounts(ctx); err != nil {
+		t.Fatalf("계정 조회 실패: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("주문 그룹 요청 대기 = %v, want 시세 그룹과 무관", elapsed)
+	}
+}
+
+func TestPriorityLimiterServesUrgentRequestsFirst(t *testing.T) {
+	l := newPriorityLimiter(20)
+	ctx := context.Background()
+
+	// 한도를 소진해 두고 실행 요청을 대기시킨다
+	if err := l.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	urgentDone := make(chan time.Time, 1)
+	go func() {
+		if err := l.Urgent().Wait(ctx); err == nil {
+			urgentDone <- time.Now()
+		}
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	generalAt := time.Now()
+
+	select {
+	case urgentAt := <-urgentDone:
+		if urgentAt.After(generalAt) {
+			t.Fatal("일반 요청이 대기 중인 실행 요청보다 먼저 나감")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("실행 요청이 진행되지 않음")
+	}
+}
+
+func TestPriorityLimiterWithoutPriorityKeepsArrivalOrder(t *testing.T) {
+	l := newPriorityLimiter(20)
+	l.setPrioritize(false)
+	ctx := context.Background()
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	generalDone := make(chan time.Time, 1)
+	go func() {
+		if err := l.Wait(ctx); err == nil {
+			generalDone <- time.Now()
+		}
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	if err := l.Urgent().Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	urgentAt := time.Now()
+
+	select {
+	case generalAt := <-generalDone:
+		if generalAt.After(urgentAt) {
+			t.Fatal("우선 처리를 끈 상태에서 실행 요청이 먼저 온 일반 요청을 앞지름")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("일반 요청이 진행되지 않음")
+	}
+}
+
+func TestOrderCallsProceedBeforeDataCallsUnderContention(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		if r.URL.Path == "/v1/orders" {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"uuid":"order-1","state":"wait"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+	c := newTestClient(t, handler, WithOrderRateLimit(10))
+	ctx := context.Background()
+
+	// 한도를 소진한 뒤 조회 요청들이 먼저 대기하고, 그 다음 주문 요청이 들어온다
+	if _, err := c.GetAccounts(ctx); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.GetAccounts(ctx)
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, err := c.CreateOrder(ctx, "KRW-BTC", "bid", "limit", 0.1, 100000); err != nil {
+		t.Fatalf("주문 실패: %v", err)
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(paths) != 4 || paths[1] != "POST /v1/orders" {
+		t.Fatalf("요청 순서 = %v, want 첫 조회 다음에 주문", paths)
+	}
+}
+
+func TestOrderPollingWaitsBehindOrderCalls(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/v1/orders":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"uuid":"order-2","state":"wait"}`))
+		case "/v1/order":
+			w.Write([]byte(`{"uuid":"order-1","state":"wait"}`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	})
+	c := newTestClient(t, handler, WithOrderRateLimit(10))
+	ctx := context.Background()
+
+	// 한도를 소진한 뒤 주문 상태 조회가 먼저 대기해도 나중에 온 주문 요청이 앞선다
+	if _, err := c.GetAccounts(ctx); err != nil {
+		t.Fatal(err)
+	}
+	polled := make(chan error, 1)
+	go func() {
+		_, err := c.GetOrder(ctx, "order-1")
+		polled <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	if _, err := c.CreateOrder(ctx, "KRW-BTC", "bid", "limit", 0.1, 100000); err != nil {
+		t.Fatalf("주문 실패: %v", err)
+	}
+	if err := <-polled; err != nil {
+		t.Fatalf("주문 조회 실패: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(paths) != 3 || paths[1] != "POST /v1/orders" || paths[2] != "GET /v1/order" {
+		t.Fatalf("요청 순서 = %v, want 계정 조회, 주문, 주문 조회", paths)
+	}
+}
+
+func TestParseRemainingReq(t *testing.T) {
+	status, ok := parseRemainingReq("group=order; min=1799; sec=29")
+	if !ok || status.Group != "order" || status.PerMinute != 1799 || status.PerSecond != 29 {
+		t.Fatalf("parseRemainingReq = %+v, %v, want order 1799 29", status, ok)
+	}
+
+	for _, header := range []string{"", "garbage", "group=default; sec=abc"} {
+		if _, ok := parseRemainingReq(header); ok {
+			t.Fatalf("parseRemainingReq(%q) ok = true, want false", header)
+		}
+	}
+}
+
+func TestLastRateLimitTracksResponseHeader(t *testing.T) {
+	var header atomic.Value
+	header.Store("group=market; min=599; sec=9")
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if value := header.Load().(string); value != "" {
+			w.Header().Set("Remaining-Req", value)
+		}
+		w.Write([]byte(`[{"market":"KRW-BTC","trade_price":100}]`))
+	}))
+
+	if _, err := c.GetTicker(context.Background(), "KRW-BTC"); err != nil {
+		t.Fatalf("현재가 조회 실패: %v", err)
+	}
+	status := c.LastRateLimit()
+	if status.Group != "market" || status.PerSecond != 9 || status.UpdatedAt.IsZero() {
+		t.Fatalf("LastRateLimit = %+v, want market sec=9", status)
+	}
+
+	// 헤더가 없는 응답은 직전 상태를 유지한다
+	header.Store("")
+	if _, err := c.GetTicker(context.Background(), "KRW-BTC"); err != nil {
+		t.Fatalf("현재가 조회 실패: %v", err)
+	}
+	if got := c.LastRateLimit(); got != status {
+		t.Fatalf("LastRateLimit = %+v, want %+v", got, status)
+	}
+}
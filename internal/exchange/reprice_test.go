@@ -0,0 +1,77 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// errOutOfRange 업비트가 가격 범위 초과로 거부한 주문 오류
+var errOutOfRange = fmt.Errorf("%w: invalid_price_bid", ErrPriceOutOfRange)
+
+func TestSubmitRepricesOrderRejectedAsOutOfRange(t *testing.T) {
+	client := &fakeExchange{createErrs: []error{errOutOfRange}, ticker: Ticker{TradePrice: 125000}}
+	e, db := newTestExecutor(t, client, config.TradingConfig{PriceOutOfRangeAction: "reprice"})
+
+	record, err := e.submit(context.Background(), Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Volume: 1, Price: 100000}, 0)
+	if err != nil {
+		t.Fatalf("재호가 주문 실패: %v", err)
+	}
+
+	created := client.createdOrders()
+	if len(created) != 2 {
+		t.Fatalf("주문 요청 수 = %d, want 2", len(created))
+	}
+	// 매수 금액 10만원을 유지하도록 수량을 다시 계산한다
+	if retry := created[1]; retry.Price != 125000 || retry.Volume != 0.8 {
+		t.Fatalf("재호가 주문 = %.0f원 %v개, want 125000원 0.8개", retry.Price, retry.Volume)
+	}
+	if record.Price != 125000 || record.Volume != 0.8 || record.OrderID != "order-2" {
+		t.Fatalf("저장된 주문 = %+v, want 재호가한 가격과 수량", record)
+	}
+
+	var count int64
+	db.Model(&model.Order{}).Count(&count)
+	if count != 1 {
+		t.Fatalf("저장된 주문 수 = %d, want 1", count)
+	}
+}
+
+func TestSubmitStopsRepricingAfterMaxAttempts(t *testing.T) {
+	client := &fakeExchange{createErrs: []error{errOutOfRange, errOutOfRange, errOutOfRange, errOutOfRange}, ticker: Ticker{TradePrice: 125000}}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{PriceOutOfRangeAction: "reprice", MaxRepriceAttempts: 2})
+
+	_, err := e.submit(context.Background(), Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Volume: 1, Price: 100000}, 0)
+	if !errors.Is(err, ErrPriceOutOfRange) {
+		t.Fatalf("재호가 한도 초과 오류 = %v, want ErrPriceOutOfRange", err)
+	}
+	if n := len(client.createdOrders()); n != 3 {
+		t.Fatalf("주문 요청 수 = %d, want 3 (최초 1회 + 재호가 2회)", n)
+	}
+}
+
+func TestSubmitAbandonsOutOfRangeOrderByDefault(t *testing.T) {
+	client := &fakeExchange{createErrs: []error{errOutOfRange}, ticker: Ticker{TradePrice: 125000}}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+
+	_, err := e.submit(context.Background(), Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Volume: 1, Price: 100000}, 0)
+	if !errors.Is(err, ErrPriceOutOfRange) {
+		t.Fatalf("주문 포기 오류 = %v, want ErrPriceOutOfRange", err)
+	}
+	if n := len(client.createdOrders()); n != 1 {
+		t.Fatalf("주문 요청 수 = %d, want 1", n)
+	}
+	if client.tickerCalls != 0 {
+		t.Fatalf("포기 설정인데 현재가 조회 %d회", client.tickerCalls)
+	}
+
+	var count int64
+	db.Model(&model.Order{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("거부된 주문이 저장됨: %d건", count)
+	}
+}
@@ -0,0 +1,41 @@
+package exchange
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryBackoff = 500 * time.Millisecond
+)
+
+// isRetryableStatus 재시도할 응답인지 여부
+// 상태 코드 0은 응답을 받지 못한 네트워크 오류를 뜻한다.
+func isRetryableStatus(status int) bool {
+	return status == 0 || isServerFailure(status)
+}
+
+// parseRetryAfter Retry-After 헤더를 대기 시간으로 변환
+// 초 단위 숫자와 HTTP 날짜 형식을 모두 지원하며, 없거나 잘못된 값이면 0을 반환한다.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(header); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+
+	return 0
+}
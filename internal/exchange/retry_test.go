@@ -0,0 +1,157 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRequestRetriesTransientFailures(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`[{"market":"KRW-BTC","trade_price":100}]`))
+	}), WithRetry(3, time.Millisecond))
+
+	ticker, err := c.GetTicker(context.Background(), "KRW-BTC")
+	if err != nil {
+		t.Fatalf("재시도 후 현재가 조회 실패: %v", err)
+	}
+	if ticker.TradePrice != 100 || calls.Load() != 3 {
+		t.Fatalf("현재가 %v, 요청 수 %d, want 100, 3", ticker.TradePrice, calls.Load())
+	}
+}
+
+func TestDoRequestGivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}), WithRetry(2, time.Millisecond))
+
+	if _, err := c.GetTicker(context.Background(), "KRW-BTC"); !errors.Is(err, ErrUnexpectedStatus) {
+		t.Fatalf("errors.Is(err, ErrUnexpectedStatus) = false: %v", err)
+	}
+	if n := calls.Load(); n != 3 {
+		t.Fatalf("요청 수 = %d, want 3 (첫 요청 + 재시도 2회)", n)
+	}
+}
+
+func TestDoRequestDoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"name":"validation_error","message":"잘못된 파라미터"}}`))
+	}), WithRetry(3, time.Millisecond))
+
+	if _, err := c.GetTicker(context.Background(), "KRW-BTC"); err == nil {
+		t.Fatal("400 응답 오류 = nil")
+	}
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("요청 수 = %d, want 1", n)
+	}
+}
+
+func TestDoRequestHonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`[{"market":"KRW-BTC","trade_price":100}]`))
+	}), WithRetry(1, time.Millisecond))
+
+	start := time.Now()
+	if _, err := c.GetTicker(context.Background(), "KRW-BTC"); err != nil {
+		t.Fatalf("재시도 후 현재가 조회 실패: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("재시도 대기 = %v, want Retry-After 1초", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	cases := map[string]time.Duration{
+		"":                              0,
+		"3":                             3 * time.Second,
+		"-1":                            0,
+		"soon":                          0,
+		"Mon, 01 Jan 2024 09:00:05 GMT": 5 * time.Second,
+		"Mon, 01 Jan 2024 08:59:00 GMT": 0,
+	}
+	for header, want := range cases {
+		if got := parseRetryAfter(header, now); got != want {
+			t.Fatalf("parseRetryAfter(%q) = %v, want %v", header, got, want)
+		}
+	}
+}
+
+func TestCreateOrderReturnsOrderCreatedBeforeRetry(t *testing.T) {
+	var posts atomic.Int32
+	var created atomic.Value // string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/orders":
+			var body OrderRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("주문 요청 파싱 실패: %v", err)
+			}
+			// 첫 요청은 주문을 만든 뒤 응답만 실패하고, 재시도는 같은 identifier라 거부된다
+			if posts.Add(1) == 1 {
+				created.Store(body.Identifier)
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"name":"duplicate_identifier","message":"중복된 identifier 입니다."}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/order":
+			if id, _ := created.Load().(string); id == "" || r.URL.Query().Get("identifier") != id {
+				t.Errorf("주문 조회 identifier = %q, want %q", r.URL.Query().Get("identifier"), id)
+			}
+			w.Write([]byte(`{"uuid":"order-1","side":"bid","ord_type":"limit","state":"wait","market":"KRW-BTC"}`))
+		default:
+			t.Errorf("예상하지 않은 요청: %s %s", r.Method, r.URL.Path)
+		}
+	}), WithRetry(3, time.Millisecond))
+
+	order, err := c.CreateOrder(context.Background(), "KRW-BTC", "bid", "limit", 0.1, 100000)
+	if err != nil {
+		t.Fatalf("재시도 중복 거부 후 주문 생성 실패: %v", err)
+	}
+	if order.UUID != "order-1" || posts.Load() != 2 {
+		t.Fatalf("주문 %s, 주문 요청 %d건, want order-1, 2", order.UUID, posts.Load())
+	}
+}
+
+func TestCreateOrderKeepsRetryErrorWhenOrderMissing(t *testing.T) {
+	var posts atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"name":"order_not_found","message":"주문을 찾지 못했습니다."}}`))
+			return
+		}
+		if posts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"name":"validation_error","message":"잘못된 파라미터"}}`))
+	}), WithRetry(3, time.Millisecond))
+
+	// 첫 요청이 업비트에 닿지 않았으면 재시도의 거부 사유를 그대로 돌려준다
+	if _, err := c.CreateOrder(context.Background(), "KRW-BTC", "bid", "limit", 0.1, 100000); !IsAPIError(err, "validation_error") {
+		t.Fatalf("주문 오류 = %v, want validation_error", err)
+	}
+}
@@ -0,0 +1,81 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// stopPriceParam 전략이 신호에 직접 담는 손절가 파라미터
+const stopPriceParam = "stop_price"
+
+// entryStopPrice 진입 신호의 손절가
+// 신호에 손절가가 있으면 그대로 쓰고, 없으면 전략 설정의 손절률, 그마저 없으면 기본 손절률로 진입가에서 계산한다.
+func (e *OrderExecutor) entryStopPrice(signal model.Signal) (float64, error) {
+	if stop, ok := signal.Parameters[stopPriceParam].(float64); ok && stop > 0 {
+		return stop, nil
+	}
+
+	stopLoss := e.cfg.DefaultStopLoss
+	if signal.StrategyName != "" {
+		var strategyConfig model.StrategyConfig
+		err := e.db.Where("market_id = ? AND strategy_name = ?", signal.MarketID, signal.StrategyName).First(&strategyConfig).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("전략 설정 조회 실패: %w", err)
+		}
+		if err == nil && strategyConfig.StopLoss > 0 {
+			stopLoss = strategyConfig.StopLoss
+		}
+	}
+	if stopLoss <= 0 {
+		return 0, nil
+	}
+
+	return signal.Price * (1 - stopLoss/100), nil
+}
+
+// riskSizedAmount 거래당 위험 기준 매수 금액 (KRW)
+// 위험 관리자의 포지션 크기 계산기가 손절 거리에 맞춘 수량을 돌려주면 진입가를 곱해 금액으로 바꾼다.
+// 거래당 위험이 설정되지 않았거나 손절가를 정할 수 없으면 ok가 false이며 자산 비율 기준을 쓴다.
+func (e *OrderExecutor) riskSizedAmount(ctx context.Context, signal model.Signal) (amount float64, ok bool, err error) {
+	if signal.Price <= 0 {
+		return 0, false, nil
+	}
+
+	stop, err := e.entryStopPrice(signal)
+	if err != nil || stop <= 0 {
+		return 0, false, err
+	}
+
+	volume, err := e.risk.SizePosition(ctx, signal.Price, stop)
+	if err != nil {
+		return 0, false, err
+	}
+	if volume <= 0 {
+		return 0, false, nil
+	}
+
+	return volume * signal.Price, true, nil
+}
+
+// checkRiskSizedMinTotal 거래당 위험 기준 금액이 마켓 최소 주문 금액 이상인지 확인
+// 손절 거리가 넓어 계산된 금액이 최소 주문 금액보다 작으면 위험 한도를 넘겨 매수하지 않고 진입을 건너뛴다.
+// 건너뛴 진입은 ErrBelowMarketMinTotal로 돌려주어 주문을 낸 경우와 구분할 수 있게 한다.
+func (e *OrderExecutor) checkRiskSizedMinTotal(ctx context.Context, marketID string, amount float64) error {
+	minTotal := MinOrderAmount
+	chance, err := e.orderChance(ctx, marketID)
+	if err != nil {
+		return err
+	}
+	if total := chance.MinTotal("bid"); total > minTotal {
+		minTotal = total
+	}
+
+	if amount < minTotal {
+		return fmt.Errorf("%w: 거래당 위험 기준 매수 금액 %.0f원 < %.0f원 (%s)", ErrBelowMarketMinTotal, amount, minTotal, marketID)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// riskSizedBuy 손절가가 있어 거래당 위험 기준으로 크기를 정하는 매수 신호
+func riskSizedBuy() model.Signal {
+	return model.Signal{
+		MarketID:   "KRW-BTC",
+		SignalType: "BUY",
+		Price:      100000,
+		Confidence: 1,
+		Parameters: model.Parameters{stopPriceParam: 99000.0},
+	}
+}
+
+func TestEnterSkipsRiskSizedEntryBelowMinTotal(t *testing.T) {
+	client := &fakeExchange{}
+	cfg := config.TradingConfig{MaxPositionSize: 10}
+	e := NewOrderExecutor(newTestDB(t), client, &fakeRisk{equity: 1000000, sizeVolume: 0.04}, nil, nil, &cfg)
+
+	// 0.04 × 100,000원 = 4,000원으로 최소 주문 금액 5,000원에 못 미친다
+	err := e.enter(context.Background(), riskSizedBuy())
+	if !errors.Is(err, ErrBelowMarketMinTotal) {
+		t.Fatalf("enter 오류 = %v, want ErrBelowMarketMinTotal", err)
+	}
+	if n := len(client.createdOrders()); n != 0 {
+		t.Fatalf("주문 요청 수 = %d, want 0", n)
+	}
+}
+
+func TestEnterUsesMarketMinTotalForRiskSizedEntry(t *testing.T) {
+	client := &fakeExchange{chance: &OrderChance{Market: OrderChanceMarket{Bid: OrderChanceConstraint{MinTotal: "20000"}}}}
+	cfg := config.TradingConfig{MaxPositionSize: 10}
+	e := NewOrderExecutor(newTestDB(t), client, &fakeRisk{equity: 1000000, sizeVolume: 0.1}, nil, nil, &cfg)
+
+	if err := e.enter(context.Background(), riskSizedBuy()); !errors.Is(err, ErrBelowMarketMinTotal) {
+		t.Fatalf("마켓 최소 금액 미만 enter 오류 = %v, want ErrBelowMarketMinTotal", err)
+	}
+
+	// 주문 가능 정보는 캐시되므로 새 실행기로 확인한다
+	client = &fakeExchange{}
+	e = NewOrderExecutor(newTestDB(t), client, &fakeRisk{equity: 1000000, sizeVolume: 0.1}, nil, nil, &cfg)
+	if err := e.enter(context.Background(), riskSizedBuy()); err != nil {
+		t.Fatalf("enter 오류: %v", err)
+	}
+	orders := client.createdOrders()
+	if len(orders) != 1 || orders[0].Side != "bid" || orders[0].Volume*orders[0].Price != 10000 {
+		t.Fatalf("주문 = %+v, want 10,000원 매수 1건", orders)
+	}
+}
@@ -0,0 +1,42 @@
+package exchange
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestShardMarketsSplitsByConnectionLimit(t *testing.T) {
+	markets := make([]string, 0, 7)
+	for i := 1; i <= 7; i++ {
+		markets = append(markets, fmt.Sprintf("KRW-C%d", i))
+	}
+
+	shards := shardMarkets(markets, 3)
+	want := [][]string{
+		{"KRW-C1", "KRW-C2", "KRW-C3"},
+		{"KRW-C4", "KRW-C5", "KRW-C6"},
+		{"KRW-C7"},
+	}
+	if !reflect.DeepEqual(shards, want) {
+		t.Fatalf("분할 결과 = %v, want %v", shards, want)
+	}
+}
+
+func TestShardMarketsKeepsSingleConnection(t *testing.T) {
+	markets := []string{"KRW-BTC", "KRW-ETH"}
+
+	for _, size := range []int{0, -1, 2, 10} {
+		shards := shardMarkets(markets, size)
+		if len(shards) != 1 || !reflect.DeepEqual(shards[0], markets) {
+			t.Fatalf("size %d 분할 결과 = %v, want 연결 하나", size, shards)
+		}
+	}
+}
+
+func TestWebSocketMarketsPerConnectionOption(t *testing.T) {
+	c := NewUpbitClient("access", "secret", WithWebSocketMarketsPerConnection(5))
+	if c.wsMarketsPerConn != 5 {
+		t.Fatalf("연결당 마켓 수 = %d, want 5", c.wsMarketsPerConn)
+	}
+}
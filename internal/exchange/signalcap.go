@@ -0,0 +1,42 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// defaultMaxOrdersPerSignal 신호당 기본 최대 주문 수
+const defaultMaxOrdersPerSignal = 1
+
+// ErrSignalOrderLimit 신호당 주문 수 한도 초과
+var ErrSignalOrderLimit = errors.New("신호당 주문 수 한도를 초과했습니다")
+
+// checkSignalOrderLimit 신호 하나가 만든 주문 수 확인
+// 같은 신호로 주문이 반복해서 나가는 버그를 막기 위한 안전장치로, 신호와 같은 방향의 주문만 센다.
+// 부분 체결 매수분 정리처럼 신호에서 이어진 반대 방향 주문과, 시간 초과로 취소되어 다시 낸 주문으로 대체된 주문은 세지 않는다.
+func (e *OrderExecutor) checkSignalOrderLimit(order Order, signalID uint) error {
+	if signalID == 0 {
+		return nil
+	}
+
+	limit := e.cfg.MaxOrdersPerSignal
+	if limit <= 0 {
+		limit = defaultMaxOrdersPerSignal
+	}
+
+	var count int64
+	err := e.db.Model(&model.Order{}).
+		Where("signal_id = ? AND side = ? AND (reason IS NULL OR reason <> ?)", signalID, orderSide(order.Side), orderReasonTimeout).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("신호 주문 수 조회 실패: %w", err)
+	}
+	if count < int64(limit) {
+		return nil
+	}
+
+	e.logger.Warn("신호당 주문 수 한도 초과로 주문 차단:", order.MarketID, order.Side, "신호", signalID, count, "/", limit)
+	return fmt.Errorf("%w: 신호 %d (%d/%d)", ErrSignalOrderLimit, signalID, count, limit)
+}
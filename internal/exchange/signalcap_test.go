@@ -0,0 +1,82 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+// limitBid 0.1 BTC 지정가 매수 주문
+func limitBid() Order {
+	return Order{MarketID: "KRW-BTC", Side: "bid", OrderType: "limit", Price: 100000, Volume: 0.1}
+}
+
+func TestSubmitBlocksSecondOrderForSameSignal(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{})
+	ctx := context.Background()
+
+	if _, err := e.submit(ctx, limitBid(), 7); err != nil {
+		t.Fatalf("첫 주문 실패: %v", err)
+	}
+	if _, err := e.submit(ctx, limitBid(), 7); !errors.Is(err, ErrSignalOrderLimit) {
+		t.Fatalf("같은 신호의 두 번째 주문 오류 = %v, want ErrSignalOrderLimit", err)
+	}
+	if _, err := e.submit(ctx, limitBid(), 8); err != nil {
+		t.Fatalf("다른 신호의 주문 실패: %v", err)
+	}
+	if n := len(client.createdOrders()); n != 2 {
+		t.Fatalf("주문 요청 %d건, want 2", n)
+	}
+}
+
+func TestSignalOrderLimitIsConfigurable(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{MaxOrdersPerSignal: 2})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := e.submit(ctx, limitBid(), 7); err != nil {
+			t.Fatalf("%d번째 주문 실패: %v", i+1, err)
+		}
+	}
+	if _, err := e.submit(ctx, limitBid(), 7); !errors.Is(err, ErrSignalOrderLimit) {
+		t.Fatalf("세 번째 주문 오류 = %v, want ErrSignalOrderLimit", err)
+	}
+}
+
+func TestSignalOrderLimitIgnoresOppositeAndReplacedOrders(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	ctx := context.Background()
+
+	record, err := e.submit(ctx, limitBid(), 7)
+	if err != nil {
+		t.Fatalf("첫 주문 실패: %v", err)
+	}
+
+	// 시간 초과로 취소되어 다시 내는 주문은 대체된 주문을 세지 않는다
+	if err := db.Model(record).Update("reason", orderReasonTimeout).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.submit(ctx, limitBid(), 7); err != nil {
+		t.Fatalf("재주문 실패: %v", err)
+	}
+
+	// 부분 체결분 정리 같은 반대 방향 주문은 따로 센다
+	ask := Order{MarketID: "KRW-BTC", Side: "ask", OrderType: "market", Volume: 0.05}
+	if _, err := e.submit(ctx, ask, 7); err != nil {
+		t.Fatalf("반대 방향 주문 실패: %v", err)
+	}
+}
+
+func TestSignalOrderLimitSkipsOrdersWithoutSignal(t *testing.T) {
+	e, _ := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+	for i := 0; i < 3; i++ {
+		if _, err := e.submit(context.Background(), limitBid(), 0); err != nil {
+			t.Fatalf("신호 없는 %d번째 주문 실패: %v", i+1, err)
+		}
+	}
+}
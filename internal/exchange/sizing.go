@@ -0,0 +1,87 @@
+package exchange
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+const defaultSizingLookback = 20
+
+// strategySizeScale 전략의 최근 성과 낙폭에 따른 포지션 크기 배율
+// 전략이 낙폭 구간에 있으면 진입 금액을 줄이고, 회복하면 다시 원래 크기로 돌아간다.
+func (e *OrderExecutor) strategySizeScale(strategyName string) (float64, error) {
+	cfg := e.cfg.DrawdownSizing
+	if !cfg.Enabled || strategyName == "" {
+		return 1, nil
+	}
+
+	lookback := cfg.LookbackPeriods
+	if lookback <= 0 {
+		lookback = defaultSizingLookback
+	}
+
+	var metrics []model.PerformanceMetric
+	err := e.db.Where("strategy_name = ?", strategyName).
+		Order("end_time DESC").
+		Limit(lookback).
+		Find(&metrics).Error
+	if err != nil {
+		return 0, fmt.Errorf("전략 성과 조회 실패: %w", err)
+	}
+
+	// 최신순으로 조회했으므로 시간순으로 되돌린다
+	for i, j := 0, len(metrics)-1; i < j; i, j = i+1, j-1 {
+		metrics[i], metrics[j] = metrics[j], metrics[i]
+	}
+
+	drawdown := currentDrawdown(metrics)
+	scale := drawdownScale(drawdown, cfg.Steps)
+	if scale < 1 {
+		e.logger.Info("전략 낙폭으로 포지션 크기 축소:", strategyName, drawdown, scale)
+	}
+
+	return scale, nil
+}
+
+// currentDrawdown 시간순 성과 기록을 복리로 이은 누적 수익 곡선의 현재 낙폭 (%)
+func currentDrawdown(metrics []model.PerformanceMetric) float64 {
+	value, peak := 1.0, 1.0
+	for _, metric := range metrics {
+		value *= 1 + metric.ProfitPercentage/100
+		if value > peak {
+			peak = value
+		}
+	}
+
+	if peak <= 0 {
+		return 0
+	}
+	return (peak - value) / peak * 100
+}
+
+// drawdownScale 낙폭에 해당하는 배율
+// 낙폭이 기준 이상인 구간 중 가장 깊은 구간의 배율을 사용하고, 해당 구간이 없으면 1을 반환한다.
+func drawdownScale(drawdown float64, steps []config.DrawdownSizingStep) float64 {
+	sorted := make([]config.DrawdownSizingStep, len(steps))
+	copy(sorted, steps)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Drawdown < sorted[j].Drawdown })
+
+	scale := 1.0
+	for _, step := range sorted {
+		if drawdown < step.Drawdown {
+			break
+		}
+		scale = step.Scale
+	}
+
+	if scale < 0 {
+		return 0
+	}
+	if scale > 1 {
+		return 1
+	}
+	return scale
+}
@@ -0,0 +1,91 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestDrawdownScaleUsesDeepestReachedStep(t *testing.T) {
+	steps := []config.DrawdownSizingStep{{Drawdown: 20, Scale: 0.25}, {Drawdown: 10, Scale: 0.5}}
+
+	cases := []struct {
+		drawdown float64
+		want     float64
+	}{
+		{0, 1},
+		{9.9, 1},
+		{10, 0.5},
+		{15, 0.5},
+		{25, 0.25},
+	}
+	for _, tc := range cases {
+		if got := drawdownScale(tc.drawdown, steps); got != tc.want {
+			t.Fatalf("drawdownScale(%v) = %v, want %v", tc.drawdown, got, tc.want)
+		}
+	}
+}
+
+func TestEnterScalesDownDuringStrategyDrawdown(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{
+		MaxPositionSize: 10,
+		DrawdownSizing: config.DrawdownSizingConfig{
+			Enabled: true,
+			Steps:   []config.DrawdownSizingStep{{Drawdown: 10, Scale: 0.5}, {Drawdown: 20, Scale: 0.25}},
+		},
+	})
+
+	// +25% 후 -25%: 고점 대비 25% 낙폭
+	start := time.Now().Add(-2 * time.Hour)
+	for i, profit := range []float64{25, -25} {
+		metric := model.PerformanceMetric{
+			StrategyName:     "test",
+			MarketID:         "KRW-BTC",
+			StartTime:        start.Add(time.Duration(i) * time.Hour),
+			EndTime:          start.Add(time.Duration(i+1) * time.Hour),
+			ProfitPercentage: profit,
+		}
+		if err := db.Create(&metric).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := e.enter(context.Background(), buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("매수 주문 실패: %v", err)
+	}
+
+	orders := client.createdOrders()
+	if len(orders) != 1 {
+		t.Fatalf("주문 요청 수 = %d, want 1", len(orders))
+	}
+	if amount := orders[0].Price * orders[0].Volume; amount != 25000 {
+		t.Fatalf("주문 금액 = %v, want 25000", amount)
+	}
+}
+
+func TestEnterKeepsFullSizeForOtherStrategy(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{
+		MaxPositionSize: 10,
+		DrawdownSizing: config.DrawdownSizingConfig{
+			Enabled: true,
+			Steps:   []config.DrawdownSizingStep{{Drawdown: 10, Scale: 0.5}},
+		},
+	})
+
+	metric := model.PerformanceMetric{StrategyName: "other", MarketID: "KRW-BTC", StartTime: time.Now(), EndTime: time.Now(), ProfitPercentage: -30}
+	if err := db.Create(&metric).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.enter(context.Background(), buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("매수 주문 실패: %v", err)
+	}
+	if orders := client.createdOrders(); len(orders) != 1 || orders[0].Price*orders[0].Volume != 100000 {
+		t.Fatalf("주문 = %+v, want 100000원 매수", orders)
+	}
+}
@@ -0,0 +1,75 @@
+package exchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+const defaultSnapshotDir = "data/snapshots"
+
+// StateSnapshot 장애 분석용 포지션/주문 스냅샷
+// 재시작 시 DB에 접근할 수 없어도 운영자가 거래소 잔고와 직접 대조할 수 있도록 파일로 남긴다.
+type StateSnapshot struct {
+	CreatedAt  time.Time        `json:"created_at"`
+	Reason     string           `json:"reason"`
+	Positions  []model.Position `json:"positions"`
+	OpenOrders []model.Order    `json:"open_orders"`
+}
+
+// WriteSnapshot 열린 포지션과 미체결 주문 스냅샷을 파일로 저장
+// 설정이 꺼져 있으면 아무것도 하지 않으며, 저장된 파일 경로를 반환한다.
+func (e *OrderExecutor) WriteSnapshot(reason string) (string, error) {
+	if !e.cfg.ShutdownSnapshot.Enabled {
+		return "", nil
+	}
+
+	snapshot := StateSnapshot{CreatedAt: time.Now(), Reason: reason}
+	if err := e.db.Where("status = ?", "OPEN").Find(&snapshot.Positions).Error; err != nil {
+		return "", fmt.Errorf("포지션 조회 실패: %w", err)
+	}
+	if err := e.db.Where("status = ?", OrderStatusWait).Find(&snapshot.OpenOrders).Error; err != nil {
+		return "", fmt.Errorf("미체결 주문 조회 실패: %w", err)
+	}
+
+	dir := e.cfg.ShutdownSnapshot.Dir
+	if dir == "" {
+		dir = defaultSnapshotDir
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("스냅샷 디렉터리 생성 실패: %w", err)
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("스냅샷 인코딩 실패: %w", err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("state-%s.json", snapshot.CreatedAt.Format("20060102-150405")))
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", fmt.Errorf("스냅샷 저장 실패: %w", err)
+	}
+
+	e.logger.Info("상태 스냅샷 저장:", path, len(snapshot.Positions), len(snapshot.OpenOrders))
+	return path, nil
+}
+
+// recoverWithSnapshot 패닉 발생 시 스냅샷을 남기고 패닉을 다시 일으킴
+// defer로 호출해야 한다.
+func (e *OrderExecutor) recoverWithSnapshot() {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if e.cfg.ShutdownSnapshot.OnPanic {
+		if _, err := e.WriteSnapshot(fmt.Sprintf("panic: %v", r)); err != nil {
+			e.logger.Error("패닉 스냅샷 저장 실패:", err)
+		}
+	}
+	panic(r)
+}
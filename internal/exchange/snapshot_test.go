@@ -0,0 +1,92 @@
+package exchange
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestWriteSnapshotExportsOpenPositionsAndOrders(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	e, db := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{
+		ShutdownSnapshot: config.ShutdownSnapshotConfig{Enabled: true, Dir: dir},
+	})
+
+	now := time.Now()
+	records := []interface{}{
+		&model.Position{MarketID: "KRW-BTC", EntryPrice: 100, EntryTime: now, Quantity: 1, Status: "OPEN"},
+		&model.Position{MarketID: "KRW-ETH", EntryPrice: 100, EntryTime: now, Quantity: 1, Status: "CLOSED"},
+		&model.Order{MarketID: "KRW-BTC", OrderID: "wait-1", Side: "SELL", OrderType: "limit", Volume: 1, Status: OrderStatusWait},
+		&model.Order{MarketID: "KRW-BTC", OrderID: "done-1", Side: "BUY", OrderType: "limit", Volume: 1, Status: OrderStatusDone},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	path, err := e.WriteSnapshot("shutdown")
+	if err != nil {
+		t.Fatalf("스냅샷 저장 실패: %v", err)
+	}
+	if filepath.Dir(path) != dir {
+		t.Fatalf("스냅샷 경로 = %s, want %s 아래", path, dir)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("스냅샷 읽기 실패: %v", err)
+	}
+	var snapshot StateSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		t.Fatalf("스냅샷 디코딩 실패: %v", err)
+	}
+	if snapshot.Reason != "shutdown" {
+		t.Fatalf("Reason = %q, want shutdown", snapshot.Reason)
+	}
+	if len(snapshot.Positions) != 1 || snapshot.Positions[0].MarketID != "KRW-BTC" {
+		t.Fatalf("Positions = %+v, want KRW-BTC 열린 포지션만", snapshot.Positions)
+	}
+	if len(snapshot.OpenOrders) != 1 || snapshot.OpenOrders[0].OrderID != "wait-1" {
+		t.Fatalf("OpenOrders = %+v, want wait-1만", snapshot.OpenOrders)
+	}
+}
+
+func TestWriteSnapshotDisabled(t *testing.T) {
+	e, _ := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{})
+
+	path, err := e.WriteSnapshot("shutdown")
+	if err != nil || path != "" {
+		t.Fatalf("WriteSnapshot = %q, %v, want 빈 경로", path, err)
+	}
+}
+
+func TestRecoverWithSnapshotWritesOnPanic(t *testing.T) {
+	dir := t.TempDir()
+	e, _ := newTestExecutor(t, &fakeExchange{}, config.TradingConfig{
+		ShutdownSnapshot: config.ShutdownSnapshotConfig{Enabled: true, OnPanic: true, Dir: dir},
+	})
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Fatalf("recover = %v, want boom (패닉을 다시 일으켜야 함)", r)
+			}
+		}()
+		defer e.recoverWithSnapshot()
+		panic("boom")
+	}()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("스냅샷 파일 수 = %d, want 1", len(entries))
+	}
+}
@@ -0,0 +1,75 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// maxListPageSize 목록 조회 API 페이지당 최대 개수
+	maxListPageSize     = 100
+	defaultListPageSize = maxListPageSize
+)
+
+// arrayStream 응답 배열을 원소 단위로 처리하는 디코더
+// 큰 목록 응답을 한 번에 슬라이스로 읽지 않고 원소마다 처리하여 메모리 사용을 줄인다.
+type arrayStream func(dec *json.Decoder) error
+
+// streamArray 배열 원소를 하나씩 디코딩해 fn에 전달하는 디코더 생성
+// fn이 오류를 반환하면 디코딩을 멈추고 그 오류를 반환한다.
+func streamArray[T any](fn func(T) error) arrayStream {
+	return func(dec *json.Decoder) error {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("배열 응답이 아닙니다: %v", token)
+		}
+
+		for dec.More() {
+			var item T
+			if err := dec.Decode(&item); err != nil {
+				return err
+			}
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		_, err = dec.Token()
+		return err
+	}
+}
+
+// ListOrders 주문 목록을 페이지 단위로 조회하며 주문마다 fn 호출
+// 빈 페이지가 나오거나 페이지가 가득 차지 않으면 종료한다. 페이지 크기는 WithListPageSize로 설정한다.
+func (c *UpbitClient) ListOrders(ctx context.Context, marketID, state string, fn func(OrderResponse) error) error {
+	for page := 1; ; page++ {
+		params := map[string]string{
+			"state":    state,
+			"page":     strconv.Itoa(page),
+			"limit":    strconv.Itoa(c.listPageSize),
+			"order_by": "desc",
+		}
+		if marketID != "" {
+			params["market"] = marketID
+		}
+
+		count := 0
+		stream := streamArray(func(order OrderResponse) error {
+			count++
+			return fn(order)
+		})
+		if err := c.doRequest(ctx, c.orderLimiter, c.orderListRequest(ctx, "/orders", params), http.StatusOK, stream); err != nil {
+			return err
+		}
+
+		if count < c.listPageSize {
+			return nil
+		}
+	}
+}
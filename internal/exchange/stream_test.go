@@ -0,0 +1,86 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListOrdersYieldsOrdersWhileResponseStreams(t *testing.T) {
+	firstSeen := make(chan struct{})
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := 100
+		if r.URL.Query().Get("page") == "2" {
+			count = 30
+		}
+
+		fmt.Fprintf(w, `[{"uuid":"%s-0","state":"done"}`, r.URL.Query().Get("page"))
+		w.(http.Flusher).Flush()
+		if r.URL.Query().Get("page") == "1" {
+			// 첫 주문이 처리되기 전에는 나머지를 보내지 않는다
+			select {
+			case <-firstSeen:
+			case <-time.After(2 * time.Second):
+				return
+			}
+		}
+		for i := 1; i < count; i++ {
+			fmt.Fprintf(w, `,{"uuid":"%s-%d","state":"done","market":"KRW-BTC","volume":"%s"}`, r.URL.Query().Get("page"), i, strings.Repeat("1", 64))
+		}
+		w.Write([]byte(`]`))
+	}))
+
+	var uuids []string
+	err := c.ListOrders(context.Background(), "KRW-BTC", "done", func(order OrderResponse) error {
+		if len(uuids) == 0 {
+			close(firstSeen)
+		}
+		uuids = append(uuids, order.UUID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListOrders 오류: %v (응답 전체를 받은 뒤에야 처리했을 수 있음)", err)
+	}
+	if len(uuids) != 130 || uuids[0] != "1-0" || uuids[129] != "2-29" {
+		t.Fatalf("주문 %d개 (%v ... %v), want 130개 1-0 ... 2-29", len(uuids), uuids[0], uuids[len(uuids)-1])
+	}
+}
+
+func TestListOrdersStopsOnCallbackError(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`[{"uuid":"a"},{"uuid":"b"},{"uuid":"c"}]`))
+	}), WithListPageSize(3))
+
+	stop := errors.New("stop")
+	seen := 0
+	err := c.ListOrders(context.Background(), "", "done", func(order OrderResponse) error {
+		seen++
+		if order.UUID == "b" {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("ListOrders 오류 = %v, want 콜백 오류", err)
+	}
+	if seen != 2 || requests != 1 {
+		t.Fatalf("처리한 주문 %d개, 요청 %d번, want 2개, 1번", seen, requests)
+	}
+}
+
+func TestStreamArrayRejectsNonArray(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"uuid":"a"}`))
+	}))
+
+	err := c.ListOrders(context.Background(), "", "done", func(OrderResponse) error { return nil })
+	if !errors.Is(err, ErrDecodeResponse) {
+		t.Fatalf("errors.Is(err, ErrDecodeResponse) = false: %v", err)
+	}
+}
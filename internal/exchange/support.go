@@ -0,0 +1,55 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// ErrNoOrderbookSupport 돌파 가격 아래 매수 잔량 부족
+var ErrNoOrderbookSupport = errors.New("호가창 매수 잔량이 돌파 가격을 받치지 못합니다")
+
+// bidDepth 최우선 매수호가에서 rangePercent 아래까지의 매수 잔량 (KRW)
+func bidDepth(orderbook *Orderbook, rangePercent float64) float64 {
+	if len(orderbook.Units) == 0 {
+		return 0
+	}
+
+	floor := orderbook.Units[0].BidPrice * (1 - rangePercent/100)
+	depth := 0.0
+	for _, unit := range orderbook.Units {
+		if unit.BidPrice < floor {
+			break
+		}
+		depth += unit.BidPrice * unit.BidSize
+	}
+
+	return depth
+}
+
+// checkOrderbookSupport 돌파 진입 시 호가창 지지 확인
+// 얇은 호가 위의 순간 급등에 올라타지 않도록, 현재가 아래 일정 범위에 충분한 매수 잔량이 있을 때만 진입한다.
+func (e *OrderExecutor) checkOrderbookSupport(ctx context.Context, signal model.Signal) error {
+	cfg := e.cfg.OrderbookSupport
+	if !cfg.Enabled {
+		return nil
+	}
+	if breakout, _ := signal.Parameters["breakout"].(bool); !breakout {
+		return nil
+	}
+
+	orderbook, err := e.client.GetOrderbook(ctx, signal.MarketID)
+	if err != nil {
+		return fmt.Errorf("호가 조회 실패: %w", err)
+	}
+
+	depth := bidDepth(orderbook, cfg.RangePercent)
+	if depth < cfg.MinBidDepth {
+		return fmt.Errorf("%w: %s 매수 잔량 %.0f원 (필요 %.0f원, 범위 %.2f%%)",
+			ErrNoOrderbookSupport, signal.MarketID, depth, cfg.MinBidDepth, cfg.RangePercent)
+	}
+
+	return nil
+}
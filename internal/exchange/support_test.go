@@ -0,0 +1,58 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// testOrderbook 최우선 매수호가 100,000원부터 1%씩 내려가는 매수 호가 (각 0.1개)
+func testOrderbook() *Orderbook {
+	return &Orderbook{
+		MarketID: "KRW-BTC",
+		Units: []OrderbookUnit{
+			{AskPrice: 100100, BidPrice: 100000, BidSize: 0.1},
+			{AskPrice: 100200, BidPrice: 99000, BidSize: 0.1},
+			{AskPrice: 100300, BidPrice: 98000, BidSize: 0.1},
+		},
+	}
+}
+
+func TestBidDepthSumsWithinRange(t *testing.T) {
+	// 1.5% 범위: 100,000원과 99,000원 호가만 포함
+	if got := bidDepth(testOrderbook(), 1.5); got != 19900 {
+		t.Fatalf("매수 잔량 = %v, want 19900", got)
+	}
+	if got := bidDepth(&Orderbook{}, 1.5); got != 0 {
+		t.Fatalf("빈 호가 매수 잔량 = %v, want 0", got)
+	}
+}
+
+func TestEnterRequiresOrderbookSupportForBreakouts(t *testing.T) {
+	client := &fakeExchange{orderbook: testOrderbook()}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{
+		MaxPositionSize:  10,
+		OrderbookSupport: config.OrderbookSupportConfig{Enabled: true, RangePercent: 1.5, MinBidDepth: 50000},
+	})
+
+	breakout := buySignal("KRW-BTC", 100000)
+	breakout.Parameters = model.Parameters{"breakout": true}
+	if err := e.enter(context.Background(), breakout); !errors.Is(err, ErrNoOrderbookSupport) {
+		t.Fatalf("얇은 호가 돌파 진입 오류 = %v, want ErrNoOrderbookSupport", err)
+	}
+
+	// 돌파가 아닌 신호는 호가를 보지 않는다
+	if err := e.enter(context.Background(), buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("돌파가 아닌 신호 진입 실패: %v", err)
+	}
+
+	client.mu.Lock()
+	client.orderbook.Units[1].BidSize = 1
+	client.mu.Unlock()
+	if err := e.enter(context.Background(), breakout); err != nil {
+		t.Fatalf("호가 지지가 충분한 돌파 진입 실패: %v", err)
+	}
+}
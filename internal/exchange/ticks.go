@@ -0,0 +1,58 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// 체결 주도 방향 (TickTrade.AskBid)
+const (
+	AskBidAsk = "ASK" // 매도 주문이 매수 호가를 받아 체결 (매도 주도)
+	AskBidBid = "BID" // 매수 주문이 매도 호가를 받아 체결 (매수 주도)
+)
+
+// TickTrade 최근 체결 내역
+type TickTrade struct {
+	MarketID         string  `json:"market"`
+	TradeDateUTC     string  `json:"trade_date_utc"`
+	TradeTimeUTC     string  `json:"trade_time_utc"`
+	Timestamp        int64   `json:"timestamp"`
+	TradePrice       float64 `json:"trade_price"`
+	TradeVolume      float64 `json:"trade_volume"`
+	PrevClosingPrice float64 `json:"prev_closing_price"`
+	ChangePrice      float64 `json:"change_price"`
+	AskBid           string  `json:"ask_bid"`
+	SequentialID     int64   `json:"sequential_id"` // 체결 고유 번호 (이전 체결 조회 커서)
+}
+
+// BuyerInitiated 매수 주도 체결인지 여부
+func (t TickTrade) BuyerInitiated() bool {
+	return t.AskBid == AskBidBid
+}
+
+// GetRecentTrades 최근 체결 내역 조회 (최신순)
+func (c *UpbitClient) GetRecentTrades(ctx context.Context, marketID string, count int) ([]TickTrade, error) {
+	return c.GetRecentTradesBefore(ctx, marketID, count, 0)
+}
+
+// GetRecentTradesBefore cursor(체결 고유 번호) 이전의 체결 내역 조회 (cursor가 0이면 최신 체결부터)
+// 응답의 마지막 체결 SequentialID를 다음 cursor로 넘기면 과거로 이어서 조회할 수 있다.
+func (c *UpbitClient) GetRecentTradesBefore(ctx context.Context, marketID string, count int, cursor int64) ([]TickTrade, error) {
+	url := fmt.Sprintf("%s/trades/ticks?market=%s&count=%d", upbitAPIURL, marketID, count)
+	if cursor > 0 {
+		url += "&cursor=" + strconv.FormatInt(cursor, 10)
+	}
+
+	build := func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, "GET", url, nil)
+	}
+
+	var trades []TickTrade
+	if err := c.doRequest(ctx, c.quotationLimiter, build, http.StatusOK, &trades); err != nil {
+		return nil, err
+	}
+
+	return trades, nil
+}
@@ -0,0 +1,74 @@
+package exchange
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// candleTimeLayout 업비트 캔들 시각 형식
+const candleTimeLayout = "2006-01-02T15:04:05"
+
+// TimeframeDuration 타임프레임의 캔들 간격
+// months는 30일로 근사한다.
+func TimeframeDuration(timeframe string) (time.Duration, error) {
+	switch {
+	case timeframe == "seconds":
+		return time.Second, nil
+	case strings.HasPrefix(timeframe, "minutes"):
+		parts := strings.Split(timeframe, "/")
+		if len(parts) != 2 {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidTimeframe, timeframe)
+		}
+		unit, err := strconv.Atoi(parts[1])
+		if err != nil || unit <= 0 {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidTimeframe, timeframe)
+		}
+		return time.Duration(unit) * time.Minute, nil
+	case timeframe == "days":
+		return 24 * time.Hour, nil
+	case timeframe == "weeks":
+		return 7 * 24 * time.Hour, nil
+	case timeframe == "months":
+		return 30 * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnsupportedTimeframe, timeframe)
+	}
+}
+
+// ToCandlestick 캔들 응답을 모델로 변환
+func (c Candle) ToCandlestick(timeframe string) (model.Candlestick, error) {
+	timestamp, err := time.Parse(candleTimeLayout, c.CandleDateTimeUTC)
+	if err != nil {
+		return model.Candlestick{}, fmt.Errorf("캔들 시각 파싱 실패: %w", err)
+	}
+
+	return model.Candlestick{
+		MarketID:  c.MarketID,
+		Timeframe: timeframe,
+		Timestamp: timestamp,
+		Open:      c.OpeningPrice,
+		High:      c.HighPrice,
+		Low:       c.LowPrice,
+		Close:     c.TradePrice,
+		Volume:    c.CandleAccTradeVolume,
+	}, nil
+}
+
+// ToCandlesticks 캔들 목록을 시간순 모델 목록으로 변환
+// 업비트 캔들 응답은 최신순이므로 순서를 뒤집는다.
+func ToCandlesticks(candles []Candle, timeframe string) ([]model.Candlestick, error) {
+	result := make([]model.Candlestick, len(candles))
+	for i, candle := range candles {
+		candlestick, err := candle.ToCandlestick(timeframe)
+		if err != nil {
+			return nil, err
+		}
+		result[len(candles)-1-i] = candlestick
+	}
+
+	return result, nil
+}
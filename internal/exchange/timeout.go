@@ -0,0 +1,87 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// orderReasonTimeout 미체결 시간 초과로 취소한 주문의 사유 (model.Order.Reason)
+const orderReasonTimeout = "timeout"
+
+// urgentOrders 시간 초과로 취소하면 남은 수량을 시장가로 다시 낼 긴급 신호 주문 (주문 번호)
+// 메모리에만 두므로 재시작 전에 낸 주문은 시간이 지나도 취소만 한다.
+type urgentOrders struct {
+	mu  sync.Mutex
+	ids map[string]bool
+}
+
+// add 긴급 주문 등록
+func (u *urgentOrders) add(orderID string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.ids == nil {
+		u.ids = make(map[string]bool)
+	}
+	u.ids[orderID] = true
+}
+
+// take 긴급 주문 여부 확인 후 등록 해제
+func (u *urgentOrders) take(orderID string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	urgent := u.ids[orderID]
+	delete(u.ids, orderID)
+	return urgent
+}
+
+// isUrgentSignal 시간 초과 시 시장가로 다시 주문하도록 전략이 표시한 신호인지 여부
+func isUrgentSignal(signal model.Signal) bool {
+	urgent, _ := signal.Parameters["urgent"].(bool)
+	return urgent
+}
+
+// checkOrderTimeout 미체결 시간이 초과된 지정가 주문 취소 (호출하는 쪽에서 orderMu를 잡고 있어야 함)
+// 긴급 신호 주문이면 취소 후 체결되지 않은 수량을 시장가로 다시 주문한다.
+func (e *OrderExecutor) checkOrderTimeout(ctx context.Context, order *model.Order, now time.Time) error {
+	timeout := time.Duration(e.cfg.OrderTimeoutSeconds) * time.Second
+	if timeout <= 0 || order.OrderType != "limit" || now.Sub(order.CreatedAt) < timeout {
+		return nil
+	}
+
+	e.logger.Info("미체결 시간 초과로 주문 취소:", order.MarketID, order.Side, order.OrderID, now.Sub(order.CreatedAt).Truncate(time.Second))
+	order.Reason = orderReasonTimeout
+	if err := e.cancelOrder(ctx, order); err != nil {
+		return fmt.Errorf("시간 초과 주문 취소 실패: %w", err)
+	}
+
+	if !e.urgent.take(order.OrderID) {
+		return nil
+	}
+	return e.resubmitAtMarket(ctx, order)
+}
+
+// resubmitAtMarket 취소한 주문의 체결되지 않은 수량을 시장가로 다시 주문
+func (e *OrderExecutor) resubmitAtMarket(ctx context.Context, record *model.Order) error {
+	remaining := RoundVolume(record.Volume - record.ExecutedVolume)
+	if remaining <= 0 {
+		return nil
+	}
+
+	order := Order{MarketID: record.MarketID, Side: "ask", OrderType: "market", Volume: remaining}
+	if record.Side == "BUY" {
+		// 시장가 매수는 수량 대신 주문 총액으로 낸다
+		order = Order{MarketID: record.MarketID, Side: "bid", OrderType: "market", Price: remaining * record.Price}
+	}
+
+	e.logger.Info("긴급 주문 시장가 재주문:", record.MarketID, order.Side, record.OrderID, remaining)
+	if _, err := e.submit(ctx, order, record.SignalID); err != nil {
+		return fmt.Errorf("시장가 재주문 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// submitAged 주문 전송 후 생성 시각을 age만큼 앞당긴 주문 기록
+func submitAged(t *testing.T, e *OrderExecutor, order Order, signalID uint, age time.Duration) *model.Order {
+	t.Helper()
+	record, err := e.submit(context.Background(), order, signalID)
+	if err != nil {
+		t.Fatalf("주문 전송 실패: %v", err)
+	}
+	if err := e.db.Model(record).Update("created_at", time.Now().Add(-age)).Error; err != nil {
+		t.Fatal(err)
+	}
+	return record
+}
+
+func TestUnfilledLimitOrderIsCancelledAfterTimeout(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{OrderTimeoutSeconds: 60})
+	record := submitAged(t, e, limitBid(), 0, 2*time.Minute)
+
+	e.pollOpenOrders(context.Background())
+
+	if cancelled := client.cancelledOrders(); len(cancelled) != 1 || cancelled[0] != record.OrderID {
+		t.Fatalf("취소 요청 = %v, want [%s]", cancelled, record.OrderID)
+	}
+	var order model.Order
+	if err := db.First(&order, record.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if order.Status != OrderStatusCancel || order.Reason != orderReasonTimeout {
+		t.Fatalf("주문 = %s %q, want CANCEL %q", order.Status, order.Reason, orderReasonTimeout)
+	}
+	// 긴급 주문이 아니면 다시 주문하지 않는다
+	if n := len(client.createdOrders()); n != 1 {
+		t.Fatalf("주문 요청 %d건, want 1", n)
+	}
+}
+
+func TestOrderWithinTimeoutIsKept(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{OrderTimeoutSeconds: 60})
+	submitAged(t, e, limitBid(), 0, 30*time.Second)
+
+	e.pollOpenOrders(context.Background())
+
+	if cancelled := client.cancelledOrders(); len(cancelled) != 0 {
+		t.Fatalf("시간 초과 전 취소 요청 = %v, want 없음", cancelled)
+	}
+}
+
+func TestTimeoutDisabledKeepsOrder(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{})
+	submitAged(t, e, limitBid(), 0, 24*time.Hour)
+
+	e.pollOpenOrders(context.Background())
+
+	if cancelled := client.cancelledOrders(); len(cancelled) != 0 {
+		t.Fatalf("시간 초과 미설정인데 취소 요청 = %v", cancelled)
+	}
+}
+
+func TestUrgentOrderIsResubmittedAtMarketAfterTimeout(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{OrderTimeoutSeconds: 60})
+	order := limitBid()
+	order.Urgent = true
+	submitAged(t, e, order, 0, 2*time.Minute)
+
+	e.pollOpenOrders(context.Background())
+
+	created := client.createdOrders()
+	if len(created) != 2 {
+		t.Fatalf("주문 요청 %d건, want 2 (시장가 재주문 포함)", len(created))
+	}
+	// 시장가 매수는 남은 수량 x 지정가만큼의 주문 총액으로 낸다
+	if again := created[1]; again.Side != "bid" || again.OrderType != "market" || again.Price != 10000 {
+		t.Fatalf("재주문 = %+v, want 시장가 매수 총액 10000", again)
+	}
+}
@@ -0,0 +1,117 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+const defaultOrderPollInterval = 2 * time.Second
+
+// orderPollInterval 미체결 주문 상태 조회 주기
+func (e *OrderExecutor) orderPollInterval() time.Duration {
+	if e.cfg.OrderPollSeconds > 0 {
+		return time.Duration(e.cfg.OrderPollSeconds) * time.Second
+	}
+	return defaultOrderPollInterval
+}
+
+// trackOrders 미체결 주문 추적 루프
+// 주문 조회는 업비트 클라이언트의 주문 그룹 한도 제한기를 거치므로 주문이 많으면 한도에 맞춰 천천히 돈다.
+func (e *OrderExecutor) trackOrders(ctx context.Context) {
+	defer e.wg.Done()
+	defer e.recoverWithSnapshot()
+
+	ticker := time.NewTicker(e.orderPollInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.pollOpenOrders(ctx)
+		}
+	}
+}
+
+// pollOpenOrders 미체결(WAIT) 주문 전체의 상태 조회
+func (e *OrderExecutor) pollOpenOrders(ctx context.Context) {
+	var orders []model.Order
+	if err := e.db.Where("status = ?", OrderStatusWait).Order("id").Find(&orders).Error; err != nil {
+		e.logger.Error("미체결 주문 조회 실패:", err)
+		return
+	}
+
+	for i := range orders {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := e.trackOrder(ctx, &orders[i]); err != nil {
+			e.logger.Error("주문 상태 갱신 실패:", orders[i].MarketID, orders[i].OrderID, err)
+		}
+	}
+}
+
+// trackOrder 주문 상태를 조회해 주문 기록에 반영
+// 체결 수량이 늘었으면 새 체결 내역을 기록하고 체결분을 바로 포지션에 반영하며, 체결 완료(DONE)나 취소(CANCEL)가 되면 추적을 끝낸다.
+// 체결 반영에 실패하면 주문 기록을 이전 상태로 남겨 다음 조회에서 다시 시도한다.
+// 아직 미체결이면 주문 시간 초과도 여기서 확인한다.
+func (e *OrderExecutor) trackOrder(ctx context.Context, order *model.Order) error {
+	e.orderMu.Lock()
+	defer e.orderMu.Unlock()
+
+	// 목록 조회 후 수동 취소 등으로 이미 끝났을 수 있다
+	if err := e.db.First(order, order.ID).Error; err != nil {
+		return fmt.Errorf("주문 조회 실패: %w", err)
+	}
+	if IsTerminalStatus(order.Status) {
+		return nil
+	}
+
+	resp, err := e.client.GetOrder(ctx, order.OrderID)
+	if err != nil {
+		return err
+	}
+
+	previous := *order
+	e.applyOrderResponse(order, resp)
+	if order.Status != previous.Status || order.ExecutedVolume != previous.ExecutedVolume {
+		if order.ExecutedVolume > 0 {
+			if _, err := e.applyNewFills(ctx, order, resp.PaidFee); err != nil {
+				return err
+			}
+		}
+
+		if err := e.db.Save(order).Error; err != nil {
+			return fmt.Errorf("주문 저장 실패: %w", err)
+		}
+	}
+
+	if IsTerminalStatus(order.Status) {
+		e.urgent.take(order.OrderID)
+		return nil
+	}
+	return e.checkOrderTimeout(ctx, order, time.Now())
+}
+
+// applyNewFills 새로 들어온 체결을 기록하고 포지션에 반영한 뒤 반영한 체결 수량 반환
+// 매수 체결은 applyFill이 기존 보유 수량과 가중 평균하므로 조회 주기마다 나눠 반영해도 평균 매수가는 전체 체결의 VWAP와 같다.
+func (e *OrderExecutor) applyNewFills(ctx context.Context, order *model.Order, paidFee string) (float64, error) {
+	added, err := e.recordTrades(ctx, order, paidFee)
+	if err != nil {
+		return 0, fmt.Errorf("체결 내역 기록 실패: %w", err)
+	}
+	if added.Volume <= 0 {
+		return 0, nil
+	}
+
+	price := added.Notional / added.Volume
+	if err := e.applyFill(order, price, added.Volume, added.Fee, time.Now()); err != nil {
+		return 0, fmt.Errorf("체결 포지션 반영 실패: %w", err)
+	}
+	e.logger.Info("체결 반영:", order.MarketID, order.Side, order.OrderID, price, added.Volume)
+	return added.Volume, nil
+}
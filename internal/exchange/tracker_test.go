@@ -0,0 +1,112 @@
+package exchange
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestPollOpenOrdersTracksOrderUntilDone(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	ctx := context.Background()
+
+	order := model.Order{MarketID: "KRW-BTC", OrderID: "order-1", Side: "BUY", OrderType: "limit", Price: 100000, Volume: 1, Status: OrderStatusWait, LastUpdated: time.Now().Add(-time.Minute)}
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+	first := OrderTrade{UUID: "trade-1", Price: "100000", Volume: "0.4", Funds: "40000", CreatedAt: "2024-01-01T09:00:00+09:00"}
+
+	// 부분 체결: 주문은 WAIT로 남고 체결분만 포지션에 반영된다
+	client.setOrderState("order-1", &OrderResponse{UUID: "order-1", State: "wait", ExecutedVolume: "0.4"}, []OrderTrade{first})
+	e.pollOpenOrders(ctx)
+
+	if err := db.First(&order, order.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if order.Status != OrderStatusWait || order.ExecutedVolume != 0.4 {
+		t.Fatalf("부분 체결 후 주문 = %s 체결 %v, want WAIT 0.4", order.Status, order.ExecutedVolume)
+	}
+	partialUpdated := order.LastUpdated
+	var position model.Position
+	if err := db.Where("market_id = ?", "KRW-BTC").First(&position).Error; err != nil {
+		t.Fatalf("부분 체결 후 포지션 없음: %v", err)
+	}
+	if position.Status != "OPEN" || math.Abs(position.Quantity-0.4) > 1e-9 {
+		t.Fatalf("부분 체결 후 포지션 = %s 수량 %v, want OPEN 0.4", position.Status, position.Quantity)
+	}
+
+	// 체결 완료
+	client.setOrderState("order-1", &OrderResponse{UUID: "order-1", State: "done", ExecutedVolume: "1"}, []OrderTrade{
+		first,
+		{UUID: "trade-2", Price: "100000", Volume: "0.6", Funds: "60000", CreatedAt: "2024-01-01T09:00:05+09:00"},
+	})
+	e.pollOpenOrders(ctx)
+
+	if err := db.First(&order, order.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if order.Status != OrderStatusDone || order.ExecutedVolume != 1 || order.LastUpdated.Before(partialUpdated) {
+		t.Fatalf("체결 완료 후 주문 = %s 체결 %v 갱신 %v, want DONE 1", order.Status, order.ExecutedVolume, order.LastUpdated)
+	}
+	var trades int64
+	if err := db.Model(&model.Trade{}).Where("order_id = ?", "order-1").Count(&trades).Error; err != nil {
+		t.Fatal(err)
+	}
+	if trades != 2 {
+		t.Fatalf("저장된 체결 수 = %d, want 2", trades)
+	}
+	if err := db.First(&position, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(position.Quantity-1) > 1e-9 {
+		t.Fatalf("체결 완료 후 포지션 수량 = %v, want 1", position.Quantity)
+	}
+
+	// 끝난 주문은 더 조회하지 않는다
+	calls := client.getOrderCalls()
+	e.pollOpenOrders(ctx)
+	if got := client.getOrderCalls(); got != calls {
+		t.Fatalf("체결 완료 후 주문 조회 %d건, want 0", got-calls)
+	}
+}
+
+func TestPartialFillsAcrossPollsAverageEntryPrice(t *testing.T) {
+	client := &fakeExchange{}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	ctx := context.Background()
+
+	order := model.Order{MarketID: "KRW-BTC", OrderID: "order-1", Side: "BUY", OrderType: "limit", Price: 101000, Volume: 1, Status: OrderStatusWait, LastUpdated: time.Now()}
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+	first := OrderTrade{UUID: "trade-1", Price: "100000", Volume: "0.25", Funds: "25000", CreatedAt: "2024-01-01T09:00:00+09:00"}
+	second := OrderTrade{UUID: "trade-2", Price: "101000", Volume: "0.75", Funds: "75750", CreatedAt: "2024-01-01T09:00:05+09:00"}
+
+	client.setOrderState("order-1", &OrderResponse{UUID: "order-1", State: "wait", ExecutedVolume: "0.25"}, []OrderTrade{first})
+	e.pollOpenOrders(ctx)
+	// 두 번째 조회에서도 첫 체결이 다시 오지만 체결 UUID로 걸러 한 번만 반영한다
+	client.setOrderState("order-1", &OrderResponse{UUID: "order-1", State: "done", ExecutedVolume: "1"}, []OrderTrade{first, second})
+	e.pollOpenOrders(ctx)
+
+	var position model.Position
+	if err := db.Where("market_id = ?", "KRW-BTC").First(&position).Error; err != nil {
+		t.Fatalf("포지션 조회 실패: %v", err)
+	}
+	vwap := (100000*0.25 + 101000*0.75) / 1.0
+	if math.Abs(position.Quantity-1) > 1e-9 || math.Abs(position.EntryPrice-vwap) > 1e-6 {
+		t.Fatalf("포지션 = 수량 %v 평균 매수가 %v, want 1 %v", position.Quantity, position.EntryPrice, vwap)
+	}
+
+	var trades []model.Trade
+	if err := db.Where("order_id = ?", "order-1").Order("timestamp").Find(&trades).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 2 || trades[0].TradeID != "trade-1" || trades[1].TradeID != "trade-2" || trades[1].Price != 101000 {
+		t.Fatalf("저장된 체결 = %+v, want trade-1, trade-2", trades)
+	}
+}
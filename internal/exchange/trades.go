@@ -0,0 +1,126 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// fill 파싱된 체결 내역
+type fill struct {
+	uuid      string
+	price     float64
+	volume    float64
+	funds     float64 // 체결 금액
+	fee       float64
+	timestamp time.Time
+}
+
+// parseFills 체결 내역 파싱
+// 시장가 주문은 여러 호가에 걸쳐 체결되므로 체결마다 실제 가격을 그대로 사용한다.
+// 업비트 체결 내역에는 수수료가 없으므로 주문 응답의 paid_fee를 체결 금액 비율로 나누고,
+// paid_fee를 알 수 없으면 feeRate(%)로 추정한다.
+func parseFills(trades []OrderTrade, paidFee string, feeRate float64) ([]fill, error) {
+	fills := make([]fill, 0, len(trades))
+	totalFunds := 0.0
+	for _, trade := range trades {
+		price, err := strconv.ParseFloat(trade.Price, 64)
+		if err != nil {
+			return nil, fmt.Errorf("체결 가격 파싱 실패: %w", err)
+		}
+		volume, err := strconv.ParseFloat(trade.Volume, 64)
+		if err != nil {
+			return nil, fmt.Errorf("체결 수량 파싱 실패: %w", err)
+		}
+
+		f := fill{uuid: trade.UUID, price: price, volume: volume, funds: price * volume}
+		if funds, err := strconv.ParseFloat(trade.Funds, 64); err == nil {
+			f.funds = funds
+		}
+		totalFunds += f.funds
+
+		f.timestamp, err = time.Parse(time.RFC3339, trade.CreatedAt)
+		if err != nil {
+			f.timestamp = time.Now()
+		}
+
+		fills = append(fills, f)
+	}
+
+	paid, err := strconv.ParseFloat(paidFee, 64)
+	for i := range fills {
+		if err == nil && totalFunds > 0 {
+			fills[i].fee = paid * fills[i].funds / totalFunds
+		} else {
+			fills[i].fee = fills[i].funds * feeRate / 100
+		}
+	}
+
+	return fills, nil
+}
+
+// recordTrades 주문의 체결 내역 중 아직 기록하지 않은 체결을 Trade로 기록하고 그 합계 반환
+// 부분 체결은 여러 조회 주기에 걸쳐 들어오므로 이미 기록한 체결은 체결 UUID로 건너뛴다.
+// paidFee는 주문 조회 응답의 누적 수수료(paid_fee)이며, 비어 있으면 수수료율로 추정한다.
+func (e *OrderExecutor) recordTrades(ctx context.Context, order *model.Order, paidFee string) (orderFill, error) {
+	trades, err := e.client.GetOrderTrades(ctx, order.OrderID)
+	if err != nil {
+		return orderFill{}, err
+	}
+
+	side := "ask"
+	if order.Side == "BUY" {
+		side = "bid"
+	}
+	fills, err := parseFills(trades, paidFee, e.marketFeeRate(ctx, order.MarketID, side))
+	if err != nil {
+		return orderFill{}, err
+	}
+
+	var recorded []string
+	if err := e.db.Model(&model.Trade{}).Where("order_id = ?", order.OrderID).Pluck("trade_id", &recorded).Error; err != nil {
+		return orderFill{}, fmt.Errorf("기록된 체결 조회 실패: %w", err)
+	}
+	seen := make(map[string]bool, len(recorded))
+	for _, id := range recorded {
+		seen[id] = true
+	}
+
+	var added orderFill
+	for _, f := range fills {
+		if f.uuid != "" && seen[f.uuid] {
+			continue
+		}
+		seen[f.uuid] = true
+
+		trade := model.Trade{
+			MarketID:  order.MarketID,
+			OrderID:   order.OrderID,
+			TradeID:   f.uuid,
+			Price:     f.price,
+			Volume:    f.volume,
+			Side:      order.Side,
+			Fee:       f.fee,
+			Timestamp: f.timestamp,
+		}
+		if err := e.db.Create(&trade).Error; err != nil {
+			return added, fmt.Errorf("체결 저장 실패: %w", err)
+		}
+		added.Volume += f.volume
+		added.Notional += f.price * f.volume
+		added.Fee += f.fee
+	}
+
+	return added, nil
+}
+
+// feeRate 수수료 추정에 사용할 수수료율 (%)
+func (e *OrderExecutor) feeRate() float64 {
+	if e.cfg.FeeRate > 0 {
+		return e.cfg.FeeRate
+	}
+	return defaultFeeRate
+}
@@ -0,0 +1,134 @@
+package exchange
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestRecordTradesRecordsEachFillOnce(t *testing.T) {
+	client := &fakeExchange{
+		chance: &OrderChance{BidFee: "0.0005", AskFee: "0.0005"},
+		trades: map[string][]OrderTrade{
+			"order-1": {
+				{UUID: "trade-1", Price: "100000", Volume: "0.3", CreatedAt: "2024-01-01T09:00:00+09:00"},
+				{UUID: "trade-2", Price: "100100", Volume: "0.2", CreatedAt: "2024-01-01T09:00:01+09:00"},
+			},
+		},
+	}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	order := &model.Order{MarketID: "KRW-BTC", OrderID: "order-1", Side: "BUY", OrderType: "market", Status: OrderStatusWait, LastUpdated: time.Now()}
+
+	added, err := e.recordTrades(context.Background(), order, "")
+	if err != nil {
+		t.Fatalf("recordTrades 오류: %v", err)
+	}
+	notional := 100000*0.3 + 100100*0.2
+	if math.Abs(added.Volume-0.5) > 1e-9 || math.Abs(added.Notional-notional) > 1e-6 {
+		t.Fatalf("체결 합계 = %+v, want 수량 0.5, 금액 %v", added, notional)
+	}
+	// paid_fee가 없으면 체결마다 실제 체결 금액에 수수료율을 적용한다
+	if math.Abs(added.Fee-notional*0.0005) > 1e-6 {
+		t.Fatalf("수수료 합계 = %v, want %v", added.Fee, notional*0.0005)
+	}
+
+	// 다음 조회에서는 새 체결만 기록한다
+	client.mu.Lock()
+	client.trades["order-1"] = append(client.trades["order-1"], OrderTrade{UUID: "trade-3", Price: "100200", Volume: "0.1", CreatedAt: "2024-01-01T09:00:02+09:00"})
+	client.mu.Unlock()
+
+	added, err = e.recordTrades(context.Background(), order, "")
+	if err != nil {
+		t.Fatalf("recordTrades 오류: %v", err)
+	}
+	if math.Abs(added.Volume-0.1) > 1e-9 {
+		t.Fatalf("두 번째 체결 합계 수량 = %v, want 0.1", added.Volume)
+	}
+
+	var trades []model.Trade
+	if err := db.Order("timestamp").Find(&trades).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 3 {
+		t.Fatalf("저장된 체결 수 = %d, want 3", len(trades))
+	}
+	if trades[1].TradeID != "trade-2" || trades[1].Price != 100100 || trades[1].Side != "BUY" {
+		t.Fatalf("두 번째 체결 = %+v", trades[1])
+	}
+}
+
+func TestParseFillsRejectsMalformedTrades(t *testing.T) {
+	if _, err := parseFills([]OrderTrade{{UUID: "trade-1", Price: "abc", Volume: "1"}}, "", 0.05); err == nil {
+		t.Fatal("잘못된 체결 가격이 파싱됨")
+	}
+	if _, err := parseFills([]OrderTrade{{UUID: "trade-1", Price: "100", Volume: ""}}, "", 0.05); err == nil {
+		t.Fatal("잘못된 체결 수량이 파싱됨")
+	}
+}
+
+func TestParseFillsApportionsPaidFeeByFunds(t *testing.T) {
+	trades := []OrderTrade{
+		{UUID: "trade-1", Price: "100000", Volume: "0.3", Funds: "30000"},
+		{UUID: "trade-2", Price: "100000", Volume: "0.1", Funds: "10000"},
+	}
+
+	fills, err := parseFills(trades, "24", 0.05)
+	if err != nil {
+		t.Fatalf("parseFills 오류: %v", err)
+	}
+	if fills[0].fee != 18 || fills[1].fee != 6 {
+		t.Fatalf("체결 수수료 = %v, %v, want 18, 6", fills[0].fee, fills[1].fee)
+	}
+
+	// paid_fee가 없으면 수수료율로 추정한다
+	fills, err = parseFills(trades, "", 0.05)
+	if err != nil {
+		t.Fatalf("parseFills 오류: %v", err)
+	}
+	if fills[0].fee != 15 || fills[1].fee != 5 {
+		t.Fatalf("추정 수수료 = %v, %v, want 15, 5", fills[0].fee, fills[1].fee)
+	}
+}
+
+func TestTrackOrderUsesPaidFee(t *testing.T) {
+	client := &fakeExchange{
+		orders: map[string]*OrderResponse{
+			"order-1": {UUID: "order-1", State: "done", ExecutedVolume: "0.4", PaidFee: "30"},
+		},
+		trades: map[string][]OrderTrade{
+			"order-1": {
+				{UUID: "trade-1", Price: "100000", Volume: "0.3", Funds: "30000", CreatedAt: "2024-01-01T09:00:00+09:00"},
+				{UUID: "trade-2", Price: "100000", Volume: "0.1", Funds: "10000", CreatedAt: "2024-01-01T09:00:01+09:00"},
+			},
+		},
+	}
+	e, db := newTestExecutor(t, client, config.TradingConfig{})
+	order := model.Order{MarketID: "KRW-BTC", OrderID: "order-1", Side: "BUY", OrderType: "limit", Price: 100000, Volume: 0.4, Status: OrderStatusWait, LastUpdated: time.Now()}
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.trackOrder(context.Background(), &order); err != nil {
+		t.Fatalf("trackOrder 오류: %v", err)
+	}
+
+	var trades []model.Trade
+	if err := db.Order("timestamp").Find(&trades).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 2 || trades[0].Fee != 22.5 || trades[1].Fee != 7.5 {
+		t.Fatalf("저장된 체결 = %+v, want 수수료 22.5, 7.5", trades)
+	}
+
+	var position model.Position
+	if err := db.Where("market_id = ?", "KRW-BTC").First(&position).Error; err != nil {
+		t.Fatalf("포지션 조회 실패: %v", err)
+	}
+	if position.EntryFee != 30 {
+		t.Fatalf("포지션 매수 수수료 = %v, want 30", position.EntryFee)
+	}
+}
@@ -0,0 +1,121 @@
+package exchange
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+var (
+	// ErrWithdrawalsDisabled 출금 기능이 켜져 있지 않음
+	ErrWithdrawalsDisabled = errors.New("출금 기능이 비활성화되어 있습니다")
+	// ErrWithdrawalNotConfirmed 출금 확인 토큰 불일치
+	ErrWithdrawalNotConfirmed = errors.New("출금 확인 토큰이 일치하지 않습니다")
+)
+
+// Transfer 입출금 내역
+type Transfer struct {
+	Type            string `json:"type"` // deposit, withdraw
+	UUID            string `json:"uuid"`
+	Currency        string `json:"currency"`
+	NetType         string `json:"net_type"`
+	TxID            string `json:"txid"`
+	State           string `json:"state"`
+	CreatedAt       string `json:"created_at"`
+	DoneAt          string `json:"done_at"`
+	Amount          string `json:"amount"`
+	Fee             string `json:"fee"`
+	TransactionType string `json:"transaction_type"` // default(일반), internal(바로 입출금)
+}
+
+// Deposit 입금 내역
+type Deposit = Transfer
+
+// Withdraw 출금 내역
+type Withdraw = Transfer
+
+// WithdrawResponse 출금 요청 응답
+type WithdrawResponse struct {
+	Transfer
+	KRWAmount string `json:"krw_amount"`
+}
+
+// EnableWithdrawals 코인 출금 허용
+// 자금이 빠져나가는 요청이므로 기본으로 막혀 있으며, 출금 시 여기서 정한 확인 토큰을 함께 넘겨야 한다.
+func EnableWithdrawals(confirmToken string) ClientOption {
+	return func(c *UpbitClient) {
+		c.withdrawToken = confirmToken
+	}
+}
+
+// GetDeposits 입금 내역 조회 (currency가 비어 있으면 전체 화폐)
+func (c *UpbitClient) GetDeposits(ctx context.Context, currency string) ([]Deposit, error) {
+	return c.getTransfers(ctx, "/deposits", currency)
+}
+
+// GetWithdraws 출금 내역 조회 (currency가 비어 있으면 전체 화폐)
+func (c *UpbitClient) GetWithdraws(ctx context.Context, currency string) ([]Withdraw, error) {
+	return c.getTransfers(ctx, "/withdraws", currency)
+}
+
+// getTransfers 입출금 내역 조회
+func (c *UpbitClient) getTransfers(ctx context.Context, path, currency string) ([]Transfer, error) {
+	params := map[string]string{}
+	if currency != "" {
+		params["currency"] = currency
+	}
+
+	var transfers []Transfer
+	if err := c.doRequest(ctx, c.orderLimiter, c.orderListRequest(ctx, path, params), http.StatusOK, &transfers); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// CreateWithdrawCrypto 코인 출금 요청
+// EnableWithdrawals로 출금을 허용하고 같은 확인 토큰을 넘긴 경우에만 요청을 보낸다.
+// netType은 출금 네트워크로, 화폐 이름과 다를 수 있으므로 (예: USDT의 TRX) 받는 주소의 네트워크와 맞춰야 한다.
+func (c *UpbitClient) CreateWithdrawCrypto(ctx context.Context, currency, netType string, amount float64, address, secondaryAddress, confirmToken string) (*WithdrawResponse, error) {
+	if c.withdrawToken == "" {
+		return nil, ErrWithdrawalsDisabled
+	}
+	if subtle.ConstantTimeCompare([]byte(confirmToken), []byte(c.withdrawToken)) != 1 {
+		return nil, ErrWithdrawalNotConfirmed
+	}
+	if netType == "" {
+		return nil, fmt.Errorf("출금 네트워크(net_type)는 필수입니다")
+	}
+
+	params := map[string]string{
+		"currency": currency,
+		"net_type": netType,
+		"amount":   strconv.FormatFloat(amount, 'f', -1, 64),
+		"address":  address,
+	}
+	if secondaryAddress != "" {
+		params["secondary_address"] = secondaryAddress
+	}
+
+	jsonData, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("요청 인코딩 실패: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/withdraws/coin", upbitAPIURL)
+	build := func() (*http.Request, error) {
+		return c.newAuthRequest(ctx, "POST", url, params, jsonData)
+	}
+
+	var response WithdrawResponse
+	if err := c.doRequest(ctx, c.orderLimiter.Urgent(), build, http.StatusCreated, &response); err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("코인 출금 요청:", currency, params["amount"], response.UUID)
+	return &response, nil
+}
@@ -0,0 +1,89 @@
+package exchange
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetTransfersSignQueryHash(t *testing.T) {
+	var path, query, token string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query, token = r.URL.Path, r.URL.RawQuery, r.Header.Get("Authorization")
+		w.Write([]byte(`[{"type":"deposit","uuid":"deposit-1","currency":"KRW","state":"ACCEPTED","amount":"10000"}]`))
+	}))
+
+	deposits, err := c.GetDeposits(context.Background(), "KRW")
+	if err != nil {
+		t.Fatalf("GetDeposits 오류: %v", err)
+	}
+	if path != "/v1/deposits" || query != "currency=KRW" {
+		t.Fatalf("요청 = %s?%s, want /v1/deposits?currency=KRW", path, query)
+	}
+	if len(deposits) != 1 || deposits[0].UUID != "deposit-1" || deposits[0].Amount != "10000" {
+		t.Fatalf("입금 내역 = %+v, want deposit-1 10000", deposits)
+	}
+	want := fmt.Sprintf("%x", sha512.Sum512([]byte(query)))
+	if claims := parseTestJWT(t, token, "secret"); claims["query_hash"] != want {
+		t.Fatalf("query_hash = %v, want %s", claims["query_hash"], want)
+	}
+
+	if _, err := c.GetWithdraws(context.Background(), ""); err != nil {
+		t.Fatalf("GetWithdraws 오류: %v", err)
+	}
+	if path != "/v1/withdraws" || query != "" {
+		t.Fatalf("요청 = %s?%s, want 전체 화폐 /v1/withdraws", path, query)
+	}
+}
+
+func TestCreateWithdrawCryptoRequiresEnableAndToken(t *testing.T) {
+	var requests int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"uuid":"withdraw-1"}`))
+	})
+	ctx := context.Background()
+
+	disabled := newTestClient(t, handler)
+	if _, err := disabled.CreateWithdrawCrypto(ctx, "BTC", "BTC", 0.1, "address", "", ""); !errors.Is(err, ErrWithdrawalsDisabled) {
+		t.Fatalf("출금 비활성 오류 = %v, want ErrWithdrawalsDisabled", err)
+	}
+
+	enabled := newTestClient(t, handler, EnableWithdrawals("confirm"))
+	if _, err := enabled.CreateWithdrawCrypto(ctx, "BTC", "BTC", 0.1, "address", "", "wrong"); !errors.Is(err, ErrWithdrawalNotConfirmed) {
+		t.Fatalf("잘못된 확인 토큰 오류 = %v, want ErrWithdrawalNotConfirmed", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Fatalf("출금 요청 %d건 전송, want 0", n)
+	}
+}
+
+func TestCreateWithdrawCryptoSendsNetType(t *testing.T) {
+	var body map[string]string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"uuid":"withdraw-1","currency":"USDT","net_type":"TRX","state":"WAITING"}`))
+	}), EnableWithdrawals("confirm"))
+
+	resp, err := c.CreateWithdrawCrypto(context.Background(), "USDT", "TRX", 10, "address", "", "confirm")
+	if err != nil {
+		t.Fatalf("출금 요청 실패: %v", err)
+	}
+	if body["currency"] != "USDT" || body["net_type"] != "TRX" || body["amount"] != "10" {
+		t.Fatalf("요청 본문 = %v, want currency USDT, net_type TRX, amount 10", body)
+	}
+	if resp.UUID != "withdraw-1" {
+		t.Fatalf("응답 UUID = %q, want withdraw-1", resp.UUID)
+	}
+
+	if _, err := c.CreateWithdrawCrypto(context.Background(), "USDT", "", 10, "address", "", "confirm"); err == nil {
+		t.Fatal("출금 네트워크 없이 요청이 전송됨")
+	}
+}
@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha512"
 	"encoding/json"
 	"fmt"
@@ -10,12 +11,15 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/metrics"
 	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -24,6 +28,15 @@ const (
 	defaultHTTPTimeout  = 10 * time.Second
 	initialBackoff      = 1
 	maxBackoff          = 30
+	defaultWSShardSize  = 100
+
+	// maxCandlesPerRequest 캔들 조회 API 요청당 최대 개수
+	maxCandlesPerRequest = 200
+	// maxMarketsQueryLength 한 요청에 넣을 마켓 목록 최대 길이 (주소 길이 제한 대비)
+	maxMarketsQueryLength = 1500
+
+	// MinOrderAmount 업비트 KRW 마켓 최소 주문 금액
+	MinOrderAmount = 5000.0
 )
 
 // UpbitClient 업비트 API 클라이언트
@@ -32,6 +45,27 @@ type UpbitClient struct {
 	secretKey   string
 	httpClient  *http.Client
 	logger      *utils.Logger
+
+	wsURL              string
+	wsMarketsPerConn   int
+	wsPingInterval     time.Duration
+	wsPongTimeout      time.Duration
+	wsSubscribeTimeout time.Duration
+	wsFormat           string
+	wsStats            wsStats
+	errorRate          *errorRateTracker
+
+	orderLimiter     *priorityLimiter
+	quotationLimiter *rate.Limiter
+	rateLimit        rateLimitState
+
+	maxRetries   int
+	retryBackoff time.Duration
+	listPageSize int
+
+	candleChunking bool
+	withdrawToken  string // 비어 있으면 출금 비활성
+	metrics        *metrics.Metrics
 }
 
 // Market 마켓 정보
@@ -100,6 +134,7 @@ type OrderResponse struct {
 	Volume          string   `json:"volume"`
 	RemainingVolume string   `json:"remaining_volume"`
 	ExecutedVolume  string   `json:"executed_volume"`
+	PaidFee         string   `json:"paid_fee"`
 }
 
 // OrderTrade 체결 내역
@@ -107,10 +142,27 @@ type OrderTrade struct {
 	UUID       string  `json:"uuid"`
 	Price      string  `json:"price"`
 	Volume     string  `json:"volume"`
-	Fee        string  `json:"fee"`
+	Funds      string  `json:"funds"`
 	CreatedAt  string  `json:"created_at"`
 }
 
+// OrderbookUnit 호가 단위
+type OrderbookUnit struct {
+	AskPrice float64 `json:"ask_price"`
+	BidPrice float64 `json:"bid_price"`
+	AskSize  float64 `json:"ask_size"`
+	BidSize  float64 `json:"bid_size"`
+}
+
+// Orderbook 호가 정보
+type Orderbook struct {
+	MarketID     string          `json:"market"`
+	Timestamp    int64           `json:"timestamp"`
+	TotalAskSize float64         `json:"total_ask_size"`
+	TotalBidSize float64         `json:"total_bid_size"`
+	Units        []OrderbookUnit `json:"orderbook_units"`
+}
+
 // MarketData 시장 데이터
 type MarketData struct {
 	Type       string  `json:"type"`
@@ -122,16 +174,39 @@ type MarketData struct {
 	Ask        float64 `json:"ask,omitempty"`
 	BidVolume  float64 `json:"bid_volume,omitempty"`
 	AskVolume  float64 `json:"ask_volume,omitempty"`
+
+	Ticker    *Ticker    `json:"-"` // type이 ticker일 때의 현재가 정보 (웹소켓 수신 시)
+	Trade     *TickTrade `json:"-"` // type이 trade일 때의 체결 정보
+	Orderbook *Orderbook `json:"-"` // type이 orderbook일 때의 호가 정보
 }
 
 // NewUpbitClient 새로운 업비트 클라이언트 생성
-func NewUpbitClient(accessKey, secretKey string) *UpbitClient {
-	return &UpbitClient{
-		accessKey:  accessKey,
-		secretKey:  secretKey,
-		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
-		logger:     utils.NewLogger("upbit"),
+func NewUpbitClient(accessKey, secretKey string, opts ...ClientOption) *UpbitClient {
+	c := &UpbitClient{
+		accessKey:          accessKey,
+		secretKey:          secretKey,
+		httpClient:         &http.Client{Timeout: defaultHTTPTimeout},
+		logger:             utils.NewLogger("upbit"),
+		wsURL:              upbitWebSocketURL,
+		wsMarketsPerConn:   defaultWSShardSize,
+		wsPingInterval:     defaultWSPingInterval,
+		wsPongTimeout:      defaultWSPongTimeout,
+		wsSubscribeTimeout: defaultWSSubscribeTimeout,
+		wsFormat:           WebSocketFormatDefault,
+		errorRate:          newErrorRateTracker(defaultErrorRateWindow),
+		orderLimiter:       newPriorityLimiter(defaultOrderRequestsPerSecond),
+		quotationLimiter:   newRateLimiter(defaultQuotationRequestsPerSecond),
+		maxRetries:         defaultMaxRetries,
+		retryBackoff:       defaultRetryBackoff,
+		listPageSize:       defaultListPageSize,
+		candleChunking:     true,
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
 // createJWT JWT 생성
@@ -160,34 +235,22 @@ func (c *UpbitClient) createJWT(params map[string]string) (string, error) {
 	
 	tokenString, err := token.SignedString([]byte(c.secretKey))
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("JWT 서명 실패: %w", err)
 	}
 	
 	return "Bearer " + tokenString, nil
 }
 
 // GetMarkets 마켓 코드 조회
-func (c *UpbitClient) GetMarkets() ([]Market, error) {
+func (c *UpbitClient) GetMarkets(ctx context.Context) ([]Market, error) {
 	url := fmt.Sprintf("%s/market/all", upbitAPIURL)
 	
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-	
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API 오류: %s %s", resp.Status, string(body))
+	build := func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, "GET", url, nil)
 	}
 	
 	var markets []Market
-	if err := json.NewDecoder(resp.Body).Decode(&markets); err != nil {
+	if err := c.doRequest(ctx, c.quotationLimiter, build, http.StatusOK, &markets); err != nil {
 		return nil, err
 	}
 	
@@ -213,39 +276,129 @@ func (c *UpbitClient) GetMarkets() ([]Market, error) {
 }
 
 // GetTicker 현재가 정보 조회
-func (c *UpbitClient) GetTicker(marketID string) (*Ticker, error) {
-	url := fmt.Sprintf("%s/ticker?markets=%s", upbitAPIURL, marketID)
-	
-	req, err := http.NewRequest("GET", url, nil)
+func (c *UpbitClient) GetTicker(ctx context.Context, marketID string) (*Ticker, error) {
+	tickers, err := c.GetTickers(ctx, []string{marketID})
 	if err != nil {
 		return nil, err
 	}
 	
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	if len(tickers) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTickerNotFound, marketID)
 	}
-	defer resp.Body.Close()
 	
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API 오류: %s %s", resp.Status, string(body))
+	return &tickers[0], nil
+}
+
+// GetTickers 여러 마켓의 현재가 정보 조회
+// 마켓 목록은 쉼표로 이어 한 번에 요청하며, 주소가 너무 길어지면 여러 요청으로 나눠 결과를 합친다.
+func (c *UpbitClient) GetTickers(ctx context.Context, marketIDs []string) ([]Ticker, error) {
+	if len(marketIDs) == 0 {
+		return nil, ErrNoMarkets
 	}
-	
-	var tickers []Ticker
-	if err := json.NewDecoder(resp.Body).Decode(&tickers); err != nil {
+
+	var result []Ticker
+	for _, markets := range chunkMarkets(marketIDs, maxMarketsQueryLength) {
+		url := fmt.Sprintf("%s/ticker?markets=%s", upbitAPIURL, markets)
+
+		build := func() (*http.Request, error) {
+			return http.NewRequestWithContext(ctx, "GET", url, nil)
+		}
+
+		var tickers []Ticker
+		if err := c.doRequest(ctx, c.quotationLimiter, build, http.StatusOK, &tickers); err != nil {
+			return nil, err
+		}
+		result = append(result, tickers...)
+	}
+
+	return result, nil
+}
+
+// chunkMarkets 쉼표로 이은 길이가 maxLength를 넘지 않도록 마켓 목록 분할
+func chunkMarkets(marketIDs []string, maxLength int) []string {
+	var chunks []string
+	current := ""
+	for _, marketID := range marketIDs {
+		if current != "" && len(current)+1+len(marketID) > maxLength {
+			chunks = append(chunks, current)
+			current = ""
+		}
+		if current != "" {
+			current += ","
+		}
+		current += marketID
+	}
+
+	return append(chunks, current)
+}
+
+// GetOrderbook 호가 정보 조회
+func (c *UpbitClient) GetOrderbook(ctx context.Context, marketID string) (*Orderbook, error) {
+	url := fmt.Sprintf("%s/orderbook?markets=%s", upbitAPIURL, marketID)
+
+	build := func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, "GET", url, nil)
+	}
+
+	var orderbooks []Orderbook
+	if err := c.doRequest(ctx, c.quotationLimiter, build, http.StatusOK, &orderbooks); err != nil {
 		return nil, err
 	}
-	
-	if len(tickers) == 0 {
-		return nil, fmt.Errorf("티커 정보가 없습니다: %s", marketID)
+
+	if len(orderbooks) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrOrderbookNotFound, marketID)
 	}
-	
-	return &tickers[0], nil
+
+	return &orderbooks[0], nil
 }
 
 // GetCandles 캔들스틱 정보 조회
-func (c *UpbitClient) GetCandles(marketID, timeframe string, count int) ([]Candle, error) {
+func (c *UpbitClient) GetCandles(ctx context.Context, marketID, timeframe string, count int) ([]Candle, error) {
+	return c.GetCandlesBefore(ctx, marketID, timeframe, count, time.Time{})
+}
+
+// GetCandlesBefore to 이전에 시작한 캔들스틱 정보 조회 (to가 0이면 최신 캔들부터)
+// 업비트는 요청당 200개까지만 반환하므로 count가 더 크면 to를 과거로 옮겨 가며 나눠 조회하고 최신순으로 이어 붙인다.
+// 나눠 조회하지 않도록 설정하면 200개로 제한된다.
+func (c *UpbitClient) GetCandlesBefore(ctx context.Context, marketID, timeframe string, count int, to time.Time) ([]Candle, error) {
+	if count <= maxCandlesPerRequest {
+		return c.getCandlePage(ctx, marketID, timeframe, count, to)
+	}
+	if !c.candleChunking {
+		c.logger.Debug("캔들 요청 개수를 최대 개수로 제한:", marketID, timeframe, count, "->", maxCandlesPerRequest)
+		return c.getCandlePage(ctx, marketID, timeframe, maxCandlesPerRequest, to)
+	}
+
+	candles := make([]Candle, 0, count)
+	cursor := to
+	for len(candles) < count {
+		size := count - len(candles)
+		if size > maxCandlesPerRequest {
+			size = maxCandlesPerRequest
+		}
+
+		page, err := c.getCandlePage(ctx, marketID, timeframe, size, cursor)
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, page...)
+		if len(page) < size {
+			break
+		}
+
+		// 응답은 최신순이므로 마지막 캔들이 가장 오래된 캔들이다
+		cursor, err = time.Parse(candleTimeLayout, page[len(page)-1].CandleDateTimeUTC)
+		if err != nil {
+			return nil, fmt.Errorf("캔들 시각 파싱 실패: %w", err)
+		}
+	}
+
+	return candles, nil
+}
+
+// getCandlePage 캔들 조회 API 한 번 호출
+// 타임프레임 형식: seconds(1초), minutes/N(N분, 1·3·5·10·15·30·60·240), days, weeks, months
+func (c *UpbitClient) getCandlePage(ctx context.Context, marketID, timeframe string, count int, to time.Time) ([]Candle, error) {
 	var url string
 	
 	// 타임프레임에 따른 엔드포인트 선택
@@ -253,10 +406,12 @@ func (c *UpbitClient) GetCandles(marketID, timeframe string, count int) ([]Candl
 	case strings.HasPrefix(timeframe, "minutes"):
 		parts := strings.Split(timeframe, "/")
 		if len(parts) != 2 {
-			return nil, fmt.Errorf("잘못된 타임프레임 형식: %s", timeframe)
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTimeframe, timeframe)
 		}
 		unit := parts[1]
 		url = fmt.Sprintf("%s/candles/minutes/%s?market=%s&count=%d", upbitAPIURL, unit, marketID, count)
+	case timeframe == "seconds":
+		url = fmt.Sprintf("%s/candles/seconds?market=%s&count=%d", upbitAPIURL, marketID, count)
 	case timeframe == "days":
 		url = fmt.Sprintf("%s/candles/days?market=%s&count=%d", upbitAPIURL, marketID, count)
 	case timeframe == "weeks":
@@ -264,27 +419,18 @@ func (c *UpbitClient) GetCandles(marketID, timeframe string, count int) ([]Candl
 	case timeframe == "months":
 		url = fmt.Sprintf("%s/candles/months?market=%s&count=%d", upbitAPIURL, marketID, count)
 	default:
-		return nil, fmt.Errorf("지원되지 않는 타임프레임: %s", timeframe)
-	}
-	
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedTimeframe, timeframe)
 	}
-	
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	if !to.IsZero() {
+		url += "&to=" + to.UTC().Format(candleTimeLayout+"Z")
 	}
-	defer resp.Body.Close()
 	
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API 오류: %s %s", resp.Status, string(body))
+	build := func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, "GET", url, nil)
 	}
 	
 	var candles []Candle
-	if err := json.NewDecoder(resp.Body).Decode(&candles); err != nil {
+	if err := c.doRequest(ctx, c.quotationLimiter, build, http.StatusOK, &candles); err != nil {
 		return nil, err
 	}
 	
@@ -292,34 +438,15 @@ func (c *UpbitClient) GetCandles(marketID, timeframe string, count int) ([]Candl
 }
 
 // GetAccounts 계정 정보 조회
-func (c *UpbitClient) GetAccounts() ([]Account, error) {
+func (c *UpbitClient) GetAccounts(ctx context.Context) ([]Account, error) {
 	url := fmt.Sprintf("%s/accounts", upbitAPIURL)
 	
-	token, err := c.createJWT(nil)
-	if err != nil {
-		return nil, err
-	}
-	
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-	
-	req.Header.Add("Authorization", token)
-	
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API 오류: %s %s", resp.Status, string(body))
+	build := func() (*http.Request, error) {
+		return c.newAuthRequest(ctx, "GET", url, nil, nil)
 	}
 	
 	var accounts []Account
-	if err := json.NewDecoder(resp.Body).Decode(&accounts); err != nil {
+	if err := c.doRequest(ctx, c.orderLimiter, build, http.StatusOK, &accounts); err != nil {
 		return nil, err
 	}
 	
@@ -327,116 +454,118 @@ func (c *UpbitClient) GetAccounts() ([]Account, error) {
 }
 
 // CreateOrder 주문 생성
-func (c *UpbitClient) CreateOrder(marketID, side, orderType string, volume, price float64) (*OrderResponse, error) {
+func (c *UpbitClient) CreateOrder(ctx context.Context, marketID, side, orderType string, volume, price float64) (*OrderResponse, error) {
 	url := fmt.Sprintf("%s/orders", upbitAPIURL)
 	
-	orderRequest := OrderRequest{
-		MarketID:   marketID,
-		Side:       side,
-		OrderType:  orderType,
-		Identifier: uuid.New().String(),
-	}
-	
-	// 주문 유형에 따른 필드 설정
+	// 호가 단위에 맞지 않는 지정가는 거래소가 거부한다
 	if orderType == "limit" {
-		orderRequest.Price = price
-		orderRequest.Volume = volume
-	} else if orderType == "market" {
-		if side == "bid" {
-			orderRequest.Price = price
-		} else {
-			orderRequest.Volume = volume
-		}
+		price = NormalizePrice(marketID, price)
 	}
 	
+	identifier := uuid.New().String()
+	orderRequest, params := newOrderRequest(marketID, side, orderType, volume, price, identifier)
+	
 	jsonData, err := json.Marshal(orderRequest)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("요청 인코딩 실패: %w", err)
 	}
 	
-	params := map[string]string{
-		"market":     marketID,
-		"side":       side,
-		"ord_type":   orderType,
-		"identifier": orderRequest.Identifier,
+	// identifier가 같으므로 재시도된 주문이 중복 생성되지 않는다
+	attempts := 0
+	build := func() (*http.Request, error) {
+		attempts++
+		return c.newAuthRequest(ctx, "POST", url, params, jsonData)
 	}
 	
-	if orderType == "limit" {
-		params["price"] = strconv.FormatFloat(price, 'f', -1, 64)
-		params["volume"] = strconv.FormatFloat(volume, 'f', -1, 64)
-	} else if orderType == "market" {
-		if side == "bid" {
-			params["price"] = strconv.FormatFloat(price, 'f', -1, 64)
-		} else {
-			params["volume"] = strconv.FormatFloat(volume, 'f', -1, 64)
+	var orderResponse OrderResponse
+	err = c.doRequest(ctx, c.orderLimiter.Urgent(), build, http.StatusCreated, &orderResponse)
+	if err != nil && attempts > 1 && isClientError(err) {
+		// 응답을 받지 못한 첫 요청이 업비트에 도착했다면 재시도는 identifier 중복으로 거부된다
+		if created, lookupErr := c.GetOrderByIdentifier(ctx, identifier); lookupErr == nil {
+			c.logger.Warn("재시도 전에 생성된 주문 사용:", marketID, side, created.UUID, err)
+			return created, nil
 		}
 	}
-	
-	token, err := c.createJWT(params)
 	if err != nil {
 		return nil, err
 	}
 	
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
+	return &orderResponse, nil
+}
+
+// newOrderRequest 주문 요청 본문과 서명할 파라미터 생성
+// 업비트 시장가 매수는 ord_type=price로 매수 총액(price)만, 시장가 매도는 ord_type=market으로 수량(volume)만 보내야 하며
+// 다른 필드가 함께 있으면 주문이 거부된다. 본문과 서명 파라미터가 어긋나지 않도록 한곳에서 만든다.
+func newOrderRequest(marketID, side, orderType string, volume, price float64, identifier string) (OrderRequest, map[string]string) {
+	request := OrderRequest{
+		MarketID:   marketID,
+		Side:       side,
+		OrderType:  orderType,
+		Identifier: identifier,
 	}
-	
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", token)
-	
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+
+	switch {
+	case orderType == "limit":
+		request.Price = price
+		request.Volume = volume
+	case orderType == "market" && side == "bid":
+		request.OrderType = "price"
+		request.Price = price
+	case orderType == "market":
+		request.Volume = volume
 	}
-	defer resp.Body.Close()
-	
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API 오류: %s %s", resp.Status, string(body))
+
+	params := map[string]string{
+		"market":     request.MarketID,
+		"side":       request.Side,
+		"ord_type":   request.OrderType,
+		"identifier": request.Identifier,
 	}
-	
-	var orderResponse OrderResponse
-	if err := json.NewDecoder(resp.Body).Decode(&orderResponse); err != nil {
-		return nil, err
+	if request.Price != 0 {
+		params["price"] = strconv.FormatFloat(request.Price, 'f', -1, 64)
 	}
-	
-	return &orderResponse, nil
+	if request.Volume != 0 {
+		params["volume"] = strconv.FormatFloat(request.Volume, 'f', -1, 64)
+	}
+
+	return request, params
 }
 
 // GetOrder 주문 조회
-func (c *UpbitClient) GetOrder(uuid string) (*OrderResponse, error) {
+// 미체결 주문 추적의 상태 조회이므로 주문 그룹 한도에서 주문 생성·취소보다 뒤에 보낸다.
+func (c *UpbitClient) GetOrder(ctx context.Context, uuid string) (*OrderResponse, error) {
 	params := map[string]string{
 		"uuid": uuid,
 	}
 	
-	token, err := c.createJWT(params)
-	if err != nil {
-		return nil, err
-	}
-	
 	url := fmt.Sprintf("%s/order?uuid=%s", upbitAPIURL, uuid)
 	
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+	build := func() (*http.Request, error) {
+		return c.newAuthRequest(ctx, "GET", url, params, nil)
 	}
 	
-	req.Header.Add("Authorization", token)
-	
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
+	var orderResponse OrderResponse
+	if err := c.doRequest(ctx, c.orderLimiter, build, http.StatusOK, &orderResponse); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 	
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API 오류: %s %s", resp.Status, string(body))
+	return &orderResponse, nil
+}
+
+// GetOrderByIdentifier 주문 생성 시 보낸 identifier로 주문 조회
+func (c *UpbitClient) GetOrderByIdentifier(ctx context.Context, identifier string) (*OrderResponse, error) {
+	params := map[string]string{
+		"identifier": identifier,
+	}
+	
+	url := fmt.Sprintf("%s/order?identifier=%s", upbitAPIURL, identifier)
+	
+	build := func() (*http.Request, error) {
+		return c.newAuthRequest(ctx, "GET", url, params, nil)
 	}
 	
 	var orderResponse OrderResponse
-	if err := json.NewDecoder(resp.Body).Decode(&orderResponse); err != nil {
+	if err := c.doRequest(ctx, c.orderLimiter.Urgent(), build, http.StatusOK, &orderResponse); err != nil {
 		return nil, err
 	}
 	
@@ -444,38 +573,19 @@ func (c *UpbitClient) GetOrder(uuid string) (*OrderResponse, error) {
 }
 
 // GetOrderTrades 체결 내역 조회
-func (c *UpbitClient) GetOrderTrades(uuid string) ([]OrderTrade, error) {
+func (c *UpbitClient) GetOrderTrades(ctx context.Context, uuid string) ([]OrderTrade, error) {
 	params := map[string]string{
 		"uuid": uuid,
 	}
 	
-	token, err := c.createJWT(params)
-	if err != nil {
-		return nil, err
-	}
-	
 	url := fmt.Sprintf("%s/order/trades?uuid=%s", upbitAPIURL, uuid)
 	
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-	
-	req.Header.Add("Authorization", token)
-	
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API 오류: %s %s", resp.Status, string(body))
+	build := func() (*http.Request, error) {
+		return c.newAuthRequest(ctx, "GET", url, params, nil)
 	}
 	
 	var trades []OrderTrade
-	if err := json.NewDecoder(resp.Body).Decode(&trades); err != nil {
+	if err := c.doRequest(ctx, c.orderLimiter, build, http.StatusOK, &trades); err != nil {
 		return nil, err
 	}
 	
@@ -483,62 +593,149 @@ func (c *UpbitClient) GetOrderTrades(uuid string) ([]OrderTrade, error) {
 }
 
 // CancelOrder 주문 취소
-func (c *UpbitClient) CancelOrder(uuid string) (*OrderResponse, error) {
+func (c *UpbitClient) CancelOrder(ctx context.Context, uuid string) (*OrderResponse, error) {
 	params := map[string]string{
 		"uuid": uuid,
 	}
 	
-	token, err := c.createJWT(params)
-	if err != nil {
-		return nil, err
-	}
-	
 	url := fmt.Sprintf("%s/order", upbitAPIURL)
 	
 	jsonData, err := json.Marshal(params)
 	if err != nil {
+		return nil, fmt.Errorf("요청 인코딩 실패: %w", err)
+	}
+	
+	build := func() (*http.Request, error) {
+		return c.newAuthRequest(ctx, "DELETE", url, params, jsonData)
+	}
+	
+	var orderResponse OrderResponse
+	if err := c.doRequest(ctx, c.orderLimiter.Urgent(), build, http.StatusOK, &orderResponse); err != nil {
 		return nil, err
 	}
 	
-	req, err := http.NewRequest("DELETE", url, bytes.NewBuffer(jsonData))
+	return &orderResponse, nil
+}
+
+// newAuthRequest 인증 헤더를 포함한 요청 생성
+// JWT nonce는 요청마다 달라야 하므로 재시도할 때도 새로 서명한다.
+func (c *UpbitClient) newAuthRequest(ctx context.Context, method, url string, params map[string]string, body []byte) (*http.Request, error) {
+	token, err := c.createJWT(params)
 	if err != nil {
 		return nil, err
 	}
-	
-	req.Header.Add("Content-Type", "application/json")
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
 	req.Header.Add("Authorization", token)
-	
+
+	return req, nil
+}
+
+// doRequest 요청 전송 및 응답 디코딩
+// 네트워크 오류, 5xx, 429 응답은 지수 백오프로 재시도하며 429의 Retry-After 헤더를 따른다.
+// 그 밖의 4xx는 요청 자체의 문제이므로 재시도하지 않는다.
+// 요청 컨텍스트가 취소되면 errors.Is(err, context.Canceled)로 확인할 수 있는 오류를 반환한다.
+func (c *UpbitClient) doRequest(ctx context.Context, limiter requestLimiter, build func() (*http.Request, error), expectedStatus int, out interface{}) error {
+	backoff := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		req, err := build()
+		if err != nil {
+			return fmt.Errorf("요청 생성 실패: %w", err)
+		}
+
+		status, retryAfter, err := c.send(req, limiter, expectedStatus, out)
+		c.logger.Debug("업비트 응답:", req.Method, req.URL.Path, status)
+		if err == nil || attempt >= c.maxRetries || ctx.Err() != nil || !isRetryableStatus(status) {
+			return err
+		}
+
+		wait := backoff
+		if retryAfter > 0 {
+			wait = retryAfter
+		}
+		c.logger.Warn("업비트 요청 재시도:", req.Method, req.URL.Path, attempt+1, wait, err)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ErrRequestFailed, ctx.Err())
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+// send 요청 한 번 전송
+// 응답 상태 코드(네트워크 오류면 0)와 Retry-After 대기 시간을 함께 반환한다.
+func (c *UpbitClient) send(req *http.Request, limiter requestLimiter, expectedStatus int, out interface{}) (int, time.Duration, error) {
+	if err := limiter.Wait(req.Context()); err != nil {
+		return 0, 0, fmt.Errorf("%w: 요청 한도 대기 실패: %w", ErrRequestFailed, err)
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		// 호출자가 취소한 요청은 업비트 장애로 집계하지 않는다
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return 0, 0, fmt.Errorf("%w: %w", ErrRequestFailed, ctxErr)
+		}
+		c.errorRate.record(time.Now(), true)
+		c.metrics.APIError(apiErrorRequestFailed)
+		return 0, 0, fmt.Errorf("%w: %w", ErrRequestFailed, err)
 	}
 	defer resp.Body.Close()
-	
-	if resp.StatusCode != http.StatusOK {
+
+	c.errorRate.record(time.Now(), isServerFailure(resp.StatusCode))
+	c.rateLimit.update(resp.Header.Get("Remaining-Req"), time.Now())
+
+	if resp.StatusCode != expectedStatus {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API 오류: %s %s", resp.Status, string(body))
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		apiErr := parseAPIError(resp.StatusCode, body)
+		c.metrics.APIError(apiErr.metricName())
+		return resp.StatusCode, retryAfter, apiErr
 	}
-	
-	var orderResponse OrderResponse
-	if err := json.NewDecoder(resp.Body).Decode(&orderResponse); err != nil {
-		return nil, err
+
+	dec := json.NewDecoder(resp.Body)
+	if stream, ok := out.(arrayStream); ok {
+		err = stream(dec)
+	} else {
+		err = dec.Decode(out)
 	}
-	
-	return &orderResponse, nil
+	if err != nil {
+		return resp.StatusCode, 0, fmt.Errorf("%w: %w", ErrDecodeResponse, err)
+	}
+
+	return resp.StatusCode, 0, nil
 }
 
 // ConnectWebSocket 웹소켓 연결
+// permessage-deflate 압축을 요청하며, 서버가 거절하면 압축 없이 연결한다.
+// 기본 형식이 아니면 형식 지정이 포함된 배열 형태로 구독한다.
 func (c *UpbitClient) ConnectWebSocket(markets []string, types []string) (*websocket.Conn, error) {
 	dialer := websocket.Dialer{
-		Proxy:            http.ProxyFromEnvironment,
-		HandshakeTimeout: 45 * time.Second,
+		Proxy:             http.ProxyFromEnvironment,
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: true,
+		NetDialContext:    c.dialCounting,
 	}
 	
 	// 웹소켓 연결
-	conn, _, err := dialer.Dial(upbitWebSocketURL, nil)
+	conn, resp, err := dialer.Dial(c.wsURL, nil)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %w", ErrWebSocketConnect, err)
 	}
+	c.recordCompression(resp.Header.Get("Sec-WebSocket-Extensions"))
 	
 	// 구독 요청 생성
 	type TickerRequest struct {
@@ -548,15 +745,18 @@ func (c *UpbitClient) ConnectWebSocket(markets []string, types []string) (*webso
 	}
 	
 	for _, t := range types {
-		request := TickerRequest{
+		var request interface{} = TickerRequest{
 			Ticket: uuid.New().String(),
 			Type:   t,
 			Codes:  markets,
 		}
+		if c.wsFormat != WebSocketFormatDefault {
+			request = subscribeRequest(t, markets, c.wsFormat)
+		}
 		
 		if err := conn.WriteJSON(request); err != nil {
 			conn.Close()
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", ErrWebSocketSubscribe, err)
 		}
 	}
 	
@@ -564,14 +764,46 @@ func (c *UpbitClient) ConnectWebSocket(markets []string, types []string) (*webso
 }
 
 // MaintainWebSocketConnection 웹소켓 연결 유지
+// 마켓 수가 연결당 구독 한도를 넘으면 여러 연결로 나누어 구독하고,
+// 각 연결은 독립적인 백오프로 유지되며 데이터는 같은 채널로 모인다.
 func (c *UpbitClient) MaintainWebSocketConnection(markets []string, types []string, dataCh chan<- MarketData, done <-chan struct{}) {
+	go c.reportWebSocketBandwidth(done)
+
+	shards := shardMarkets(markets, c.wsMarketsPerConn)
+	if len(shards) <= 1 {
+		c.maintainShard(markets, types, dataCh, done)
+		return
+	}
+
+	c.logger.Info("웹소켓 구독 분할:", len(markets), "개 마켓,", len(shards), "개 연결")
+
+	var wg sync.WaitGroup
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard []string) {
+			defer wg.Done()
+			c.maintainShard(shard, types, dataCh, done)
+		}(shard)
+	}
+	wg.Wait()
+}
+
+// maintainShard 단일 웹소켓 연결 유지
+// 연결 후 구독이 확인되어야 백오프를 초기화하므로, 데이터를 보내지 않는 연결에 묶여 있지 않고 재연결한다.
+func (c *UpbitClient) maintainShard(markets []string, types []string, dataCh chan<- MarketData, done <-chan struct{}) {
 	backoff := initialBackoff
+	connected := false
 	
 	for {
 		select {
 		case <-done:
 			return
 		default:
+			if connected {
+				c.wsStats.reconnects.Add(1)
+			}
+			connected = true
+			
 			conn, err := c.ConnectWebSocket(markets, types)
 			if err != nil {
 				c.logger.Error("웹소켓 연결 실패:", err)
@@ -580,11 +812,30 @@ func (c *UpbitClient) MaintainWebSocketConnection(markets []string, types []stri
 				continue
 			}
 			
+			// 첫 데이터가 오지 않으면 구독이 실패한 연결로 보고 백오프 후 재연결
+			first, err := c.confirmSubscription(conn)
+			if err != nil {
+				c.logger.Error("웹소켓 구독 확인 실패:", err)
+				conn.Close()
+				time.Sleep(time.Duration(backoff) * time.Second)
+				backoff = min(backoff*2, maxBackoff)
+				continue
+			}
+			
 			// 연결 성공 시 백오프 리셋
 			backoff = initialBackoff
+			c.logger.Info("웹소켓 연결 및 구독 완료:", len(markets), "개 마켓")
+			
+			select {
+			case dataCh <- first:
+			case <-done:
+				conn.Close()
+				return
+			}
 			
 			// 웹소켓 데이터 처리
 			c.handleWebSocketConnection(conn, dataCh, done)
+			c.logger.Info("웹소켓 연결 종료:", len(markets), "개 마켓")
 		}
 	}
 }
@@ -594,9 +845,20 @@ func (c *UpbitClient) handleWebSocketConnection(conn *websocket.Conn, dataCh cha
 	defer conn.Close()
 	
 	// 핑 처리를 위한 타이머
-	pingTicker := time.NewTicker(30 * time.Second)
+	pingTicker := time.NewTicker(c.wsPingInterval)
 	defer pingTicker.Stop()
 	
+	// 퐁 수신 알림 (반쯤 열린 연결 감지용)
+	pongCh := make(chan struct{}, 1)
+	conn.SetPongHandler(func(string) error {
+		select {
+		case pongCh <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+	var pongDeadline <-chan time.Time
+	
 	// 고루틴으로 데이터 수신
 	dataDone := make(chan struct{})
 	
@@ -610,11 +872,12 @@ func (c *UpbitClient) handleWebSocketConnection(conn *websocket.Conn, dataCh cha
 				return
 			}
 			
-			var data MarketData
-			if err := json.Unmarshal(message, &data); err != nil {
+			data, err := parseWebSocketMessage(message)
+			if err != nil {
 				c.logger.Error("웹소켓 메시지 파싱 실패:", err)
 				continue
 			}
+			c.logger.Debug("웹소켓 수신:", data.Type, data.MarketID, data.TradePrice)
 			
 			dataCh <- data
 		}
@@ -635,8 +898,36 @@ func (c *UpbitClient) handleWebSocketConnection(conn *websocket.Conn, dataCh cha
 				c.logger.Error("웹소켓 핑 전송 실패:", err)
 				return
 			}
+			if pongDeadline == nil {
+				pongDeadline = time.After(c.wsPongTimeout)
+			}
+		case <-pongCh:
+			pongDeadline = nil
+		case <-pongDeadline:
+			// 제한 시간 내 퐁이 없으면 연결을 끊고 재연결
+			c.wsStats.missedPongs.Add(1)
+			c.logger.Warn("웹소켓 퐁 응답 시간 초과, 재연결:", c.wsPongTimeout)
+			return
+		}
+	}
+}
+
+// shardMarkets 마켓 목록을 연결당 한도 크기로 분할
+func shardMarkets(markets []string, size int) [][]string {
+	if size <= 0 || len(markets) <= size {
+		return [][]string{markets}
+	}
+
+	shards := make([][]string, 0, (len(markets)+size-1)/size)
+	for start := 0; start < len(markets); start += size {
+		end := start + size
+		if end > len(markets) {
+			end = len(markets)
 		}
+		shards = append(shards, markets[start:end])
 	}
+
+	return shards
 }
 
 // min 두 정수 중 작은 값 반환
@@ -0,0 +1,73 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRESTRequestStopsAtContextDeadline(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetAccounts(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("errors.Is(err, context.DeadlineExceeded) = false: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("요청이 컨텍스트 만료 후에도 %v 동안 대기함", elapsed)
+	}
+
+	// 호출자가 끊은 요청은 업비트 장애로 집계하지 않는다
+	if _, total := c.ErrorRate(); total != 0 {
+		t.Fatalf("오류율 집계 요청 수 = %d, want 0", total)
+	}
+}
+
+func TestCanceledContextSkipsOrderRequest(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"uuid":"order-1"}`))
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.CreateOrder(ctx, "KRW-BTC", "bid", "limit", 1, 100000); !errors.Is(err, context.Canceled) {
+		t.Fatalf("errors.Is(err, context.Canceled) = false: %v", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Fatalf("취소된 컨텍스트로 보낸 요청 수 = %d, want 0", n)
+	}
+}
+
+func TestContextCancelStopsRetryBackoff(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}), WithRetry(5, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetTicker(ctx, "KRW-BTC"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("errors.Is(err, context.DeadlineExceeded) = false: %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("요청 수 = %d, want 1 (백오프 중 취소)", n)
+	}
+}
@@ -0,0 +1,76 @@
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/indicator"
+)
+
+const (
+	defaultVolatilityTimeframe = "days"
+	defaultVolatilityPeriod    = 20
+	defaultVolatilityMaxScale  = 1.0
+)
+
+// volatilitySizeScale 마켓 변동성 대비 목표 변동성 배율
+// 포지션마다 변동성 기여가 비슷하도록 변동성이 큰 마켓은 진입 금액을 줄인다.
+// 저장된 캔들이 부족하면 배율을 조정하지 않는다.
+func (e *OrderExecutor) volatilitySizeScale(marketID string) (float64, error) {
+	cfg := e.cfg.VolatilitySizing
+	if !cfg.Enabled || cfg.VolTarget <= 0 {
+		return 1, nil
+	}
+
+	timeframe := cfg.Timeframe
+	if timeframe == "" {
+		timeframe = defaultVolatilityTimeframe
+	}
+	period := cfg.Period
+	if period <= 0 {
+		period = defaultVolatilityPeriod
+	}
+
+	var candles []model.Candlestick
+	err := e.db.Where("market_id = ? AND timeframe = ?", marketID, timeframe).
+		Order("timestamp DESC").
+		Limit(period + 1).
+		Find(&candles).Error
+	if err != nil {
+		return 0, fmt.Errorf("변동성 계산용 캔들 조회 실패: %w", err)
+	}
+	if len(candles) < period+1 {
+		return 1, nil
+	}
+
+	// 최신순으로 조회했으므로 시간순으로 되돌린다
+	closes := make([]float64, len(candles))
+	for i, candle := range candles {
+		closes[len(candles)-1-i] = candle.Close
+	}
+
+	volatility := indicator.StdDev(indicator.Returns(closes)) * 100
+	scale := volatilityScale(volatility, cfg.VolTarget, cfg.MaxScale)
+	if scale != 1 {
+		e.logger.Info("변동성 목표로 포지션 크기 조정:", marketID, volatility, scale)
+	}
+
+	return scale, nil
+}
+
+// volatilityScale 변동성(%)에 반비례하는 배율
+// 변동성이 0이면 배율을 정할 수 없으므로 1을 반환하고, 결과는 maxScale을 넘지 않는다.
+func volatilityScale(volatility, target, maxScale float64) float64 {
+	if volatility <= 0 || target <= 0 {
+		return 1
+	}
+	if maxScale <= 0 {
+		maxScale = defaultVolatilityMaxScale
+	}
+
+	scale := target / volatility
+	if scale > maxScale {
+		return maxScale
+	}
+	return scale
+}
@@ -0,0 +1,81 @@
+package exchange
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/indicator"
+)
+
+func TestVolatilityScale(t *testing.T) {
+	cases := []struct {
+		volatility, target, maxScale, want float64
+	}{
+		{4, 2, 0, 0.5},
+		{1, 2, 0, 1},
+		{1, 2, 1.5, 1.5},
+		{0.5, 2, 1.5, 1.5},
+		{0, 2, 0, 1},
+	}
+	for _, tc := range cases {
+		if got := volatilityScale(tc.volatility, tc.target, tc.maxScale); got != tc.want {
+			t.Fatalf("volatilityScale(%v, %v, %v) = %v, want %v", tc.volatility, tc.target, tc.maxScale, got, tc.want)
+		}
+	}
+}
+
+// storeDailyCandles 시간순 종가로 일봉 저장
+func storeDailyCandles(t *testing.T, e *OrderExecutor, marketID string, closes ...float64) {
+	t.Helper()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := make([]model.Candlestick, len(closes))
+	for i, c := range closes {
+		candles[i] = model.Candlestick{MarketID: marketID, Timeframe: "days", Timestamp: start.AddDate(0, 0, i), Open: c, High: c, Low: c, Close: c}
+	}
+	if err := e.db.Create(&candles).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEnterScalesToVolatilityTarget(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{
+		MaxPositionSize:  10,
+		VolatilitySizing: config.VolatilitySizingConfig{Enabled: true, VolTarget: 2, Period: 4},
+	})
+	closes := []float64{100, 110, 100, 110, 100}
+	storeDailyCandles(t, e, "KRW-BTC", closes...)
+
+	if err := e.enter(context.Background(), buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("매수 주문 실패: %v", err)
+	}
+
+	want := 100000 * 2 / (indicator.StdDev(indicator.Returns(closes)) * 100)
+	orders := client.createdOrders()
+	if len(orders) != 1 {
+		t.Fatalf("주문 요청 수 = %d, want 1", len(orders))
+	}
+	if amount := orders[0].Price * orders[0].Volume; math.Abs(amount-want) > 1 {
+		t.Fatalf("주문 금액 = %v, want %v", amount, want)
+	}
+}
+
+func TestEnterKeepsSizeWithoutEnoughCandles(t *testing.T) {
+	client := &fakeExchange{}
+	e, _ := newTestExecutor(t, client, config.TradingConfig{
+		MaxPositionSize:  10,
+		VolatilitySizing: config.VolatilitySizingConfig{Enabled: true, VolTarget: 2, Period: 4},
+	})
+	storeDailyCandles(t, e, "KRW-BTC", 100, 110, 100)
+
+	if err := e.enter(context.Background(), buySignal("KRW-BTC", 100000)); err != nil {
+		t.Fatalf("매수 주문 실패: %v", err)
+	}
+	if orders := client.createdOrders(); len(orders) != 1 || orders[0].Price*orders[0].Volume != 100000 {
+		t.Fatalf("주문 = %+v, want 100000원 매수", orders)
+	}
+}
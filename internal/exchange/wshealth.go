@@ -0,0 +1,101 @@
+package exchange
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultWSPingInterval = 30 * time.Second
+	defaultWSPongTimeout  = 10 * time.Second
+	wsBandwidthInterval   = time.Minute
+
+	// wsCompressionExtension 웹소켓 메시지 압축 확장 이름
+	wsCompressionExtension = "permessage-deflate"
+)
+
+// wsStats 웹소켓 연결 상태 카운터
+type wsStats struct {
+	missedPongs atomic.Int64
+	reconnects  atomic.Int64
+
+	bytesReceived atomic.Int64 // 압축·암호화된 상태로 실제 수신한 바이트 수
+	compressed    atomic.Bool  // 마지막 연결에서 압축 확장이 협상되었는지
+}
+
+// WebSocketStats 웹소켓 연결 상태 지표
+type WebSocketStats struct {
+	MissedPongs int64 `json:"missed_pongs"` // 핑 후 제한 시간 내 퐁을 받지 못한 횟수
+	Reconnects  int64 `json:"reconnects"`   // 연결이 끊겨 다시 연결한 횟수
+
+	BytesReceived int64 `json:"bytes_received"` // 실제 수신한 누적 바이트 수
+	Compressed    bool  `json:"compressed"`     // 메시지 압축 사용 여부
+}
+
+// WebSocketStats 웹소켓 연결 상태 지표 조회
+func (c *UpbitClient) WebSocketStats() WebSocketStats {
+	return WebSocketStats{
+		MissedPongs: c.wsStats.missedPongs.Load(),
+		Reconnects:  c.wsStats.reconnects.Load(),
+
+		BytesReceived: c.wsStats.bytesReceived.Load(),
+		Compressed:    c.wsStats.compressed.Load(),
+	}
+}
+
+// countingConn 수신 바이트 수를 세는 연결
+// TLS 아래 연결을 감싸므로 압축 여부가 반영된 실제 전송량을 센다.
+type countingConn struct {
+	net.Conn
+	received *atomic.Int64
+}
+
+// Read 수신 바이트 집계
+func (c countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.received.Add(int64(n))
+	return n, err
+}
+
+// dialCounting 수신량을 집계하는 웹소켓 연결 다이얼
+func (c *UpbitClient) dialCounting(ctx context.Context, network, addr string) (net.Conn, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	return countingConn{Conn: conn, received: &c.wsStats.bytesReceived}, nil
+}
+
+// recordCompression 압축 확장 협상 결과 기록
+// 서버가 압축을 거절하면 압축 없이 그대로 동작한다.
+func (c *UpbitClient) recordCompression(extensions string) {
+	compressed := strings.Contains(extensions, wsCompressionExtension)
+	c.wsStats.compressed.Store(compressed)
+	if compressed {
+		c.logger.Info("웹소켓 메시지 압축 사용:", extensions)
+	} else {
+		c.logger.Info("웹소켓 메시지 압축 미지원, 압축 없이 수신")
+	}
+}
+
+// reportWebSocketBandwidth 분당 웹소켓 수신량 기록
+func (c *UpbitClient) reportWebSocketBandwidth(done <-chan struct{}) {
+	ticker := time.NewTicker(wsBandwidthInterval)
+	defer ticker.Stop()
+
+	last := c.wsStats.bytesReceived.Load()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			total := c.wsStats.bytesReceived.Load()
+			c.logger.Debug("웹소켓 분당 수신량:", total-last, "bytes, 압축:", c.wsStats.compressed.Load())
+			last = total
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package exchange
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// newWebSocketServer 핑에 퐁으로 응답할지 정할 수 있는 웹소켓 테스트 서버에 연결
+func newWebSocketServer(t *testing.T, answerPings bool) *websocket.Conn {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if !answerPings {
+			conn.SetPingHandler(func(string) error { return nil })
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("웹소켓 연결 실패: %v", err)
+	}
+	return conn
+}
+
+func TestHandleWebSocketConnectionDropsOnMissedPong(t *testing.T) {
+	c := NewUpbitClient("access", "secret", WithWebSocketPongTimeout(50*time.Millisecond))
+	c.wsPingInterval = 20 * time.Millisecond
+	conn := newWebSocketServer(t, false)
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		c.handleWebSocketConnection(conn, make(chan MarketData), make(chan struct{}))
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(2 * time.Second):
+		t.Fatal("퐁 응답이 없는데 연결을 끊지 않음")
+	}
+	if missed := c.WebSocketStats().MissedPongs; missed != 1 {
+		t.Fatalf("MissedPongs = %d, want 1", missed)
+	}
+}
+
+func TestHandleWebSocketConnectionKeepsAnsweredConnection(t *testing.T) {
+	c := NewUpbitClient("access", "secret", WithWebSocketPongTimeout(50*time.Millisecond))
+	c.wsPingInterval = 20 * time.Millisecond
+	conn := newWebSocketServer(t, true)
+
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		c.handleWebSocketConnection(conn, make(chan MarketData), done)
+	}()
+
+	select {
+	case <-finished:
+		t.Fatal("퐁 응답이 오는 연결을 끊음")
+	case <-time.After(300 * time.Millisecond):
+	}
+	close(done)
+	<-finished
+
+	if missed := c.WebSocketStats().MissedPongs; missed != 0 {
+		t.Fatalf("MissedPongs = %d, want 0", missed)
+	}
+}
@@ -0,0 +1,240 @@
+package exchange
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// DataTypeTicker 웹소켓 현재가 메시지 유형
+	DataTypeTicker = "ticker"
+	// DataTypeTrade 웹소켓 체결 메시지 유형
+	DataTypeTrade = "trade"
+	// DataTypeOrderbook 웹소켓 호가 메시지 유형
+	DataTypeOrderbook = "orderbook"
+
+	// WebSocketFormatDefault 전체 필드 이름으로 받는 기본 메시지 형식
+	WebSocketFormatDefault = "DEFAULT"
+	// WebSocketFormatSimple 축약 필드 이름(ty, cd, tp 등)으로 받는 메시지 형식
+	WebSocketFormatSimple = "SIMPLE"
+)
+
+// simpleFieldNames SIMPLE 형식 축약 필드 이름과 기본 형식 필드 이름
+var simpleFieldNames = map[string]string{
+	"ty":     "type",
+	"cd":     "code",
+	"tms":    "timestamp",
+	"st":     "stream_type",
+	"tp":     "trade_price",
+	"tv":     "trade_volume",
+	"op":     "opening_price",
+	"hp":     "high_price",
+	"lp":     "low_price",
+	"pcp":    "prev_closing_price",
+	"scr":    "signed_change_rate",
+	"atv24h": "acc_trade_volume_24h",
+	"ab":     "ask_bid",
+	"ttms":   "trade_timestamp",
+	"sid":    "sequential_id",
+	"tas":    "total_ask_size",
+	"tbs":    "total_bid_size",
+	"obu":    "orderbook_units",
+	"ap":     "ask_price",
+	"bp":     "bid_price",
+	"as":     "ask_size",
+	"bs":     "bid_size",
+}
+
+// ErrUnknownMessageType 알 수 없는 웹소켓 메시지 유형
+var ErrUnknownMessageType = errors.New("알 수 없는 웹소켓 메시지 유형")
+
+// wsEnvelope 메시지 유형 판별용 공통 필드
+type wsEnvelope struct {
+	Type string `json:"type"`
+	Code string `json:"code"`
+}
+
+// wsTicker 웹소켓 현재가 메시지 (마켓 코드 필드가 REST 응답과 다름)
+type wsTicker struct {
+	Code              string  `json:"code"`
+	TradePrice        float64 `json:"trade_price"`
+	TradeVolume       float64 `json:"trade_volume"`
+	OpeningPrice      float64 `json:"opening_price"`
+	HighPrice         float64 `json:"high_price"`
+	LowPrice          float64 `json:"low_price"`
+	PrevClosingPrice  float64 `json:"prev_closing_price"`
+	SignedChangeRate  float64 `json:"signed_change_rate"`
+	AccTradeVolume24h float64 `json:"acc_trade_volume_24h"`
+	Timestamp         int64   `json:"timestamp"`
+}
+
+// wsTrade 웹소켓 체결 메시지
+type wsTrade struct {
+	Code             string  `json:"code"`
+	TradePrice       float64 `json:"trade_price"`
+	TradeVolume      float64 `json:"trade_volume"`
+	AskBid           string  `json:"ask_bid"`
+	PrevClosingPrice float64 `json:"prev_closing_price"`
+	TradeTimestamp   int64   `json:"trade_timestamp"`
+	SequentialID     int64   `json:"sequential_id"`
+	Timestamp        int64   `json:"timestamp"`
+}
+
+// wsOrderbook 웹소켓 호가 메시지 (마켓 코드 필드가 REST 응답과 다름)
+type wsOrderbook struct {
+	Code         string          `json:"code"`
+	Timestamp    int64           `json:"timestamp"`
+	TotalAskSize float64         `json:"total_ask_size"`
+	TotalBidSize float64         `json:"total_bid_size"`
+	Units        []OrderbookUnit `json:"orderbook_units"`
+}
+
+// parseWebSocketMessage 웹소켓 메시지를 시장 데이터로 변환
+// type 필드만 먼저 읽어 메시지 종류별 구조체로 파싱하며, MarketData는 Type을 태그로 하는 합 타입으로
+// 공통 필드(가격, 수량)와 함께 종류별 상세(Ticker, Trade, Orderbook) 중 하나만 채워진다.
+func parseWebSocketMessage(message []byte) (MarketData, error) {
+	message, err := expandSimpleFormat(message)
+	if err != nil {
+		return MarketData{}, err
+	}
+
+	var envelope wsEnvelope
+	if err := json.Unmarshal(message, &envelope); err != nil {
+		return MarketData{}, err
+	}
+
+	switch envelope.Type {
+	case DataTypeTicker:
+		return parseTickerMessage(message)
+	case DataTypeTrade:
+		return parseTradeMessage(message)
+	case DataTypeOrderbook:
+		return parseOrderbookMessage(message)
+	}
+
+	return MarketData{}, fmt.Errorf("%w: %q", ErrUnknownMessageType, envelope.Type)
+}
+
+// parseTickerMessage 현재가 메시지 파싱
+func parseTickerMessage(message []byte) (MarketData, error) {
+	var ticker wsTicker
+	if err := json.Unmarshal(message, &ticker); err != nil {
+		return MarketData{}, err
+	}
+
+	return MarketData{
+		Type:        DataTypeTicker,
+		MarketID:    ticker.Code,
+		Timestamp:   ticker.Timestamp,
+		TradePrice:  ticker.TradePrice,
+		TradeVolume: ticker.TradeVolume,
+		Ticker: &Ticker{
+			MarketID:          ticker.Code,
+			TradePrice:        ticker.TradePrice,
+			OpeningPrice:      ticker.OpeningPrice,
+			HighPrice:         ticker.HighPrice,
+			LowPrice:          ticker.LowPrice,
+			PrevClosingPrice:  ticker.PrevClosingPrice,
+			SignedChangeRate:  ticker.SignedChangeRate,
+			AccTradeVolume24h: ticker.AccTradeVolume24h,
+			Timestamp:         ticker.Timestamp,
+		},
+	}, nil
+}
+
+// parseTradeMessage 체결 메시지 파싱
+func parseTradeMessage(message []byte) (MarketData, error) {
+	var trade wsTrade
+	if err := json.Unmarshal(message, &trade); err != nil {
+		return MarketData{}, err
+	}
+
+	return MarketData{
+		Type:        DataTypeTrade,
+		MarketID:    trade.Code,
+		Timestamp:   trade.Timestamp,
+		TradePrice:  trade.TradePrice,
+		TradeVolume: trade.TradeVolume,
+		Trade: &TickTrade{
+			MarketID:         trade.Code,
+			Timestamp:        trade.TradeTimestamp,
+			TradePrice:       trade.TradePrice,
+			TradeVolume:      trade.TradeVolume,
+			PrevClosingPrice: trade.PrevClosingPrice,
+			AskBid:           trade.AskBid,
+			SequentialID:     trade.SequentialID,
+		},
+	}, nil
+}
+
+// parseOrderbookMessage 호가 메시지 파싱
+// 평평한 MarketData에 담기지 않는 호가 단위 목록은 Orderbook으로 함께 담는다.
+func parseOrderbookMessage(message []byte) (MarketData, error) {
+	var orderbook wsOrderbook
+	if err := json.Unmarshal(message, &orderbook); err != nil {
+		return MarketData{}, err
+	}
+
+	data := MarketData{
+		Type:      DataTypeOrderbook,
+		MarketID:  orderbook.Code,
+		Timestamp: orderbook.Timestamp,
+		Orderbook: &Orderbook{
+			MarketID:     orderbook.Code,
+			Timestamp:    orderbook.Timestamp,
+			TotalAskSize: orderbook.TotalAskSize,
+			TotalBidSize: orderbook.TotalBidSize,
+			Units:        orderbook.Units,
+		},
+	}
+	if len(orderbook.Units) > 0 {
+		data.Bid = orderbook.Units[0].BidPrice
+		data.Ask = orderbook.Units[0].AskPrice
+		data.BidVolume = orderbook.Units[0].BidSize
+		data.AskVolume = orderbook.Units[0].AskSize
+	}
+
+	return data, nil
+}
+
+// expandSimpleFormat SIMPLE 형식 메시지를 기본 형식 필드 이름으로 변환
+// 축약된 type 필드(ty)가 없으면 기본 형식으로 보고 그대로 돌려준다.
+func expandSimpleFormat(message []byte) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(message, &fields); err != nil {
+		return nil, err
+	}
+	if _, ok := fields["ty"]; !ok {
+		return message, nil
+	}
+
+	if units, ok := fields["obu"]; ok {
+		var simpleUnits []map[string]json.RawMessage
+		if err := json.Unmarshal(units, &simpleUnits); err != nil {
+			return nil, err
+		}
+		for i, unit := range simpleUnits {
+			simpleUnits[i] = renameSimpleFields(unit)
+		}
+		expanded, err := json.Marshal(simpleUnits)
+		if err != nil {
+			return nil, err
+		}
+		fields["obu"] = expanded
+	}
+
+	return json.Marshal(renameSimpleFields(fields))
+}
+
+// renameSimpleFields 축약 필드 이름을 기본 이름으로 변경 (모르는 필드는 그대로 둠)
+func renameSimpleFields(fields map[string]json.RawMessage) map[string]json.RawMessage {
+	renamed := make(map[string]json.RawMessage, len(fields))
+	for key, value := range fields {
+		if name, ok := simpleFieldNames[key]; ok {
+			key = name
+		}
+		renamed[key] = value
+	}
+	return renamed
+}
@@ -0,0 +1,90 @@
+package exchange
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// 업비트 웹소켓에서 받은 메시지 (필드 일부 생략)
+const (
+	tickerFrame    = `{"type":"ticker","code":"KRW-BTC","opening_price":31883000,"high_price":32310000,"low_price":31855000,"trade_price":32287000,"prev_closing_price":31883000,"signed_change_rate":0.0126713295,"trade_volume":0.03103806,"acc_trade_volume_24h":2429.58834336,"timestamp":1676965262177,"stream_type":"REALTIME"}`
+	tradeFrame     = `{"type":"trade","code":"KRW-BTC","timestamp":1676965262139,"trade_timestamp":1676965262125,"trade_price":32287000,"trade_volume":0.03103806,"ask_bid":"BID","prev_closing_price":31883000,"sequential_id":1676965262125000,"stream_type":"REALTIME"}`
+	orderbookFrame = `{"type":"orderbook","code":"KRW-BTC","timestamp":1676965262139,"total_ask_size":4.79158413,"total_bid_size":2.65609625,"orderbook_units":[{"ask_price":32289000,"bid_price":32287000,"ask_size":0.2,"bid_size":0.5},{"ask_price":32290000,"bid_price":32286000,"ask_size":1.1,"bid_size":0.3}],"stream_type":"REALTIME"}`
+	simpleTicker   = `{"ty":"ticker","cd":"KRW-ETH","tp":2200000,"tv":0.5,"tms":1676965262177,"st":"REALTIME"}`
+)
+
+func TestParseWebSocketMessageByType(t *testing.T) {
+	ticker, err := parseWebSocketMessage([]byte(tickerFrame))
+	if err != nil {
+		t.Fatalf("현재가 메시지 파싱 실패: %v", err)
+	}
+	if ticker.Type != DataTypeTicker || ticker.Ticker == nil || ticker.Trade != nil || ticker.Orderbook != nil {
+		t.Fatalf("현재가 메시지 = %+v, want Ticker만 채워짐", ticker)
+	}
+	if ticker.MarketID != "KRW-BTC" || ticker.Ticker.TradePrice != 32287000 || ticker.Ticker.HighPrice != 32310000 || ticker.Ticker.AccTradeVolume24h != 2429.58834336 {
+		t.Fatalf("현재가 = %+v", ticker.Ticker)
+	}
+
+	trade, err := parseWebSocketMessage([]byte(tradeFrame))
+	if err != nil {
+		t.Fatalf("체결 메시지 파싱 실패: %v", err)
+	}
+	if trade.Type != DataTypeTrade || trade.Trade == nil || trade.Ticker != nil || trade.Orderbook != nil {
+		t.Fatalf("체결 메시지 = %+v, want Trade만 채워짐", trade)
+	}
+	if trade.Trade.AskBid != "BID" || trade.Trade.Timestamp != 1676965262125 || trade.Trade.SequentialID != 1676965262125000 || trade.TradeVolume != 0.03103806 {
+		t.Fatalf("체결 = %+v", trade.Trade)
+	}
+
+	orderbook, err := parseWebSocketMessage([]byte(orderbookFrame))
+	if err != nil {
+		t.Fatalf("호가 메시지 파싱 실패: %v", err)
+	}
+	if orderbook.Type != DataTypeOrderbook || orderbook.Orderbook == nil || orderbook.Ticker != nil || orderbook.Trade != nil {
+		t.Fatalf("호가 메시지 = %+v, want Orderbook만 채워짐", orderbook)
+	}
+	if len(orderbook.Orderbook.Units) != 2 || orderbook.Bid != 32287000 || orderbook.Ask != 32289000 || orderbook.BidVolume != 0.5 || orderbook.AskVolume != 0.2 {
+		t.Fatalf("호가 = %+v, 최우선 호가 %v/%v", orderbook.Orderbook, orderbook.Bid, orderbook.Ask)
+	}
+}
+
+func TestParseWebSocketMessageExpandsSimpleFormat(t *testing.T) {
+	data, err := parseWebSocketMessage([]byte(simpleTicker))
+	if err != nil {
+		t.Fatalf("SIMPLE 메시지 파싱 실패: %v", err)
+	}
+	if data.Type != DataTypeTicker || data.MarketID != "KRW-ETH" || data.TradePrice != 2200000 || data.Timestamp != 1676965262177 {
+		t.Fatalf("SIMPLE 현재가 = %+v, want KRW-ETH 2200000", data)
+	}
+}
+
+func TestParseWebSocketMessageRejectsUnknownType(t *testing.T) {
+	if _, err := parseWebSocketMessage([]byte(`{"type":"myOrder","code":"KRW-BTC"}`)); !errors.Is(err, ErrUnknownMessageType) {
+		t.Fatalf("알 수 없는 유형 오류 = %v, want ErrUnknownMessageType", err)
+	}
+}
+
+func TestWebSocketRoutesEachMessageType(t *testing.T) {
+	server := newSubscribeServer(t, tickerFrame, tradeFrame, orderbookFrame)
+	c := NewUpbitClient("access", "secret")
+	c.wsURL = server.url
+
+	dataCh := make(chan MarketData, 3)
+	done := make(chan struct{})
+	defer close(done)
+	go c.MaintainWebSocketConnection([]string{"KRW-BTC"}, []string{DataTypeTicker, DataTypeTrade, DataTypeOrderbook}, dataCh, done)
+
+	received := make(map[string]MarketData)
+	for len(received) < 3 {
+		select {
+		case data := <-dataCh:
+			received[data.Type] = data
+		case <-time.After(2 * time.Second):
+			t.Fatalf("수신한 메시지 유형 = %d개, want 3", len(received))
+		}
+	}
+	if received[DataTypeTicker].Ticker == nil || received[DataTypeTrade].Trade == nil || received[DataTypeOrderbook].Orderbook == nil {
+		t.Fatalf("유형별 상세가 비어 있음: %+v", received)
+	}
+}
@@ -0,0 +1,73 @@
+package exchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+// defaultWSSubscribeTimeout 구독 후 첫 데이터 수신 제한 시간
+const defaultWSSubscribeTimeout = 10 * time.Second
+
+// wsControlFrame 업비트 웹소켓 상태/오류 메시지
+type wsControlFrame struct {
+	Status string `json:"status"`
+	Error  *struct {
+		Name    string `json:"name"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// subscribeRequest 메시지 형식을 지정한 구독 요청
+// 업비트 구독 요청은 [티켓, 유형, 형식] 순서의 배열이다.
+func subscribeRequest(dataType string, markets []string, format string) []map[string]interface{} {
+	return []map[string]interface{}{
+		{"ticket": uuid.New().String()},
+		{"type": dataType, "codes": markets},
+		{"format": format},
+	}
+}
+
+// confirmSubscription 구독 확인
+// 구독 요청 직후 업비트는 구독한 마켓의 스냅샷을 바로 보내므로, 제한 시간 안에 유효한 데이터 메시지가 오지 않으면
+// 구독이 실패한 연결로 본다. 상태 메시지({"status":"UP"})는 건너뛰고, 오류 메시지는 바로 실패로 처리한다.
+// 확인에 쓴 첫 데이터는 버리지 않도록 돌려준다.
+func (c *UpbitClient) confirmSubscription(conn *websocket.Conn) (MarketData, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(c.wsSubscribeTimeout)); err != nil {
+		return MarketData{}, fmt.Errorf("%w: %w", ErrWebSocketSubscribe, err)
+	}
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return MarketData{}, fmt.Errorf("%w: 첫 메시지 수신 실패: %w", ErrWebSocketSubscribe, err)
+		}
+
+		var frame wsControlFrame
+		if err := json.Unmarshal(message, &frame); err != nil {
+			return MarketData{}, fmt.Errorf("%w: %w", ErrWebSocketSubscribe, err)
+		}
+		if frame.Error != nil {
+			return MarketData{}, fmt.Errorf("%w: %s: %s", ErrWebSocketSubscribe, frame.Error.Name, frame.Error.Message)
+		}
+		if frame.Status != "" {
+			continue
+		}
+
+		data, err := parseWebSocketMessage(message)
+		if err != nil {
+			return MarketData{}, fmt.Errorf("%w: %w", ErrWebSocketSubscribe, err)
+		}
+		if data.Type == "" || data.MarketID == "" {
+			return MarketData{}, fmt.Errorf("%w: 알 수 없는 메시지: %s", ErrWebSocketSubscribe, message)
+		}
+
+		if err := conn.SetReadDeadline(time.Time{}); err != nil {
+			return MarketData{}, fmt.Errorf("%w: %w", ErrWebSocketSubscribe, err)
+		}
+		return data, nil
+	}
+}
@@ -0,0 +1,142 @@
+package exchange
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// subscribeServer 구독 요청을 받은 뒤 replies를 보내고 연결을 유지하는 웹소켓 테스트 서버
+// 연결 수를 세며, 주소는 ws:// 형식이다.
+type subscribeServer struct {
+	url         string
+	connections int32
+}
+
+func newSubscribeServer(t *testing.T, replies ...string) *subscribeServer {
+	t.Helper()
+
+	s := &subscribeServer{}
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		atomic.AddInt32(&s.connections, 1)
+
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		for _, reply := range replies {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(reply)); err != nil {
+				return
+			}
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	s.url = "ws" + strings.TrimPrefix(server.URL, "http")
+	return s
+}
+
+// dial 테스트 서버에 연결하고 구독 요청 전송
+func (s *subscribeServer) dial(t *testing.T, c *UpbitClient) *websocket.Conn {
+	t.Helper()
+	c.wsURL = s.url
+	conn, err := c.ConnectWebSocket([]string{"KRW-BTC"}, []string{DataTypeTicker})
+	if err != nil {
+		t.Fatalf("웹소켓 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestConfirmSubscriptionSkipsStatusFrame(t *testing.T) {
+	server := newSubscribeServer(t, `{"status":"UP"}`, `{"type":"ticker","code":"KRW-BTC","trade_price":100}`)
+	c := NewUpbitClient("access", "secret")
+	conn := server.dial(t, c)
+
+	first, err := c.confirmSubscription(conn)
+	if err != nil {
+		t.Fatalf("confirmSubscription 오류: %v", err)
+	}
+	if first.Type != DataTypeTicker || first.MarketID != "KRW-BTC" || first.TradePrice != 100 {
+		t.Fatalf("첫 데이터 = %+v, want KRW-BTC 현재가 100", first)
+	}
+}
+
+func TestConfirmSubscriptionFailsOnErrorFrame(t *testing.T) {
+	server := newSubscribeServer(t, `{"error":{"name":"INVALID_AUTH","message":"인증 실패"}}`)
+	c := NewUpbitClient("access", "secret")
+	conn := server.dial(t, c)
+
+	_, err := c.confirmSubscription(conn)
+	if !errors.Is(err, ErrWebSocketSubscribe) || !strings.Contains(err.Error(), "INVALID_AUTH") {
+		t.Fatalf("confirmSubscription 오류 = %v, want INVALID_AUTH 구독 실패", err)
+	}
+}
+
+func TestConfirmSubscriptionTimesOutWithoutData(t *testing.T) {
+	server := newSubscribeServer(t)
+	c := NewUpbitClient("access", "secret", WithWebSocketSubscribeTimeout(50*time.Millisecond))
+	conn := server.dial(t, c)
+
+	started := time.Now()
+	if _, err := c.confirmSubscription(conn); !errors.Is(err, ErrWebSocketSubscribe) {
+		t.Fatalf("confirmSubscription 오류 = %v, want ErrWebSocketSubscribe", err)
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Fatalf("구독 확인 대기 %s, want 제한 시간 후 실패", elapsed)
+	}
+}
+
+func TestMaintainWebSocketReconnectsWhenNoDataArrives(t *testing.T) {
+	server := newSubscribeServer(t)
+	c := NewUpbitClient("access", "secret", WithWebSocketSubscribeTimeout(50*time.Millisecond))
+	c.wsURL = server.url
+
+	done := make(chan struct{})
+	defer close(done)
+	go c.MaintainWebSocketConnection([]string{"KRW-BTC"}, []string{DataTypeTicker}, make(chan MarketData), done)
+
+	deadline := time.Now().Add(3 * time.Second)
+	for atomic.LoadInt32(&server.connections) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("연결 %d번, want 데이터가 없으면 재연결", atomic.LoadInt32(&server.connections))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMaintainWebSocketDeliversConfirmedFirstMessage(t *testing.T) {
+	server := newSubscribeServer(t, `{"type":"ticker","code":"KRW-BTC","trade_price":100}`)
+	c := NewUpbitClient("access", "secret")
+	c.wsURL = server.url
+
+	dataCh := make(chan MarketData, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go c.MaintainWebSocketConnection([]string{"KRW-BTC"}, []string{DataTypeTicker}, dataCh, done)
+
+	select {
+	case data := <-dataCh:
+		if data.MarketID != "KRW-BTC" || data.TradePrice != 100 {
+			t.Fatalf("수신 데이터 = %+v, want 구독 확인에 쓴 첫 메시지", data)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("구독 확인 후 첫 데이터가 전달되지 않음")
+	}
+}
@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "upbit_bot"
+
+// Metrics 봇 운영 지표 (Prometheus)
+// 등록할 레지스트리를 주입받으므로 테스트에서는 별도 레지스트리로 만들어 값을 확인할 수 있다.
+// nil *Metrics의 메서드는 아무것도 하지 않으므로 지표를 쓰지 않는 구성에서는 nil을 그대로 넘긴다.
+type Metrics struct {
+	orders       *prometheus.CounterVec
+	orderLatency prometheus.Histogram
+	openPosition prometheus.Gauge
+	equity       prometheus.Gauge
+	dailyProfit  prometheus.Gauge
+	apiErrors    *prometheus.CounterVec
+}
+
+// 주문 지표 결과 (orders_total의 result 라벨)
+const (
+	OrderPlaced    = "placed"
+	OrderFilled    = "filled"
+	OrderCancelled = "cancelled"
+	OrderRejected  = "rejected"
+)
+
+// New 지표를 만들어 레지스트리에 등록
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		orders: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "orders_total",
+			Help:      "Orders by result (placed, filled, cancelled, rejected), market and side.",
+		}, []string{"result", "market", "side"}),
+		orderLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "order_round_trip_seconds",
+			Help:      "Time from sending an order request to the exchange response.",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}),
+		openPosition: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "open_positions",
+			Help:      "Number of open positions.",
+		}),
+		equity: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "equity_krw",
+			Help:      "Mark-to-market equity in KRW (KRW balance plus open positions at last price).",
+		}),
+		dailyProfit: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "daily_realized_profit_krw",
+			Help:      "Realized profit for the current KST trading day in KRW, net of fees.",
+		}),
+		apiErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upbit_api_errors_total",
+			Help:      "Upbit REST API errors by error name.",
+		}, []string{"name"}),
+	}
+
+	reg.MustRegister(m.orders, m.orderLatency, m.openPosition, m.equity, m.dailyProfit, m.apiErrors)
+	return m
+}
+
+// Order 주문 결과 집계 (side는 BUY, SELL 또는 bid, ask)
+func (m *Metrics) Order(result, market, side string) {
+	if m == nil {
+		return
+	}
+	m.orders.WithLabelValues(result, market, side).Inc()
+}
+
+// ObserveOrderLatency 주문 요청 왕복 시간 기록
+func (m *Metrics) ObserveOrderLatency(d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.orderLatency.Observe(d.Seconds())
+}
+
+// SetOpenPositions 열린 포지션 수 설정
+func (m *Metrics) SetOpenPositions(n int) {
+	if m == nil {
+		return
+	}
+	m.openPosition.Set(float64(n))
+}
+
+// SetEquity 시가 평가 자산 설정
+func (m *Metrics) SetEquity(equity float64) {
+	if m == nil {
+		return
+	}
+	m.equity.Set(equity)
+}
+
+// SetDailyProfit 당일 실현 손익 설정
+func (m *Metrics) SetDailyProfit(profit float64) {
+	if m == nil {
+		return
+	}
+	m.dailyProfit.Set(profit)
+}
+
+// APIError 업비트 API 오류 집계
+func (m *Metrics) APIError(name string) {
+	if m == nil {
+		return
+	}
+	m.apiErrors.WithLabelValues(name).Inc()
+}
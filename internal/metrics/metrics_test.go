@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// histogramCount 레지스트리에서 히스토그램 표본 수 조회
+func histogramCount(t *testing.T, reg prometheus.Gatherer, name string) uint64 {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("지표 수집 실패: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() == name {
+			return family.GetMetric()[0].GetHistogram().GetSampleCount()
+		}
+	}
+	t.Fatalf("%s 지표 없음", name)
+	return 0
+}
+
+func TestMetricsRecordToInjectedRegistry(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := New(reg)
+
+	m.Order(OrderPlaced, "KRW-BTC", "BUY")
+	m.Order(OrderPlaced, "KRW-BTC", "BUY")
+	m.Order(OrderRejected, "KRW-ETH", "SELL")
+	m.ObserveOrderLatency(120 * time.Millisecond)
+	m.SetOpenPositions(3)
+	m.SetEquity(1500000)
+	m.SetDailyProfit(-25000)
+	m.APIError("invalid_query_payload")
+
+	if got := testutil.ToFloat64(m.orders.WithLabelValues(OrderPlaced, "KRW-BTC", "BUY")); got != 2 {
+		t.Fatalf("placed 주문 수 = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(m.orders.WithLabelValues(OrderRejected, "KRW-ETH", "SELL")); got != 1 {
+		t.Fatalf("rejected 주문 수 = %v, want 1", got)
+	}
+	if got := histogramCount(t, reg, "upbit_bot_order_round_trip_seconds"); got != 1 {
+		t.Fatalf("주문 왕복 시간 표본 수 = %d, want 1", got)
+	}
+	if got := testutil.ToFloat64(m.openPosition); got != 3 {
+		t.Fatalf("열린 포지션 수 = %v, want 3", got)
+	}
+	if got := testutil.ToFloat64(m.equity); got != 1500000 {
+		t.Fatalf("자산 = %v, want 1500000", got)
+	}
+	if got := testutil.ToFloat64(m.dailyProfit); got != -25000 {
+		t.Fatalf("당일 실현 손익 = %v, want -25000", got)
+	}
+	if got := testutil.ToFloat64(m.apiErrors.WithLabelValues("invalid_query_payload")); got != 1 {
+		t.Fatalf("API 오류 수 = %v, want 1", got)
+	}
+
+	// 레지스트리마다 따로 등록되므로 테스트끼리 값이 섞이지 않는다
+	other := New(prometheus.NewRegistry())
+	if got := testutil.ToFloat64(other.orders.WithLabelValues(OrderPlaced, "KRW-BTC", "BUY")); got != 0 {
+		t.Fatalf("새 레지스트리의 placed 주문 수 = %v, want 0", got)
+	}
+}
+
+func TestNilMetricsIsNoop(t *testing.T) {
+	var m *Metrics
+	m.Order(OrderPlaced, "KRW-BTC", "BUY")
+	m.ObserveOrderLatency(time.Second)
+	m.SetOpenPositions(1)
+	m.SetEquity(1)
+	m.SetDailyProfit(1)
+	m.APIError("x")
+}
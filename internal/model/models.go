@@ -7,19 +7,22 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 // Candlestick 캔들스틱 데이터
 type Candlestick struct {
 	gorm.Model
-	MarketID  string    `gorm:"column:market_id;not null;index:idx_market_timeframe_timestamp,priority:1"`
-	Timeframe string    `gorm:"column:timeframe;not null;index:idx_market_timeframe_timestamp,priority:2"`
-	Timestamp time.Time `gorm:"column:timestamp;not null;index:idx_market_timeframe_timestamp,priority:3"`
+	MarketID  string    `gorm:"column:market_id;not null;uniqueIndex:idx_candles_market_timeframe_timestamp,priority:1"`
+	Timeframe string    `gorm:"column:timeframe;not null;uniqueIndex:idx_candles_market_timeframe_timestamp,priority:2"` // seconds, minutes/N, days, weeks, months
+	Timestamp time.Time `gorm:"column:timestamp;not null;uniqueIndex:idx_candles_market_timeframe_timestamp,priority:3"`
 	Open      float64   `gorm:"column:open;not null"`
 	High      float64   `gorm:"column:high;not null"`
 	Low       float64   `gorm:"column:low;not null"`
 	Close     float64   `gorm:"column:close;not null"`
 	Volume    float64   `gorm:"column:volume;not null"`
+
+	Provisional bool `gorm:"-" json:"provisional,omitempty"` // 아직 마감되지 않은 캔들 (저장하지 않음)
 }
 
 // TableName Candlestick 테이블 이름 설정
@@ -28,33 +31,69 @@ func (Candlestick) TableName() string {
 }
 
 // Parameters JSONB 타입 정의
+// PostgreSQL에서는 jsonb, JSON 타입이 없는 SQLite에서는 TEXT 컬럼에 JSON 문자열로 저장한다.
 type Parameters map[string]interface{}
 
+// GormDBDataType 드라이버별 컬럼 타입
+func (Parameters) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	if db.Dialector.Name() == "sqlite" {
+		return "TEXT"
+	}
+	return "jsonb"
+}
+
+// JSONText JSON 문서를 그대로 담는 문자열 컬럼
+type JSONText string
+
+// GormDBDataType 드라이버별 컬럼 타입
+func (JSONText) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return Parameters(nil).GormDBDataType(db, field)
+}
+
 // Value JSONB 데이터베이스 인코딩
 func (p Parameters) Value() (driver.Value, error) {
-	return json.Marshal(p)
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
 }
 
 // Scan JSONB 데이터베이스 디코딩
+// 드라이버에 따라 []byte 또는 string으로 읽히며, NULL이나 JSON null은 빈 맵으로 읽는다.
 func (p *Parameters) Scan(value interface{}) error {
-	bytes, ok := value.([]byte)
-	if !ok {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*p = Parameters{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
 		return errors.New("failed to unmarshal JSONB value")
 	}
 
-	return json.Unmarshal(bytes, &p)
+	if err := json.Unmarshal(data, p); err != nil {
+		return err
+	}
+	if *p == nil {
+		*p = Parameters{}
+	}
+	return nil
 }
 
 // Signal 매매 신호
 type Signal struct {
 	gorm.Model
-	MarketID     string     `gorm:"column:market_id;not null;index:idx_market_timestamp,priority:1"`
+	MarketID     string     `gorm:"column:market_id;not null;index:idx_signals_market_timestamp,priority:1"`
 	StrategyName string     `gorm:"column:strategy_name;not null"`
 	SignalType   string     `gorm:"column:signal_type;not null"` // BUY, SELL
 	Price        float64    `gorm:"column:price;not null"`
 	Confidence   float64    `gorm:"column:confidence;not null"`
-	Timestamp    time.Time  `gorm:"column:timestamp;not null;index:idx_market_timestamp,priority:2"`
-	Parameters   Parameters `gorm:"column:parameters;type:jsonb"`
+	Timestamp    time.Time  `gorm:"column:timestamp;not null;index:idx_signals_market_timestamp,priority:2"`
+	Parameters   Parameters `gorm:"column:parameters"`
 }
 
 // TableName Signal 테이블 이름 설정
@@ -75,6 +114,7 @@ type Order struct {
 	Status         string    `gorm:"column:status;not null;index:idx_market_status,priority:2"` // WAIT, DONE, CANCEL
 	SignalID       uint      `gorm:"column:signal_id"`
 	LastUpdated    time.Time `gorm:"column:last_updated"`
+	Reason         string    `gorm:"column:reason"` // 취소 사유 (잔고 부족 거부, 미체결 시간 초과 등)
 }
 
 // TableName Order 테이블 이름 설정
@@ -85,13 +125,14 @@ func (Order) TableName() string {
 // Trade 체결 내역
 type Trade struct {
 	gorm.Model
-	MarketID  string    `gorm:"column:market_id;not null;index:idx_market_timestamp,priority:1"`
+	MarketID  string    `gorm:"column:market_id;not null;index:idx_trades_market_timestamp,priority:1"`
 	OrderID   string    `gorm:"column:order_id;not null;index"`
+	TradeID   string    `gorm:"column:trade_id;index"` // 거래소 체결 UUID (같은 체결을 두 번 기록하지 않도록 확인)
 	Price     float64   `gorm:"column:price;not null"`
 	Volume    float64   `gorm:"column:volume;not null"`
 	Side      string    `gorm:"column:side;not null"` // BUY, SELL
 	Fee       float64   `gorm:"column:fee;not null"`
-	Timestamp time.Time `gorm:"column:timestamp;not null;index:idx_market_timestamp,priority:2"`
+	Timestamp time.Time `gorm:"column:timestamp;not null;index:idx_trades_market_timestamp,priority:2"`
 }
 
 // TableName Trade 테이블 이름 설정
@@ -110,10 +151,17 @@ type Position struct {
 	ProfitTarget  float64   `gorm:"column:profit_target;not null"`
 	StopLoss      float64   `gorm:"column:stop_loss;not null"`
 	LastPrice     float64   `gorm:"column:last_price;not null"`
-	CurrentProfit float64   `gorm:"column:current_profit"`
+	CurrentProfit float64   `gorm:"column:current_profit"` // 실현 손익 (KRW, 매수/매도 수수료 차감)
 	ExitPrice     float64   `gorm:"column:exit_price"`
 	ExitTime      time.Time `gorm:"column:exit_time"`
-	ExitReason    string    `gorm:"column:exit_reason"` // TARGET, STOP, MANUAL, SIGNAL
+	ExitReason    string    `gorm:"column:exit_reason"` // TARGET, STOP, MANUAL, SIGNAL, RECONCILED, DRAWDOWN
+
+	TrailingStopPercent float64 `gorm:"column:trailing_stop_percent"` // 최고가 대비 추적 손절 폭 (%, 0이면 위험 관리 설정값)
+	HighestPrice        float64 `gorm:"column:highest_price"`         // 진입 후 최고가 (추적 손절 기준)
+
+	EntryFee      float64 `gorm:"column:entry_fee;default:0"`     // 보유 수량에 해당하는 매수 수수료 (KRW)
+	RealizedCost  float64 `gorm:"column:realized_cost;default:0"` // 매도한 수량의 매수 원가와 매수 수수료 (KRW)
+	ProfitPercent float64 `gorm:"column:profit_percent"`          // 실현 수익률 (%, 실현 손익 / 매수 원가)
 }
 
 // TableName Position 테이블 이름 설정
@@ -124,13 +172,13 @@ func (Position) TableName() string {
 // StrategyConfig 전략 설정
 type StrategyConfig struct {
 	gorm.Model
-	MarketID     string     `gorm:"column:market_id;not null;uniqueIndex"`
-	StrategyName string     `gorm:"column:strategy_name;not null"`
+	MarketID     string     `gorm:"column:market_id;not null;uniqueIndex:idx_strategy_market_name"`
+	StrategyName string     `gorm:"column:strategy_name;not null;uniqueIndex:idx_strategy_market_name"`
 	Timeframe    string     `gorm:"column:timeframe;not null"`
 	ProfitTarget float64    `gorm:"column:profit_target;not null"`
 	StopLoss     float64    `gorm:"column:stop_loss;not null"`
 	Enabled      bool       `gorm:"column:enabled;not null;default:true"`
-	Parameters   Parameters `gorm:"column:parameters;type:jsonb"`
+	Parameters   Parameters `gorm:"column:parameters"`
 }
 
 // TableName StrategyConfig 테이블 이름 설정
@@ -172,3 +220,76 @@ type DailyPerformance struct {
 func (DailyPerformance) TableName() string {
 	return "daily_performances"
 }
+
+// EquitySnapshot 총 자산 스냅샷
+type EquitySnapshot struct {
+	gorm.Model
+	Timestamp time.Time `gorm:"column:timestamp;not null;index"`
+	Equity    float64   `gorm:"column:equity;not null"`
+}
+
+// TableName EquitySnapshot 테이블 이름 설정
+func (EquitySnapshot) TableName() string {
+	return "equity_snapshots"
+}
+
+// OrderEvent 주문 상태 변경 이력 (추가만 하고 수정하지 않음)
+type OrderEvent struct {
+	gorm.Model
+	OrderID        string    `gorm:"column:order_id;not null;index"`
+	MarketID       string    `gorm:"column:market_id;not null"`
+	EventType      string    `gorm:"column:event_type;not null"` // SUBMITTED, PARTIALLY_FILLED, FILLED, CANCELLED
+	ExecutedVolume float64   `gorm:"column:executed_volume;default:0"`
+	Timestamp      time.Time `gorm:"column:timestamp;not null"`
+}
+
+// TableName OrderEvent 테이블 이름 설정
+func (OrderEvent) TableName() string {
+	return "order_events"
+}
+
+// RiskDecision 위험 관리 판단 기록 (감사 및 재현용)
+type RiskDecision struct {
+	gorm.Model
+	Kind      string    `gorm:"column:kind;not null;index"` // ENTRY, ALLOCATION, DRAWDOWN
+	MarketID  string    `gorm:"column:market_id;index"`     // 낙폭 판단은 비어 있음
+	SignalID  uint      `gorm:"column:signal_id"`
+	Outcome   string    `gorm:"column:outcome;not null"` // APPROVE, REJECT, LIMIT, HALT, CONTINUE
+	Reason    string    `gorm:"column:reason"`
+	Amount    float64   `gorm:"column:amount;default:0"` // 비중 판단 결과 금액
+	Inputs    JSONText  `gorm:"column:inputs"`           // 판단 입력 (재현에 사용)
+	Timestamp time.Time `gorm:"column:timestamp;not null;index"`
+}
+
+// TableName RiskDecision 테이블 이름 설정
+func (RiskDecision) TableName() string {
+	return "risk_decisions"
+}
+
+// DrawdownState 자산 낙폭 차단기 상태 (재시작 후에도 유지하도록 한 행만 저장)
+type DrawdownState struct {
+	gorm.Model
+	Peak      float64   `gorm:"column:peak;not null"` // 자산 고점 (KRW 잔고 + 열린 포지션 평가액)
+	PeakAt    time.Time `gorm:"column:peak_at"`
+	Trough    float64   `gorm:"column:trough;not null"` // 고점 이후 최저 자산
+	TroughAt  time.Time `gorm:"column:trough_at"`
+	Tripped   bool      `gorm:"column:tripped;not null;default:false"` // 차단 여부 (수동 해제 전까지 유지)
+	TrippedAt time.Time `gorm:"column:tripped_at"`
+	Reason    string    `gorm:"column:reason"`
+}
+
+// TableName DrawdownState 테이블 이름 설정
+func (DrawdownState) TableName() string {
+	return "drawdown_states"
+}
+
+// BreakEvenPrice 왕복 수수료를 반영한 손익분기 가격
+// feeRate는 한쪽 거래의 수수료율(%)이며, 매수 시 지불한 수수료와 매도 시 낼 수수료를 모두 회수하는 매도 가격을 반환한다.
+func (p Position) BreakEvenPrice(feeRate float64) float64 {
+	fee := feeRate / 100
+	if fee >= 1 {
+		return 0
+	}
+
+	return p.EntryPrice * (1 + fee) / (1 - fee)
+}
@@ -0,0 +1,55 @@
+package model
+
+import "testing"
+
+func TestParametersScan(t *testing.T) {
+	cases := []struct {
+		name  string
+		value interface{}
+		want  Parameters
+	}{
+		{"bytes", []byte(`{"period":14,"mode":"fast"}`), Parameters{"period": 14.0, "mode": "fast"}},
+		{"string", `{"period":14,"mode":"fast"}`, Parameters{"period": 14.0, "mode": "fast"}},
+		{"nil", nil, Parameters{}},
+		{"json null", "null", Parameters{}},
+	}
+	for _, tc := range cases {
+		var p Parameters
+		if err := p.Scan(tc.value); err != nil {
+			t.Fatalf("%s: Scan 오류: %v", tc.name, err)
+		}
+		if p == nil || len(p) != len(tc.want) {
+			t.Fatalf("%s: Scan = %#v, want %v", tc.name, p, tc.want)
+		}
+		for key, want := range tc.want {
+			if p[key] != want {
+				t.Fatalf("%s: %s = %v, want %v", tc.name, key, p[key], want)
+			}
+		}
+	}
+}
+
+func TestParametersScanRejectsUnsupportedType(t *testing.T) {
+	var p Parameters
+	if err := p.Scan(42); err == nil {
+		t.Fatal("정수 값 Scan 오류 없음, want 오류")
+	}
+	if err := p.Scan("{"); err == nil {
+		t.Fatal("잘못된 JSON Scan 오류 없음, want 오류")
+	}
+}
+
+func TestParametersValueRoundTrip(t *testing.T) {
+	value, err := Parameters{"oversold": 30.0}.Value()
+	if err != nil {
+		t.Fatalf("Value 오류: %v", err)
+	}
+
+	var p Parameters
+	if err := p.Scan(value); err != nil {
+		t.Fatalf("Scan 오류: %v", err)
+	}
+	if p["oversold"] != 30.0 {
+		t.Fatalf("왕복 결과 = %v, want oversold 30", p)
+	}
+}
@@ -0,0 +1,219 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// discordMaxEmbeds 웹훅 메시지 하나에 담을 수 있는 최대 임베드 수
+	discordMaxEmbeds  = 10
+	discordMaxRetries = 3
+	discordBackoff    = time.Second
+	discordTimeout    = 10 * time.Second
+)
+
+// 임베드 색상
+const (
+	discordColorProfit = 0x2ecc71 // 수익 청산 (초록)
+	discordColorLoss   = 0xe74c3c // 손실 청산, 오류 (빨강)
+	discordColorWarn   = 0xf39c12
+	discordColorInfo   = 0x3498db
+)
+
+// Discord 디스코드 웹훅 알림
+// 이벤트마다 종류, 마켓, 가격, 손익을 임베드로 보여 주며, 일시적 오류는 재시도하고 끝내 실패하면 오류를 돌려준다.
+type Discord struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewDiscord 새로운 디스코드 웹훅 알림 생성
+func NewDiscord(webhookURL string) *Discord {
+	return &Discord{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: discordTimeout},
+	}
+}
+
+// discordEmbed 웹훅 임베드
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Color       int            `json:"color"`
+	Fields      []discordField `json:"fields,omitempty"`
+	Timestamp   string         `json:"timestamp"`
+}
+
+// discordField 임베드 항목
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// discordMessage 웹훅 요청
+type discordMessage struct {
+	Embeds []discordEmbed `json:"embeds"`
+}
+
+// Send 알림 전송
+func (d *Discord) Send(ctx context.Context, level, message string) error {
+	return d.SendEvents(ctx, []Event{{Level: level, Message: message}})
+}
+
+// SendEvents 이벤트를 임베드로 전송 (임베드 한도를 넘으면 나눠 보냄)
+func (d *Discord) SendEvents(ctx context.Context, events []Event) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	var embeds []discordEmbed
+	for _, event := range events {
+		embeds = append(embeds, discordEventEmbed(event, now))
+	}
+
+	for start := 0; start < len(embeds); start += discordMaxEmbeds {
+		end := start + discordMaxEmbeds
+		if end > len(embeds) {
+			end = len(embeds)
+		}
+		if err := d.post(ctx, discordMessage{Embeds: embeds[start:end]}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// discordEventEmbed 이벤트 임베드 구성
+func discordEventEmbed(event Event, timestamp string) discordEmbed {
+	title := event.Type
+	if title == "" {
+		title = event.Level
+	}
+
+	embed := discordEmbed{
+		Title:       title,
+		Description: event.Message,
+		Color:       discordColor(event),
+		Timestamp:   timestamp,
+	}
+	if event.Market != "" {
+		embed.Fields = append(embed.Fields, discordField{Name: "마켓", Value: event.Market, Inline: true})
+	}
+	if event.Price > 0 {
+		embed.Fields = append(embed.Fields, discordField{Name: "가격", Value: strconv.FormatFloat(event.Price, 'f', -1, 64), Inline: true})
+	}
+	if event.HasProfit {
+		embed.Fields = append(embed.Fields, discordField{Name: "손익", Value: fmt.Sprintf("%+.0f원", event.Profit), Inline: true})
+	}
+
+	return embed
+}
+
+// discordColor 임베드 색상 (손익이 있으면 수익 초록, 손실 빨강, 없으면 알림 수준별)
+func discordColor(event Event) int {
+	if event.HasProfit {
+		if event.Profit >= 0 {
+			return discordColorProfit
+		}
+		return discordColorLoss
+	}
+
+	switch event.Level {
+	case LevelError:
+		return discordColorLoss
+	case LevelWarn:
+		return discordColorWarn
+	}
+	return discordColorInfo
+}
+
+// discordRetryError 다시 시도할 수 있는 웹훅 오류
+type discordRetryError struct {
+	err   error
+	after time.Duration // 서버가 알려 준 대기 시간 (없으면 0)
+}
+
+// Error 오류 메시지
+func (e *discordRetryError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap 원인 오류
+func (e *discordRetryError) Unwrap() error {
+	return e.err
+}
+
+// post 웹훅 요청 전송
+// 네트워크 오류, 429, 5xx 응답은 대기 후 재시도하며(429는 retry_after만큼 대기) 그 외 오류는 바로 반환한다.
+func (d *Discord) post(ctx context.Context, message discordMessage) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("디스코드 요청 인코딩 실패: %w", err)
+	}
+
+	backoff := discordBackoff
+	for attempt := 0; ; attempt++ {
+		err = d.postOnce(ctx, body)
+		var retry *discordRetryError
+		if err == nil || !errors.As(err, &retry) || attempt >= discordMaxRetries {
+			return err
+		}
+
+		wait := backoff
+		if retry.after > 0 {
+			wait = retry.after
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+// postOnce 웹훅 요청 한 번 전송
+func (d *Discord) postOnce(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", d.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		// 웹훅 주소에 토큰이 들어 있으므로 오류 메시지에 주소를 남기지 않는다
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return &discordRetryError{err: fmt.Errorf("디스코드 요청 실패: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests:
+		var limited struct {
+			RetryAfter float64 `json:"retry_after"` // 초
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&limited)
+		return &discordRetryError{
+			err:   fmt.Errorf("디스코드 요청 한도 초과: %s", resp.Status),
+			after: time.Duration(limited.RetryAfter * float64(time.Second)),
+		}
+	case resp.StatusCode >= 500:
+		return &discordRetryError{err: fmt.Errorf("디스코드 서버 오류: %s", resp.Status)}
+	default:
+		return fmt.Errorf("디스코드 전송 실패: %s", resp.Status)
+	}
+}
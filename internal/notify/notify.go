@@ -0,0 +1,229 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+)
+
+// 알림 수준
+const (
+	LevelInfo  = "INFO"
+	LevelWarn  = "WARN"
+	LevelError = "ERROR"
+)
+
+const (
+	defaultBatchInterval = 10 * time.Second
+	defaultBatchSize     = 100
+)
+
+// Notifier 알림 전송 인터페이스
+// 텔레그램 외의 채널(디스코드, 슬랙 등)도 이 인터페이스를 구현해 추가한다.
+type Notifier interface {
+	Send(ctx context.Context, level, message string) error
+}
+
+// Event 알림 이벤트
+// 항목을 나눠 보여 줄 수 있는 채널(디스코드 임베드 등)은 마켓, 가격, 손익을 따로 표시하고, 나머지 채널에는 Message만 보낸다.
+type Event struct {
+	Level     string
+	Type      string // 이벤트 종류 (예: 주문 체결, 포지션 매도)
+	Market    string
+	Price     float64
+	Profit    float64
+	HasProfit bool // 손익이 있는 이벤트 (매도 체결)
+	Message   string
+}
+
+// EventNotifier 이벤트를 항목별로 표시할 수 있는 Notifier
+type EventNotifier interface {
+	Notifier
+	SendEvents(ctx context.Context, events []Event) error
+}
+
+// Publish 이벤트 알림 전송 (이벤트를 지원하지 않는 Notifier에는 문구로 보냄)
+func Publish(ctx context.Context, n Notifier, event Event) error {
+	return deliver(ctx, n, []Event{event})
+}
+
+// deliver 이벤트 목록 전송
+// 이벤트를 지원하지 않는 Notifier에는 하나뿐이면 그대로, 여러 개면 줄마다 각 알림의 수준을 붙여 하나의 문구로 합쳐 보낸다.
+func deliver(ctx context.Context, n Notifier, events []Event) error {
+	if en, ok := n.(EventNotifier); ok {
+		return en.SendEvents(ctx, events)
+	}
+	if len(events) == 1 {
+		return n.Send(ctx, events[0].Level, events[0].Message)
+	}
+
+	level := LevelInfo
+	lines := []string{fmt.Sprintf("알림 %d건", len(events))}
+	for _, event := range events {
+		lines = append(lines, Format(event.Level, event.Message))
+		if levelRank(event.Level) > levelRank(level) {
+			level = event.Level
+		}
+	}
+	return n.Send(ctx, level, strings.Join(lines, "\n"))
+}
+
+// Nop 알림을 보내지 않는 Notifier (알림 채널이 설정되지 않았을 때)
+type Nop struct{}
+
+// Send 아무것도 하지 않음
+func (Nop) Send(ctx context.Context, level, message string) error {
+	return nil
+}
+
+// Multi 여러 알림 채널에 동시에 전송
+// 한 채널이 실패해도 나머지 채널에는 보내며, 실패한 채널의 오류를 모아 반환한다.
+type Multi []Notifier
+
+// Send 모든 채널에 알림 전송
+func (m Multi) Send(ctx context.Context, level, message string) error {
+	return m.SendEvents(ctx, []Event{{Level: level, Message: message}})
+}
+
+// SendEvents 모든 채널에 이벤트 전송
+func (m Multi) SendEvents(ctx context.Context, events []Event) error {
+	var errs []error
+	for _, n := range m {
+		if err := deliver(ctx, n, events); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Format 수준 표시를 붙인 알림 문구
+func Format(level, message string) string {
+	return fmt.Sprintf("[%s] %s", level, message)
+}
+
+// Batcher 알림 묶음 전송기
+// 체결이 몰려도 알림이 쏟아지지 않도록 알림을 모았다가 주기마다 한 번에 보낸다.
+// 버퍼가 가득 차면 새 알림은 버리고 버린 개수를 다음 묶음에 함께 알린다.
+// 전송은 별도 고루틴에서 하므로 알림 채널이 느리거나 멈춰도 호출한 쪽(거래 루프)은 기다리지 않는다.
+type Batcher struct {
+	next     Notifier
+	interval time.Duration
+	size     int
+	logger   *utils.Logger
+
+	mu      sync.Mutex
+	pending []Event
+	dropped int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewBatcher 새로운 알림 묶음 전송기 생성 (interval이 0 이하면 10초)
+func NewBatcher(next Notifier, interval time.Duration) *Batcher {
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+
+	return &Batcher{
+		next:     next,
+		interval: interval,
+		size:     defaultBatchSize,
+		logger:   utils.NewLogger("notify"),
+	}
+}
+
+// Send 알림을 다음 묶음에 추가 (전송하지 않고 바로 반환)
+func (b *Batcher) Send(ctx context.Context, level, message string) error {
+	return b.SendEvents(ctx, []Event{{Level: level, Message: message}})
+}
+
+// SendEvents 이벤트를 다음 묶음에 추가 (전송하지 않고 바로 반환)
+func (b *Batcher) SendEvents(ctx context.Context, events []Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, event := range events {
+		if len(b.pending) >= b.size {
+			b.dropped++
+			continue
+		}
+		b.pending = append(b.pending, event)
+	}
+	return nil
+}
+
+// Start 주기적 묶음 전송 시작
+func (b *Batcher) Start(ctx context.Context) {
+	ctx, b.cancel = context.WithCancel(ctx)
+	b.wg.Add(1)
+	go b.run(ctx)
+}
+
+// Stop 묶음 전송 중지 (남은 알림은 보내고 종료)
+func (b *Batcher) Stop() {
+	if b.cancel == nil {
+		return
+	}
+	b.cancel()
+	b.wg.Wait()
+	b.cancel = nil
+}
+
+// run 묶음 전송 루프
+func (b *Batcher) run(ctx context.Context) {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), b.interval)
+			b.Flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			b.Flush(ctx)
+		}
+	}
+}
+
+// Flush 모인 알림을 즉시 전송
+// 전송에 실패한 알림은 다시 쌓지 않고 버린다.
+func (b *Batcher) Flush(ctx context.Context) {
+	b.mu.Lock()
+	pending, dropped := b.pending, b.dropped
+	b.pending, b.dropped = nil, 0
+	b.mu.Unlock()
+
+	if dropped > 0 {
+		pending = append(pending, Event{Level: LevelWarn, Message: fmt.Sprintf("(알림 %d건 생략)", dropped)})
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	if err := deliver(ctx, b.next, pending); err != nil {
+		b.logger.Warn("알림 전송 실패, 알림 폐기:", len(pending), "건", err)
+	}
+}
+
+// levelRank 알림 수준 순위
+func levelRank(level string) int {
+	switch level {
+	case LevelError:
+		return 3
+	case LevelWarn:
+		return 2
+	case LevelInfo:
+		return 1
+	}
+	return 0
+}
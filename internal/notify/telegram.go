@@ -0,0 +1,92 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	telegramAPIURL = "https://api.telegram.org"
+	// telegramMaxLength 텔레그램 메시지 최대 길이 (글자 수)
+	telegramMaxLength = 4096
+	telegramTimeout   = 10 * time.Second
+)
+
+// Telegram 텔레그램 봇 API 알림
+type Telegram struct {
+	token  string
+	chatID string
+	client *http.Client
+}
+
+// NewTelegram 새로운 텔레그램 알림 생성
+// token은 BotFather에서 받은 봇 토큰, chatID는 알림을 받을 대화 ID이다.
+func NewTelegram(token, chatID string) *Telegram {
+	return &Telegram{
+		token:  token,
+		chatID: chatID,
+		client: &http.Client{Timeout: telegramTimeout},
+	}
+}
+
+// telegramMessage sendMessage 요청
+type telegramMessage struct {
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+// telegramResponse 봇 API 응답
+type telegramResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+// Send sendMessage로 알림 전송 (최대 길이를 넘는 부분은 잘라낸다)
+func (t *Telegram) Send(ctx context.Context, level, message string) error {
+	text := []rune(Format(level, message))
+	if len(text) > telegramMaxLength {
+		text = text[:telegramMaxLength]
+	}
+
+	body, err := json.Marshal(telegramMessage{ChatID: t.chatID, Text: string(text)})
+	if err != nil {
+		return fmt.Errorf("텔레그램 요청 인코딩 실패: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/bot%s/sendMessage", telegramAPIURL, t.token)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		// 요청 URL에 봇 토큰이 들어 있으므로 오류 메시지에 URL을 남기지 않는다
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("텔레그램 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("텔레그램 응답 읽기 실패: %w", err)
+	}
+
+	var result telegramResponse
+	if err := json.Unmarshal(data, &result); err != nil || !result.OK {
+		return fmt.Errorf("텔레그램 전송 실패: %s %s", resp.Status, result.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+)
+
+// ErrMarketAllocationExceeded 단일 마켓 비중 한도 초과
+var ErrMarketAllocationExceeded = errors.New("단일 마켓 최대 비중을 초과했습니다")
+
+// LimitEntryAmount 단일 마켓 비중 한도에 맞춰 매수 금액 조정
+// 한도 내라면 요청 금액을 그대로, 초과하면 남은 한도만큼 줄인 금액을 반환한다.
+// 줄인 금액이 최소 주문 금액보다 작으면 진입을 거부한다.
+func (m *Manager) LimitEntryAmount(ctx context.Context, marketID string, amount float64) (float64, error) {
+	if m.cfg.MaxMarketAllocation <= 0 {
+		return amount, nil
+	}
+
+	equity, err := m.Equity(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	exposure, err := m.marketExposure(marketID)
+	if err != nil {
+		return 0, err
+	}
+
+	in := AllocationInputs{Equity: equity, Exposure: exposure, Amount: amount, MaxPercent: m.cfg.MaxMarketAllocation}
+	outcome, limited, err := decideAllocation(in)
+	m.recordDecision(DecisionAllocation, marketID, 0, outcome, errorReason(err), limited, in, time.Now())
+	if err != nil {
+		m.logger.Info("마켓 비중 한도로 진입 거부:", marketID, err)
+		return 0, err
+	}
+
+	if limited < amount {
+		m.logger.Info("마켓 비중 한도로 매수 금액 축소:", marketID, amount, "->", limited)
+	}
+
+	return limited, nil
+}
+
+// capMarketAllocation 마켓 비중 한도 적용
+func capMarketAllocation(equity, exposure, amount, maxPercent float64) (float64, error) {
+	remaining := equity*maxPercent/100 - exposure
+	if remaining < exchange.MinOrderAmount {
+		return 0, fmt.Errorf("%w: 현재 %.0f원, 한도 %.0f원", ErrMarketAllocationExceeded, exposure, equity*maxPercent/100)
+	}
+
+	if amount > remaining {
+		return remaining, nil
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,61 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestLimitEntryAmountTrimsToMarketCap(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(1000000))
+	m, db := newTestManager(t, client, config.RiskConfig{MaxMarketAllocation: 20})
+
+	// 자산 100만원의 20% = 20만원 한도, 이미 15만원 보유
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1.5, Status: "OPEN", LastPrice: 100000}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	amount, err := m.LimitEntryAmount(context.Background(), "KRW-BTC", 100000)
+	if err != nil {
+		t.Fatalf("LimitEntryAmount 오류: %v", err)
+	}
+	if amount != 50000 {
+		t.Fatalf("조정된 매수 금액 = %.0f, want 50000", amount)
+	}
+
+	// 다른 마켓은 자기 비중만 본다
+	amount, err = m.LimitEntryAmount(context.Background(), "KRW-ETH", 100000)
+	if err != nil || amount != 100000 {
+		t.Fatalf("다른 마켓 매수 금액 = %.0f, %v, want 100000", amount, err)
+	}
+}
+
+func TestLimitEntryAmountRejectsWhenCapFull(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(1000000))
+	m, db := newTestManager(t, client, config.RiskConfig{MaxMarketAllocation: 20})
+
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1.98, Status: "OPEN", LastPrice: 100000}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// 남은 한도 2천원은 최소 주문 금액보다 작다
+	if _, err := m.LimitEntryAmount(context.Background(), "KRW-BTC", 100000); !errors.Is(err, ErrMarketAllocationExceeded) {
+		t.Fatalf("오류 = %v, want ErrMarketAllocationExceeded", err)
+	}
+}
+
+func TestLimitEntryAmountDisabled(t *testing.T) {
+	m, _ := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+	amount, err := m.LimitEntryAmount(context.Background(), "KRW-BTC", 123456)
+	if err != nil || amount != 123456 {
+		t.Fatalf("한도 미설정 시 매수 금액 = %.0f, %v", amount, err)
+	}
+}
@@ -0,0 +1,45 @@
+package risk
+
+import "errors"
+
+const defaultMinRequests = 20
+
+// ErrAPIUnhealthy 업비트 API 오류율이 높아 거래 중지
+var ErrAPIUnhealthy = errors.New("업비트 API 오류율이 높아 거래가 중지되었습니다")
+
+// checkAPIHealth API 오류율 확인
+// 오류율이 기준을 넘으면 신규 거래를 멈추고, 재개 기준 아래로 내려오면 자동으로 재개한다.
+// 시세 폴링 같은 조회 작업은 계속 진행된다.
+func (m *Manager) checkAPIHealth() {
+	cfg := m.cfg.APIErrorPause
+	rate, total := m.client.ErrorRate()
+
+	minRequests := cfg.MinRequests
+	if minRequests <= 0 {
+		minRequests = defaultMinRequests
+	}
+	resumeRate := cfg.ResumeErrorRate
+	if resumeRate <= 0 {
+		resumeRate = cfg.MaxErrorRate / 2
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case !m.apiPaused && total >= minRequests && rate >= cfg.MaxErrorRate:
+		m.apiPaused = true
+		m.logger.Error("업비트 API 오류율 초과로 거래 중지:", rate, "% /", total, "건")
+	case m.apiPaused && rate <= resumeRate:
+		m.apiPaused = false
+		m.logger.Info("업비트 API 오류율 정상화로 거래 재개:", rate, "% /", total, "건")
+	}
+}
+
+// IsAPIPaused API 오류율로 인한 거래 중지 여부
+func (m *Manager) IsAPIPaused() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.apiPaused
+}
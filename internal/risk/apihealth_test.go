@@ -0,0 +1,64 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// setErrorRate 테스트 거래소의 API 오류율과 호출 수 설정
+func (f *fakeClient) setErrorRate(rate float64, requests int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.errRate, f.requests = rate, requests
+}
+
+func TestCheckAPIHealthPausesAndResumes(t *testing.T) {
+	client := &fakeClient{}
+	m, _ := newTestManager(t, client, config.RiskConfig{
+		APIErrorPause: config.APIErrorPauseConfig{Enabled: true, MaxErrorRate: 30, ResumeErrorRate: 10, MinRequests: 20},
+	})
+	signal := &model.Signal{MarketID: "KRW-BTC", SignalType: "BUY", Timestamp: time.Now()}
+
+	client.setErrorRate(40, 50)
+	m.checkAPIHealth()
+	if !m.IsAPIPaused() {
+		t.Fatal("오류율이 기준을 넘었는데 거래가 중지되지 않음")
+	}
+	if err := m.CheckEntry(context.Background(), signal); !errors.Is(err, ErrAPIUnhealthy) {
+		t.Fatalf("중지 중 진입 오류 = %v, want ErrAPIUnhealthy", err)
+	}
+
+	// 재개 기준과 중지 기준 사이에서는 중지 상태를 유지한다
+	client.setErrorRate(20, 50)
+	m.checkAPIHealth()
+	if !m.IsAPIPaused() {
+		t.Fatal("재개 기준보다 오류율이 높은데 거래가 재개됨")
+	}
+
+	client.setErrorRate(5, 50)
+	m.checkAPIHealth()
+	if m.IsAPIPaused() {
+		t.Fatal("오류율이 재개 기준 아래로 내려왔는데 거래가 재개되지 않음")
+	}
+	if err := m.CheckEntry(context.Background(), signal); err != nil {
+		t.Fatalf("재개 후 진입 거부: %v", err)
+	}
+}
+
+func TestCheckAPIHealthIgnoresTooFewRequests(t *testing.T) {
+	client := &fakeClient{}
+	m, _ := newTestManager(t, client, config.RiskConfig{
+		APIErrorPause: config.APIErrorPauseConfig{Enabled: true, MaxErrorRate: 30, MinRequests: 20},
+	})
+
+	client.setErrorRate(100, 3)
+	m.checkAPIHealth()
+	if m.IsAPIPaused() {
+		t.Fatal("호출 수가 최소 호출 수보다 적은데 거래가 중지됨")
+	}
+}
@@ -0,0 +1,47 @@
+package risk
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+// ErrEntryBlackout 진입 금지 구간
+var ErrEntryBlackout = errors.New("진입 금지 구간입니다")
+
+// checkBlackout 진입 금지 구간 확인
+// 주요 경제 일정이나 거래소 점검 시간처럼 설정된 구간에는 신규 진입만 막고, 기존 포지션 관리는 계속한다.
+func checkBlackout(windows []config.BlackoutWindow, marketID string, now time.Time) error {
+	for _, window := range windows {
+		start, err := time.Parse(time.RFC3339, window.Start)
+		if err != nil {
+			return fmt.Errorf("잘못된 진입 금지 시작 시각: %s", window.Start)
+		}
+		end, err := time.Parse(time.RFC3339, window.End)
+		if err != nil {
+			return fmt.Errorf("잘못된 진입 금지 종료 시각: %s", window.End)
+		}
+
+		if now.Before(start) || !now.Before(end) || !appliesTo(window.Markets, marketID) {
+			continue
+		}
+		return fmt.Errorf("%w: %s (%s ~ %s)", ErrEntryBlackout, window.Reason, window.Start, window.End)
+	}
+
+	return nil
+}
+
+// appliesTo 마켓 목록에 포함되는지 여부 (목록이 비어 있으면 모든 마켓)
+func appliesTo(markets []string, marketID string) bool {
+	if len(markets) == 0 {
+		return true
+	}
+	for _, m := range markets {
+		if m == marketID {
+			return true
+		}
+	}
+	return false
+}
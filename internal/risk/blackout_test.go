@@ -0,0 +1,71 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestCheckBlackoutWindow(t *testing.T) {
+	windows := []config.BlackoutWindow{
+		{Start: "2024-03-20T18:00:00Z", End: "2024-03-20T20:00:00Z", Reason: "FOMC"},
+		{Start: "2024-04-01T00:00:00+09:00", End: "2024-04-01T02:00:00+09:00", Reason: "점검", Markets: []string{"KRW-XRP"}},
+	}
+
+	cases := []struct {
+		name    string
+		market  string
+		now     string
+		blocked bool
+	}{
+		{"구간 이전", "KRW-BTC", "2024-03-20T17:59:59Z", false},
+		{"시작 시각", "KRW-BTC", "2024-03-20T18:00:00Z", true},
+		{"구간 중", "KRW-BTC", "2024-03-20T19:30:00Z", true},
+		{"종료 시각", "KRW-BTC", "2024-03-20T20:00:00Z", false},
+		{"대상 마켓 구간 중", "KRW-XRP", "2024-03-31T16:30:00Z", true},
+		{"대상이 아닌 마켓", "KRW-BTC", "2024-03-31T16:30:00Z", false},
+	}
+	for _, tc := range cases {
+		now, _ := time.Parse(time.RFC3339, tc.now)
+		err := checkBlackout(windows, tc.market, now)
+		if blocked := errors.Is(err, ErrEntryBlackout); blocked != tc.blocked {
+			t.Fatalf("%s: checkBlackout 오류 = %v, want 차단 %v", tc.name, err, tc.blocked)
+		}
+	}
+}
+
+func TestCheckBlackoutRejectsInvalidTime(t *testing.T) {
+	windows := []config.BlackoutWindow{{Start: "2024-03-20 18:00", End: "2024-03-20T20:00:00Z"}}
+	if err := checkBlackout(windows, "KRW-BTC", time.Now()); err == nil || errors.Is(err, ErrEntryBlackout) {
+		t.Fatalf("잘못된 시각 오류 = %v, want 형식 오류", err)
+	}
+}
+
+func TestCheckEntryBlockedDuringBlackout(t *testing.T) {
+	now := time.Now()
+	active := config.BlackoutWindow{Start: now.Add(-time.Hour).Format(time.RFC3339), End: now.Add(time.Hour).Format(time.RFC3339), Reason: "FOMC"}
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{RecordDecisions: true, Blackouts: []config.BlackoutWindow{active}})
+
+	signal := &model.Signal{MarketID: "KRW-BTC", SignalType: "BUY"}
+	if err := m.CheckEntry(context.Background(), signal); !errors.Is(err, ErrEntryBlackout) {
+		t.Fatalf("진입 금지 구간 중 CheckEntry 오류 = %v, want ErrEntryBlackout", err)
+	}
+
+	var decision model.RiskDecision
+	if err := db.Where("kind = ?", DecisionEntry).First(&decision).Error; err != nil {
+		t.Fatalf("판단 기록 없음: %v", err)
+	}
+	if decision.Outcome != OutcomeReject {
+		t.Fatalf("판단 결과 = %s, want REJECT", decision.Outcome)
+	}
+
+	past := config.BlackoutWindow{Start: now.Add(-2 * time.Hour).Format(time.RFC3339), End: now.Add(-time.Hour).Format(time.RFC3339)}
+	m, _ = newTestManager(t, &fakeClient{}, config.RiskConfig{Blackouts: []config.BlackoutWindow{past}})
+	if err := m.CheckEntry(context.Background(), signal); err != nil {
+		t.Fatalf("진입 금지 구간 밖 CheckEntry 오류 = %v, want nil", err)
+	}
+}
@@ -0,0 +1,156 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
+	"gorm.io/gorm"
+)
+
+// ErrDailyLossLimitReached 당일 실현 손실 한도 도달
+var ErrDailyLossLimitReached = errors.New("당일 실현 손실 한도에 도달했습니다")
+
+// kst 일일 손실 집계 기준 시간대 (한국 표준시)
+var kst = time.FixedZone("KST", 9*60*60)
+
+// tradingDay KST 기준 날짜 (자정)
+func tradingDay(t time.Time) time.Time {
+	t = t.In(kst)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, kst)
+}
+
+// DailyLossStatus 당일 실현 손익과 손실 한도 상태
+type DailyLossStatus struct {
+	Date   time.Time `json:"date"`
+	Profit float64   `json:"profit"` // 당일 실현 손익 (KRW)
+	Loss   float64   `json:"loss"`   // 당일 실현 손실 (KRW, 수익이면 0)
+	Limit  float64   `json:"limit"`  // 손실 한도 (KRW, 0이면 한도 없음)
+	Halted bool      `json:"halted"` // 신규 진입 차단 여부
+}
+
+// RecordRealized 청산 체결의 실현 손익을 당일 성과에 누적
+// 마켓별 하루 한 행(DailyPerformance)에 손익 금액, 청산 횟수, 수익 청산 횟수를 더한다.
+// ProfitPercentage는 청산별 수익률(%)의 합계이다.
+func (m *Manager) RecordRealized(marketID string, profit, profitPercent float64, at time.Time) error {
+	day := tradingDay(at)
+
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		var perf model.DailyPerformance
+		err := tx.Where("date = ? AND market_id = ?", day, marketID).First(&perf).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("일별 성과 조회 실패: %w", err)
+		}
+		if err != nil {
+			perf = model.DailyPerformance{Date: day, MarketID: marketID}
+		}
+
+		perf.ProfitAmount += profit
+		perf.ProfitPercentage += profitPercent
+		perf.TradeCount++
+		if profit > 0 {
+			perf.WinCount++
+		}
+
+		if err := tx.Save(&perf).Error; err != nil {
+			return fmt.Errorf("일별 성과 저장 실패: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if m.metrics != nil {
+		m.updateDailyProfitMetric(time.Now())
+	}
+	return nil
+}
+
+// dailyProfit 당일 전체 마켓의 실현 손익 합계
+func (m *Manager) dailyProfit(now time.Time) (float64, error) {
+	var profit float64
+	err := m.db.Model(&model.DailyPerformance{}).
+		Where("date = ?", tradingDay(now)).
+		Select("COALESCE(SUM(profit_amount), 0)").
+		Scan(&profit).Error
+	if err != nil {
+		return 0, fmt.Errorf("당일 실현 손익 조회 실패: %w", err)
+	}
+
+	return profit, nil
+}
+
+// dailyLossLimit 당일 손실 한도 금액 (KRW, 0이면 한도 없음)
+// 비율 한도는 당일 시작 자산(현재 자산 + 당일 실현 손실) 기준이며, 금액 한도와 함께 설정하면 작은 쪽을 쓴다.
+func (m *Manager) dailyLossLimit(ctx context.Context, loss float64) (float64, error) {
+	limit := m.cfg.DailyLossLimit.Amount
+	if m.cfg.DailyLossLimit.Percent <= 0 {
+		return limit, nil
+	}
+
+	equity, err := m.Equity(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	byPercent := (equity + loss) * m.cfg.DailyLossLimit.Percent / 100
+	if limit <= 0 || byPercent < limit {
+		limit = byPercent
+	}
+	return limit, nil
+}
+
+// dailyLossEnabled 일일 손실 한도 설정 여부
+func (m *Manager) dailyLossEnabled() bool {
+	return m.cfg.DailyLossLimit.Percent > 0 || m.cfg.DailyLossLimit.Amount > 0
+}
+
+// DailyLoss 당일 실현 손익과 신규 진입 차단 여부
+// 집계가 KST 날짜별이므로 자정이 지나면 손실이 0부터 다시 쌓이고 차단도 자동으로 풀린다.
+func (m *Manager) DailyLoss(ctx context.Context) (*DailyLossStatus, error) {
+	now := time.Now()
+	profit, err := m.dailyProfit(now)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &DailyLossStatus{Date: tradingDay(now), Profit: profit}
+	if profit < 0 {
+		status.Loss = -profit
+	}
+	if !m.dailyLossEnabled() {
+		return status, nil
+	}
+
+	status.Limit, err = m.dailyLossLimit(ctx, status.Loss)
+	if err != nil {
+		return nil, err
+	}
+	status.Halted = dailyLossReached(status.Loss, status.Limit)
+
+	return status, nil
+}
+
+// notifyDailyLoss 일일 손실 한도 도달 알림 (KST 하루에 한 번)
+func (m *Manager) notifyDailyLoss(ctx context.Context, cause error, now time.Time) {
+	day := tradingDay(now)
+
+	m.mu.Lock()
+	if m.dailyLossNotified.Equal(day) {
+		m.mu.Unlock()
+		return
+	}
+	m.dailyLossNotified = day
+	m.mu.Unlock()
+
+	m.notify(ctx, notify.LevelWarn, "일일 손실 한도 도달, KST 자정까지 신규 진입 중지: "+cause.Error())
+}
+
+// dailyLossReached 손실 한도 도달 여부
+func dailyLossReached(loss, limit float64) bool {
+	return limit > 0 && loss >= limit
+}
@@ -0,0 +1,42 @@
+package risk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestRecordRealizedAccumulatesPerKSTDay(t *testing.T) {
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+
+	// UTC 14:30은 KST 23:30, UTC 15:30은 다음 날 KST 00:30
+	first := time.Date(2024, 1, 1, 14, 30, 0, 0, time.UTC)
+	for _, r := range []struct {
+		profit, percent float64
+		at              time.Time
+	}{
+		{4947.5, 9.89, first},
+		{-5047.5, -10.09, first.Add(10 * time.Minute)},
+		{1000, 1, first.Add(time.Hour)},
+	} {
+		if err := m.RecordRealized("KRW-BTC", r.profit, r.percent, r.at); err != nil {
+			t.Fatalf("RecordRealized 오류: %v", err)
+		}
+	}
+
+	var days []model.DailyPerformance
+	if err := db.Order("date").Find(&days).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 2 {
+		t.Fatalf("일별 성과 %d개, want 2", len(days))
+	}
+	if d := days[0]; d.ProfitAmount != -100 || d.TradeCount != 2 || d.WinCount != 1 {
+		t.Fatalf("첫날 성과 = %+v, want 손익 -100, 거래 2, 수익 1", d)
+	}
+	if d := days[1]; d.ProfitAmount != 1000 || d.TradeCount != 1 || d.WinCount != 1 || !d.Date.Equal(tradingDay(first.Add(time.Hour))) {
+		t.Fatalf("다음 날 성과 = %+v, want 손익 1000, 거래 1", d)
+	}
+}
@@ -0,0 +1,250 @@
+package risk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// 위험 관리 판단 종류
+const (
+	DecisionEntry      = "ENTRY"      // 신규 진입 허용 여부
+	DecisionAllocation = "ALLOCATION" // 마켓 비중 한도에 따른 매수 금액 조정
+	DecisionDrawdown   = "DRAWDOWN"   // 고점 대비 낙폭 확인
+)
+
+// 위험 관리 판단 결과
+const (
+	OutcomeApprove  = "APPROVE"
+	OutcomeReject   = "REJECT"
+	OutcomeLimit    = "LIMIT"    // 금액을 줄여 허용
+	OutcomeHalt     = "HALT"     // 전량 청산 후 거래 중지
+	OutcomeContinue = "CONTINUE" // 낙폭 한도 이내
+)
+
+// 재진입 검사 결과 (판단 입력에 기록)
+const (
+	reentryCooldown    = "COOLDOWN"
+	reentryTrendBroken = "TREND_BROKEN"
+)
+
+const defaultDecisionLimit = 100
+
+// ErrUnknownDecision 재현할 수 없는 판단 종류
+var ErrUnknownDecision = errors.New("알 수 없는 위험 관리 판단 종류입니다")
+
+// EntryInputs 진입 판단 입력
+// 재진입 검사는 상태를 바꾸고 캔들을 조회하므로 검사 결과만 입력으로 남긴다.
+type EntryInputs struct {
+	MarketID    string                  `json:"market_id"`
+	Now         time.Time               `json:"now"`
+	Paused      bool                    `json:"paused"`
+	PauseReason string                  `json:"pause_reason,omitempty"`
+	APIPaused   bool                    `json:"api_paused"`
+	Blackouts   []config.BlackoutWindow `json:"blackouts,omitempty"`
+	DailyLoss   float64                 `json:"daily_loss,omitempty"`       // 당일 실현 손실 (KRW)
+	DailyLimit  float64                 `json:"daily_loss_limit,omitempty"` // 당일 손실 한도 (KRW, 0이면 한도 없음)
+	OpenCount   int                     `json:"open_positions,omitempty"`   // 다른 마켓의 열린 포지션 수
+	MaxOpen     int                     `json:"max_open_positions,omitempty"`
+	HasPosition bool                    `json:"has_position,omitempty"` // 같은 마켓에 이미 열린 포지션이 있음 (추가 매수)
+	Reentry     string                  `json:"reentry,omitempty"`      // COOLDOWN, TREND_BROKEN (비어 있으면 통과 또는 미검사)
+}
+
+// AllocationInputs 마켓 비중 판단 입력
+type AllocationInputs struct {
+	Equity     float64 `json:"equity"`
+	Exposure   float64 `json:"exposure"`
+	Amount     float64 `json:"amount"`
+	MaxPercent float64 `json:"max_percent"`
+}
+
+// DrawdownInputs 낙폭 판단 입력
+type DrawdownInputs struct {
+	Peak        float64 `json:"peak"`
+	Equity      float64 `json:"equity"`
+	MaxDrawdown float64 `json:"max_drawdown"`
+}
+
+// decideEntry 진입 허용 여부 판단 (입력만으로 결정되는 순수 함수)
+func decideEntry(in EntryInputs) error {
+	if in.Paused {
+		return fmt.Errorf("%w: %s", ErrTradingPaused, in.PauseReason)
+	}
+	if in.APIPaused {
+		return ErrAPIUnhealthy
+	}
+	if err := checkBlackout(in.Blackouts, in.MarketID, in.Now); err != nil {
+		return err
+	}
+	if in.MaxOpen > 0 && !in.HasPosition && in.OpenCount >= in.MaxOpen {
+		return fmt.Errorf("%w: %d/%d", ErrMaxPositionsReached, in.OpenCount, in.MaxOpen)
+	}
+	if dailyLossReached(in.DailyLoss, in.DailyLimit) {
+		return fmt.Errorf("%w: 손실 %.0f원, 한도 %.0f원", ErrDailyLossLimitReached, in.DailyLoss, in.DailyLimit)
+	}
+
+	switch in.Reentry {
+	case reentryCooldown:
+		return ErrReentryCooldown
+	case reentryTrendBroken:
+		return ErrReentryTrendBroken
+	}
+
+	return nil
+}
+
+// reentryResult 재진입 검사 오류를 판단 입력 값으로 변환
+func reentryResult(err error) string {
+	switch {
+	case errors.Is(err, ErrReentryCooldown):
+		return reentryCooldown
+	case errors.Is(err, ErrReentryTrendBroken):
+		return reentryTrendBroken
+	}
+	return ""
+}
+
+// decideAllocation 마켓 비중 한도 판단
+func decideAllocation(in AllocationInputs) (string, float64, error) {
+	limited, err := capMarketAllocation(in.Equity, in.Exposure, in.Amount, in.MaxPercent)
+	if err != nil {
+		return OutcomeReject, 0, err
+	}
+	if limited < in.Amount {
+		return OutcomeLimit, limited, nil
+	}
+	return OutcomeApprove, limited, nil
+}
+
+// decideDrawdown 낙폭 한도 판단
+func decideDrawdown(in DrawdownInputs) (string, string) {
+	drawdown := drawdownPercent(in.Peak, in.Equity)
+	if drawdown < in.MaxDrawdown {
+		return OutcomeContinue, ""
+	}
+	return OutcomeHalt, fmt.Sprintf("고점 대비 낙폭 %.2f%% (고점 %.0f원, 현재 %.0f원)", drawdown, in.Peak, in.Equity)
+}
+
+// errorReason 판단 사유 (오류가 없으면 빈 문자열)
+func errorReason(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// recordDecision 판단과 입력 저장
+// RecordDecisions가 켜져 있을 때만 저장하며, 저장 실패는 거래 흐름을 막지 않고 로그만 남긴다.
+func (m *Manager) recordDecision(kind, marketID string, signalID uint, outcome, reason string, amount float64, inputs interface{}, now time.Time) {
+	if !m.cfg.RecordDecisions {
+		return
+	}
+
+	data, err := json.Marshal(inputs)
+	if err != nil {
+		m.logger.Error("위험 관리 판단 입력 인코딩 실패:", kind, err)
+		return
+	}
+
+	decision := model.RiskDecision{
+		Kind:      kind,
+		MarketID:  marketID,
+		SignalID:  signalID,
+		Outcome:   outcome,
+		Reason:    reason,
+		Amount:    amount,
+		Inputs:    model.JSONText(data),
+		Timestamp: now,
+	}
+	if err := m.db.Create(&decision).Error; err != nil {
+		m.logger.Error("위험 관리 판단 저장 실패:", kind, marketID, err)
+	}
+}
+
+// Decisions 저장된 위험 관리 판단 조회 (최신순, marketID와 kind가 비어 있으면 전체)
+func (m *Manager) Decisions(marketID, kind string, limit int) ([]model.RiskDecision, error) {
+	if limit <= 0 {
+		limit = defaultDecisionLimit
+	}
+
+	query := m.db.Order("timestamp DESC, id DESC").Limit(limit)
+	if marketID != "" {
+		query = query.Where("market_id = ?", marketID)
+	}
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+
+	var decisions []model.RiskDecision
+	if err := query.Find(&decisions).Error; err != nil {
+		return nil, fmt.Errorf("위험 관리 판단 조회 실패: %w", err)
+	}
+
+	return decisions, nil
+}
+
+// ReplayResult 판단 재현 결과
+type ReplayResult struct {
+	Decision model.RiskDecision `json:"decision"`
+	Outcome  string             `json:"outcome"`
+	Reason   string             `json:"reason"`
+	Amount   float64            `json:"amount"`
+	Match    bool               `json:"match"` // 기록된 결과와 같은지 여부
+}
+
+// ReplayDecision 기록된 입력으로 판단을 다시 계산
+// 판단 함수는 입력만으로 결정되므로, 판단 로직이 바뀌지 않았다면 기록된 결과와 같아야 한다.
+func (m *Manager) ReplayDecision(id uint) (*ReplayResult, error) {
+	var decision model.RiskDecision
+	if err := m.db.First(&decision, id).Error; err != nil {
+		return nil, fmt.Errorf("위험 관리 판단 조회 실패: %w", err)
+	}
+
+	outcome, reason, amount, err := replay(decision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReplayResult{
+		Decision: decision,
+		Outcome:  outcome,
+		Reason:   reason,
+		Amount:   amount,
+		Match:    outcome == decision.Outcome && reason == decision.Reason && amount == decision.Amount,
+	}, nil
+}
+
+// replay 판단 종류별 재계산
+func replay(decision model.RiskDecision) (string, string, float64, error) {
+	switch decision.Kind {
+	case DecisionEntry:
+		var in EntryInputs
+		if err := json.Unmarshal([]byte(decision.Inputs), &in); err != nil {
+			return "", "", 0, fmt.Errorf("판단 입력 파싱 실패: %w", err)
+		}
+		if err := decideEntry(in); err != nil {
+			return OutcomeReject, err.Error(), 0, nil
+		}
+		return OutcomeApprove, "", 0, nil
+	case DecisionAllocation:
+		var in AllocationInputs
+		if err := json.Unmarshal([]byte(decision.Inputs), &in); err != nil {
+			return "", "", 0, fmt.Errorf("판단 입력 파싱 실패: %w", err)
+		}
+		outcome, amount, err := decideAllocation(in)
+		return outcome, errorReason(err), amount, nil
+	case DecisionDrawdown:
+		var in DrawdownInputs
+		if err := json.Unmarshal([]byte(decision.Inputs), &in); err != nil {
+			return "", "", 0, fmt.Errorf("판단 입력 파싱 실패: %w", err)
+		}
+		outcome, reason := decideDrawdown(in)
+		return outcome, reason, 0, nil
+	}
+
+	return "", "", 0, fmt.Errorf("%w: %s", ErrUnknownDecision, decision.Kind)
+}
@@ -0,0 +1,100 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestDecisionsAreRecordedAndReplayable(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(1000000))
+	m, db := newTestManager(t, client, config.RiskConfig{RecordDecisions: true, MaxConcurrentPositions: 1, MaxMarketAllocation: 20})
+	ctx := context.Background()
+
+	if err := m.CheckEntry(ctx, &model.Signal{MarketID: "KRW-BTC", SignalType: "BUY"}); err != nil {
+		t.Fatalf("첫 진입 거부: %v", err)
+	}
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1.5, Status: "OPEN", LastPrice: 100000}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := m.CheckEntry(ctx, &model.Signal{MarketID: "KRW-ETH", SignalType: "BUY"}); !errors.Is(err, ErrMaxPositionsReached) {
+		t.Fatalf("포지션 한도 초과 진입 오류 = %v, want ErrMaxPositionsReached", err)
+	}
+	if _, err := m.LimitEntryAmount(ctx, "KRW-BTC", 100000); err != nil {
+		t.Fatalf("LimitEntryAmount 오류: %v", err)
+	}
+
+	decisions, err := m.Decisions("", "", 0)
+	if err != nil {
+		t.Fatalf("Decisions 오류: %v", err)
+	}
+	if len(decisions) != 3 {
+		t.Fatalf("기록된 판단 %d개, want 3", len(decisions))
+	}
+
+	want := map[string]string{DecisionEntry + "/KRW-BTC": OutcomeApprove, DecisionEntry + "/KRW-ETH": OutcomeReject, DecisionAllocation + "/KRW-BTC": OutcomeLimit}
+	for _, decision := range decisions {
+		key := decision.Kind + "/" + decision.MarketID
+		if decision.Outcome != want[key] {
+			t.Fatalf("%s 판단 = %s, want %s", key, decision.Outcome, want[key])
+		}
+
+		result, err := m.ReplayDecision(decision.ID)
+		if err != nil {
+			t.Fatalf("%s 재현 오류: %v", key, err)
+		}
+		if !result.Match || result.Outcome != decision.Outcome {
+			t.Fatalf("%s 재현 결과 = %+v, want 기록과 일치", key, result)
+		}
+	}
+
+	entries, err := m.Decisions("KRW-ETH", DecisionEntry, 10)
+	if err != nil || len(entries) != 1 || entries[0].Reason == "" {
+		t.Fatalf("KRW-ETH 진입 판단 = %+v, %v, want 사유가 있는 거부 1건", entries, err)
+	}
+}
+
+func TestReplayDetectsChangedInputs(t *testing.T) {
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+	decision := model.RiskDecision{
+		Kind:      DecisionDrawdown,
+		Outcome:   OutcomeContinue,
+		Inputs:    `{"peak":1000000,"equity":700000,"max_drawdown":20}`,
+		Timestamp: time.Now(),
+	}
+	if err := db.Create(&decision).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := m.ReplayDecision(decision.ID)
+	if err != nil {
+		t.Fatalf("ReplayDecision 오류: %v", err)
+	}
+	if result.Match || result.Outcome != OutcomeHalt {
+		t.Fatalf("재현 결과 = %+v, want HALT, 불일치", result)
+	}
+
+	unknown := model.RiskDecision{Kind: "EXIT", Outcome: OutcomeApprove, Inputs: `{}`, Timestamp: time.Now()}
+	if err := db.Create(&unknown).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.ReplayDecision(unknown.ID); !errors.Is(err, ErrUnknownDecision) {
+		t.Fatalf("알 수 없는 판단 재현 오류 = %v, want ErrUnknownDecision", err)
+	}
+}
+
+func TestDecisionsNotRecordedWhenDisabled(t *testing.T) {
+	m, _ := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+	if err := m.CheckEntry(context.Background(), &model.Signal{MarketID: "KRW-BTC", SignalType: "BUY"}); err != nil {
+		t.Fatalf("진입 거부: %v", err)
+	}
+	if decisions, _ := m.Decisions("", "", 0); len(decisions) != 0 {
+		t.Fatalf("기록된 판단 %d개, want 0", len(decisions))
+	}
+}
@@ -0,0 +1,324 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
+	"gorm.io/gorm"
+)
+
+// ErrTradingPaused 거래 일시 중지 상태
+var ErrTradingPaused = errors.New("거래가 일시 중지되었습니다")
+
+// accountRefresh 낙폭 계산에 쓰는 KRW 잔고 재조회 주기
+const accountRefresh = 30 * time.Second
+
+// snapshotEquity 총 자산 스냅샷 저장 및 낙폭 차단기 확인
+func (m *Manager) snapshotEquity(ctx context.Context, now time.Time) {
+	equity, err := m.Equity(ctx)
+	if err != nil {
+		m.logger.Error("총 자산 조회 실패:", err)
+		return
+	}
+
+	snapshot := model.EquitySnapshot{Timestamp: now, Equity: equity}
+	if err := m.db.Create(&snapshot).Error; err != nil {
+		m.logger.Error("자산 스냅샷 저장 실패:", err)
+		return
+	}
+
+	m.updateDrawdown(ctx, now, true)
+}
+
+// markToMarketEquity KRW 잔고와 열린 포지션의 최근 가격 기준 평가액 합계
+// 시세마다 호출되므로 KRW 잔고는 accountRefresh 동안 재사용한다.
+func (m *Manager) markToMarketEquity(ctx context.Context, now time.Time) (float64, error) {
+	m.mu.Lock()
+	krw, fresh := m.krw, now.Sub(m.krwAt) < accountRefresh
+	m.mu.Unlock()
+
+	if !fresh {
+		accounts, err := m.client.GetAccounts(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("계정 정보 조회 실패: %w", err)
+		}
+		krw = 0
+		for _, account := range accounts {
+			if account.Currency == "KRW" {
+				krw = account.Quantity()
+			}
+		}
+
+		m.mu.Lock()
+		m.krw, m.krwAt = krw, now
+		m.mu.Unlock()
+	}
+
+	var positions []model.Position
+	if err := m.db.Where("status = ?", "OPEN").Find(&positions).Error; err != nil {
+		return 0, fmt.Errorf("포지션 조회 실패: %w", err)
+	}
+
+	total := krw
+	for _, position := range positions {
+		price := position.LastPrice
+		if price == 0 {
+			price = position.EntryPrice
+		}
+		total += position.Quantity * price
+	}
+
+	return total, nil
+}
+
+// trackEquity 자산 고점과 고점 이후 최저점 갱신 (바뀌었으면 true)
+// 고점 기간이 설정되어 있으면 그보다 오래된 고점은 현재 자산으로 다시 잡는다.
+func trackEquity(state *model.DrawdownState, equity float64, now time.Time, window time.Duration) bool {
+	if state.Peak <= 0 || equity > state.Peak || (window > 0 && now.Sub(state.PeakAt) > window) {
+		state.Peak, state.PeakAt = equity, now
+		state.Trough, state.TroughAt = equity, now
+		return true
+	}
+	if equity < state.Trough {
+		state.Trough, state.TroughAt = equity, now
+		return true
+	}
+	return false
+}
+
+// drawdownState 저장된 낙폭 차단기 상태 (ddMu를 잡은 상태에서 호출)
+func (m *Manager) drawdownState() (*model.DrawdownState, error) {
+	if m.drawdown != nil {
+		return m.drawdown, nil
+	}
+
+	var state model.DrawdownState
+	err := m.db.Order("id").First(&state).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("낙폭 차단기 상태 조회 실패: %w", err)
+	}
+
+	m.drawdown = &state
+	return m.drawdown, nil
+}
+
+// restoreDrawdown 재시작 시 낙폭 차단 상태 복원
+func (m *Manager) restoreDrawdown() {
+	m.ddMu.Lock()
+	state, err := m.drawdownState()
+	m.ddMu.Unlock()
+	if err != nil {
+		m.logger.Error(err)
+		return
+	}
+
+	if state.Tripped {
+		m.Pause(state.Reason)
+		m.logger.Error("낙폭 차단 상태 복원, 수동 해제 전까지 신규 진입 중지:", state.Reason)
+	}
+}
+
+// updateDrawdown 시가 평가 자산으로 고점 대비 낙폭 확인
+// 고점과 최저점은 DB에 저장되어 재시작 후에도 이어지며, 한도를 넘으면 차단기를 작동시킨다.
+// record가 false이면(시세마다 호출) 차단할 때만 판단을 기록한다.
+func (m *Manager) updateDrawdown(ctx context.Context, now time.Time, record bool) {
+	if m.cfg.MaxEquityDrawdown <= 0 {
+		return
+	}
+
+	equity, err := m.markToMarketEquity(ctx, now)
+	if err != nil {
+		m.logger.Error("시가 평가 자산 계산 실패:", err)
+		return
+	}
+
+	window := time.Duration(m.cfg.EquityPeakWindowHours) * time.Hour
+
+	m.ddMu.Lock()
+	state, err := m.drawdownState()
+	if err != nil || state.Tripped {
+		m.ddMu.Unlock()
+		if err != nil {
+			m.logger.Error(err)
+		}
+		return
+	}
+
+	changed := trackEquity(state, equity, now, window)
+	in := DrawdownInputs{Peak: state.Peak, Equity: equity, MaxDrawdown: m.cfg.MaxEquityDrawdown}
+	outcome, reason := decideDrawdown(in)
+	if outcome == OutcomeHalt {
+		state.Tripped, state.TrippedAt, state.Reason = true, now, reason
+		changed = true
+	}
+	if changed {
+		if err := m.db.Save(state).Error; err != nil {
+			m.logger.Error("낙폭 차단기 상태 저장 실패:", err)
+		}
+	}
+	m.ddMu.Unlock()
+
+	if record || outcome == OutcomeHalt {
+		m.recordDecision(DecisionDrawdown, "", 0, outcome, reason, 0, in, now)
+	}
+	if outcome == OutcomeHalt {
+		m.tripBreaker(ctx, reason)
+	}
+}
+
+// tripBreaker 낙폭 차단기 작동
+// 신규 진입을 막고 미체결 주문을 모두 취소하며, 설정에 따라 열린 포지션을 시장가로 청산한다.
+// 차단은 ResetDrawdown으로 직접 해제할 때까지 유지된다.
+func (m *Manager) tripBreaker(ctx context.Context, reason string) {
+	m.logger.Error("최대 낙폭 초과, 거래 중지:", reason)
+	m.Pause(reason)
+	m.notify(ctx, notify.LevelError, "최대 낙폭 초과로 거래 중지 (수동 해제 필요): "+reason)
+
+	cancelled, err := m.client.CancelAllOrders(ctx, "")
+	if err != nil {
+		m.logger.Error("미체결 주문 취소 실패:", err)
+	}
+	m.logger.Info("낙폭 차단으로 미체결 주문 취소:", len(cancelled), "건")
+
+	if !m.cfg.KeepPositionsOnDrawdown {
+		m.flattenAll(ctx, "DRAWDOWN")
+	}
+}
+
+// DrawdownStatus 낙폭 차단기 상태 조회
+func (m *Manager) DrawdownStatus() (model.DrawdownState, error) {
+	m.ddMu.Lock()
+	defer m.ddMu.Unlock()
+
+	state, err := m.drawdownState()
+	if err != nil {
+		return model.DrawdownState{}, err
+	}
+	return *state, nil
+}
+
+// ResetDrawdown 낙폭 차단 수동 해제
+// 고점을 비워 다음 계산 시점의 자산부터 고점을 다시 잡고 거래를 재개한다.
+func (m *Manager) ResetDrawdown() error {
+	m.ddMu.Lock()
+	state, err := m.drawdownState()
+	if err != nil {
+		m.ddMu.Unlock()
+		return err
+	}
+
+	state.Tripped, state.Reason = false, ""
+	state.Peak, state.Trough = 0, 0
+	if err := m.db.Save(state).Error; err != nil {
+		m.ddMu.Unlock()
+		return fmt.Errorf("낙폭 차단기 상태 저장 실패: %w", err)
+	}
+	m.ddMu.Unlock()
+
+	m.Resume()
+	return nil
+}
+
+// drawdownPercent 고점 대비 낙폭 (%)
+func drawdownPercent(peak, equity float64) float64 {
+	if peak <= 0 || equity >= peak {
+		return 0
+	}
+
+	return (peak - equity) / peak * 100
+}
+
+// flattenAll 모든 열린 포지션 청산
+func (m *Manager) flattenAll(ctx context.Context, reason string) {
+	var positions []model.Position
+	if err := m.db.Where("status = ?", "OPEN").Find(&positions).Error; err != nil {
+		m.logger.Error("포지션 조회 실패:", err)
+		return
+	}
+
+	for _, position := range positions {
+		if err := m.closePosition(ctx, position, reason); err != nil {
+			m.logger.Error("포지션 청산 실패:", position.MarketID, err)
+		}
+	}
+}
+
+// closePosition 시장가 매도로 포지션 청산 주문
+// 보고서에서 수동 청산과 구분할 수 있도록 청산 사유를 전략 이름과 exit_reason 파라미터로 한 매도 신호를 남겨 주문에 연결한다.
+// 체결 후 포지션 정리와 청산 사유 기록은 주문 실행기의 체결 추적에서 처리된다.
+func (m *Manager) closePosition(ctx context.Context, position model.Position, reason string) error {
+	now := time.Now()
+	signal := model.Signal{
+		MarketID:     position.MarketID,
+		StrategyName: reason,
+		SignalType:   "SELL",
+		Price:        position.LastPrice,
+		Confidence:   1,
+		Timestamp:    now,
+		Parameters:   model.Parameters{"exit_reason": reason},
+	}
+	if err := m.db.Create(&signal).Error; err != nil {
+		return fmt.Errorf("청산 신호 저장 실패: %w", err)
+	}
+
+	resp, err := m.client.CreateOrder(ctx, position.MarketID, "ask", "market", position.Quantity, 0)
+	if err != nil {
+		return err
+	}
+
+	order := model.Order{
+		MarketID:    position.MarketID,
+		OrderID:     resp.UUID,
+		Side:        "SELL",
+		OrderType:   "market",
+		Volume:      position.Quantity,
+		Status:      "WAIT",
+		SignalID:    signal.ID,
+		LastUpdated: now,
+	}
+	if err := m.db.Create(&order).Error; err != nil {
+		return fmt.Errorf("청산 주문 저장 실패: %w", err)
+	}
+
+	m.logger.Info("포지션 청산 주문:", position.MarketID, position.Quantity, reason)
+	return nil
+}
+
+// Pause 거래 일시 중지 (신규 진입 차단)
+func (m *Manager) Pause(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.paused = true
+	m.pauseReason = reason
+}
+
+// Resume 거래 재개
+func (m *Manager) Resume() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.paused = false
+	m.pauseReason = ""
+	m.logger.Info("거래 재개")
+}
+
+// IsPaused 거래 일시 중지 여부
+func (m *Manager) IsPaused() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.paused
+}
+
+// PauseReason 거래 일시 중지 사유
+func (m *Manager) PauseReason() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.pauseReason
+}
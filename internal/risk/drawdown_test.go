@@ -0,0 +1,175 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// openDrawdownPosition 낙폭 계산에 들어가는 열린 포지션 (수량 1, 최근 가격 100,000원)
+func openDrawdownPosition(t *testing.T, m *Manager) {
+	t.Helper()
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN", LastPrice: 100000}
+	if err := m.db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateDrawdownFlattensAndPausesBeyondLimit(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(900000))
+	m, db := newTestManager(t, client, config.RiskConfig{MaxEquityDrawdown: 10})
+	openDrawdownPosition(t, m)
+
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	m.updateDrawdown(ctx, now, true)
+	if m.IsPaused() {
+		t.Fatal("고점 기록 시점에 거래가 중지됨")
+	}
+
+	// 자산 100만원 -> 80만원 (20% 낙폭), KRW 잔고 재조회 주기 이후
+	client.setAccounts(krwAccount(700000))
+	m.updateDrawdown(ctx, now.Add(accountRefresh+time.Second), true)
+
+	if !m.IsPaused() {
+		t.Fatal("낙폭 한도를 넘었는데 거래가 중지되지 않음")
+	}
+	state, err := m.DrawdownStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.Tripped || state.Peak != 1000000 {
+		t.Fatalf("낙폭 차단기 상태 = %+v, want Tripped, Peak 1000000", state)
+	}
+
+	if len(client.orders) != 1 {
+		t.Fatalf("청산 주문 수 = %d, want 1", len(client.orders))
+	}
+	if o := client.orders[0]; o.marketID != "KRW-BTC" || o.side != "ask" || o.orderType != "market" || o.volume != 1 {
+		t.Fatalf("청산 주문 = %+v, want KRW-BTC 시장가 매도 1", o)
+	}
+	var order model.Order
+	if err := db.Where("order_id = ?", "order-1").First(&order).Error; err != nil {
+		t.Fatalf("청산 주문 저장 안 됨: %v", err)
+	}
+	if order.Side != "SELL" {
+		t.Fatalf("저장된 청산 주문 방향 = %s, want SELL", order.Side)
+	}
+
+	// 청산 주문은 낙폭 차단 신호에 연결되고, 청산 사유는 체결 후 기록되도록 신호에 남는다
+	var signal model.Signal
+	if err := db.First(&signal, order.SignalID).Error; err != nil {
+		t.Fatalf("청산 주문의 신호 조회 실패 (signal_id %d): %v", order.SignalID, err)
+	}
+	if signal.StrategyName != "DRAWDOWN" || signal.SignalType != "SELL" || signal.Parameters["exit_reason"] != "DRAWDOWN" {
+		t.Fatalf("청산 신호 = %s %s %v, want DRAWDOWN SELL, 사유 DRAWDOWN", signal.StrategyName, signal.SignalType, signal.Parameters)
+	}
+	var position model.Position
+	if err := db.Where("market_id = ?", "KRW-BTC").First(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	if position.ExitReason != "" {
+		t.Fatalf("체결 전 포지션 청산 사유 = %q, want 없음", position.ExitReason)
+	}
+
+	// 차단 상태는 재시작 후에도 유지된다
+	restarted := NewManager(db, client, &config.RiskConfig{MaxEquityDrawdown: 10})
+	restarted.restoreDrawdown()
+	if !restarted.IsPaused() {
+		t.Fatal("재시작 후 낙폭 차단 상태가 복원되지 않음")
+	}
+}
+
+func TestUpdateDrawdownKeepsPositionsWhenConfigured(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(900000))
+	m, _ := newTestManager(t, client, config.RiskConfig{MaxEquityDrawdown: 10, KeepPositionsOnDrawdown: true})
+	openDrawdownPosition(t, m)
+
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	m.updateDrawdown(ctx, now, true)
+	client.setAccounts(krwAccount(700000))
+	m.updateDrawdown(ctx, now.Add(accountRefresh+time.Second), true)
+
+	if !m.IsPaused() {
+		t.Fatal("낙폭 한도를 넘었는데 거래가 중지되지 않음")
+	}
+	if len(client.orders) != 0 {
+		t.Fatalf("포지션 유지 설정인데 청산 주문 %d건", len(client.orders))
+	}
+}
+
+func TestResetDrawdownResumesAndRebuildsPeak(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(900000))
+	m, _ := newTestManager(t, client, config.RiskConfig{MaxEquityDrawdown: 10, KeepPositionsOnDrawdown: true})
+	openDrawdownPosition(t, m)
+
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	m.updateDrawdown(ctx, now, true)
+	client.setAccounts(krwAccount(700000))
+	now = now.Add(accountRefresh + time.Second)
+	m.updateDrawdown(ctx, now, true)
+
+	if err := m.ResetDrawdown(); err != nil {
+		t.Fatalf("ResetDrawdown 오류: %v", err)
+	}
+	if m.IsPaused() {
+		t.Fatal("수동 해제 후에도 거래 중지 상태")
+	}
+
+	// 해제 후에는 현재 자산(80만원)이 새 고점이 되어 다시 차단되지 않는다
+	m.updateDrawdown(ctx, now.Add(time.Second), true)
+	state, err := m.DrawdownStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Tripped || state.Peak != 800000 {
+		t.Fatalf("해제 후 낙폭 차단기 상태 = %+v, want Peak 800000", state)
+	}
+}
+
+func TestCheckExitTripsBreakerFromTickerPrices(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(100000))
+	m, db := newTestManager(t, client, config.RiskConfig{MaxEquityDrawdown: 10, KeepPositionsOnDrawdown: true})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 900000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	// 자산 100만원 -> 95만원 (5%) -> 85만원 (15%)
+	for _, price := range []float64{900000, 850000, 750000} {
+		expirePriceWrites(m)
+		m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: price})
+	}
+
+	state, err := m.DrawdownStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.Tripped || state.Peak != 1000000 || state.Trough != 850000 {
+		t.Fatalf("낙폭 차단기 상태 = %+v, want Tripped, Peak 1000000, Trough 850000", state)
+	}
+
+	// 차단 중에는 신규 진입을 받지 않는다
+	if err := m.CheckEntry(ctx, &model.Signal{MarketID: "KRW-ETH", SignalType: "BUY"}); !errors.Is(err, ErrTradingPaused) {
+		t.Fatalf("차단 중 진입 오류 = %v, want ErrTradingPaused", err)
+	}
+
+	// 차단 이후의 시세는 고점과 최저점을 바꾸지 않는다
+	expirePriceWrites(m)
+	m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: 1200000})
+	if after, _ := m.DrawdownStatus(); after.Peak != 1000000 || after.Trough != 850000 || !after.Tripped {
+		t.Fatalf("차단 후 낙폭 차단기 상태 = %+v, want 변화 없음", after)
+	}
+}
@@ -0,0 +1,54 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// Equity 총 자산 계산 (KRW 환산)
+// KRW 잔고와 보유 코인의 평균 매수가 기준 평가액을 합산한다. 먼지 잔고는 제외한다.
+func (m *Manager) Equity(ctx context.Context) (float64, error) {
+	accounts, err := m.client.GetAccounts(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("계정 정보 조회 실패: %w", err)
+	}
+
+	return accountsEquity(exchange.HoldingAccounts(accounts, m.cfg.DustThreshold)), nil
+}
+
+// accountsEquity 계정 목록의 총 자산 계산
+func accountsEquity(accounts []exchange.Account) float64 {
+	total := 0.0
+	for _, account := range accounts {
+		if account.Currency == "KRW" {
+			total += account.Quantity()
+			continue
+		}
+
+		total += account.Quantity() * account.AvgBuyPriceValue()
+	}
+
+	return total
+}
+
+// marketExposure 마켓의 열린 포지션 평가액
+func (m *Manager) marketExposure(marketID string) (float64, error) {
+	var positions []model.Position
+	if err := m.db.Where("market_id = ? AND status = ?", marketID, "OPEN").Find(&positions).Error; err != nil {
+		return 0, fmt.Errorf("포지션 조회 실패: %w", err)
+	}
+
+	total := 0.0
+	for _, position := range positions {
+		price := position.LastPrice
+		if price == 0 {
+			price = position.EntryPrice
+		}
+		total += position.Quantity * price
+	}
+
+	return total, nil
+}
@@ -0,0 +1,27 @@
+package risk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+)
+
+func TestEquityIgnoresDustAccounts(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(
+		krwAccount(100000),
+		coinAccount("BTC", 0, 50000000),
+		coinAccount("XRP", 1, 700),
+		coinAccount("ETH", 0.1, 3000000),
+	)
+	m, _ := newTestManager(t, client, config.RiskConfig{DustThreshold: 5000})
+
+	equity, err := m.Equity(context.Background())
+	if err != nil {
+		t.Fatalf("자산 계산 실패: %v", err)
+	}
+	if equity != 400000 {
+		t.Fatalf("Equity = %v, want 400000", equity)
+	}
+}
@@ -0,0 +1,142 @@
+package risk
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/indicator"
+)
+
+const (
+	defaultBenchmark        = "KRW-BTC"
+	defaultExposureLookback = 30
+)
+
+// PositionExposure 포지션별 방향성 노출
+type PositionExposure struct {
+	MarketID string  `json:"market"`
+	Value    float64 `json:"value"`    // 평가액 (KRW)
+	Weight   float64 `json:"weight"`   // 포트폴리오 내 비중
+	Beta     float64 `json:"beta"`     // 기준 마켓 대비 베타
+	Exposure float64 `json:"exposure"` // 기준 마켓 1% 변동 시 예상 손익 (KRW)
+}
+
+// ExposureReport 포트폴리오 방향성 노출 보고서
+type ExposureReport struct {
+	Benchmark     string             `json:"benchmark"`
+	Timeframe     string             `json:"timeframe"`
+	Lookback      int                `json:"lookback"`
+	TotalValue    float64            `json:"total_value"`
+	PortfolioBeta float64            `json:"portfolio_beta"` // 비중 가중 베타
+	Exposure      float64            `json:"exposure"`       // 기준 마켓 1% 변동 시 예상 손익 (KRW)
+	Positions     []PositionExposure `json:"positions"`
+	GeneratedAt   time.Time          `json:"generated_at"`
+}
+
+// ExposureReport 열린 포지션의 기준 마켓 대비 노출 계산
+// 저장된 캔들의 최근 lookback개 수익률로 각 마켓의 베타를 추정하고 평가액으로 가중한다.
+func (m *Manager) ExposureReport(benchmark, timeframe string, lookback int) (*ExposureReport, error) {
+	if benchmark == "" {
+		benchmark = defaultBenchmark
+	}
+	if timeframe == "" {
+		timeframe = "days"
+	}
+	if lookback <= 1 {
+		lookback = defaultExposureLookback
+	}
+
+	var positions []model.Position
+	if err := m.db.Where("status = ?", "OPEN").Find(&positions).Error; err != nil {
+		return nil, fmt.Errorf("포지션 조회 실패: %w", err)
+	}
+
+	benchmarkCloses, err := m.recentCloses(benchmark, timeframe, lookback+1)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ExposureReport{
+		Benchmark:   benchmark,
+		Timeframe:   timeframe,
+		Lookback:    lookback,
+		GeneratedAt: time.Now(),
+	}
+
+	for _, position := range positions {
+		price := position.LastPrice
+		if price == 0 {
+			price = position.EntryPrice
+		}
+
+		exposure := PositionExposure{
+			MarketID: position.MarketID,
+			Value:    position.Quantity * price,
+			Beta:     1,
+		}
+		if position.MarketID != benchmark {
+			closes, err := m.recentCloses(position.MarketID, timeframe, lookback+1)
+			if err != nil {
+				return nil, err
+			}
+			assetReturns, benchmarkReturns := alignedReturns(closes, benchmarkCloses)
+			exposure.Beta = indicator.Beta(assetReturns, benchmarkReturns)
+		}
+
+		report.TotalValue += exposure.Value
+		report.Positions = append(report.Positions, exposure)
+	}
+
+	for i := range report.Positions {
+		p := &report.Positions[i]
+		if report.TotalValue > 0 {
+			p.Weight = p.Value / report.TotalValue
+		}
+		p.Exposure = p.Value * p.Beta / 100
+		report.PortfolioBeta += p.Weight * p.Beta
+		report.Exposure += p.Exposure
+	}
+
+	return report, nil
+}
+
+// recentCloses 저장된 최근 캔들의 타임스탬프별 종가
+func (m *Manager) recentCloses(marketID, timeframe string, count int) (map[time.Time]float64, error) {
+	var candles []model.Candlestick
+	err := m.db.Where("market_id = ? AND timeframe = ?", marketID, timeframe).
+		Order("timestamp DESC").
+		Limit(count).
+		Find(&candles).Error
+	if err != nil {
+		return nil, fmt.Errorf("캔들 조회 실패: %w", err)
+	}
+
+	closes := make(map[time.Time]float64, len(candles))
+	for _, candle := range candles {
+		closes[candle.Timestamp.UTC()] = candle.Close
+	}
+
+	return closes, nil
+}
+
+// alignedReturns 두 마켓에 모두 있는 시점의 종가로 수익률 계산
+func alignedReturns(asset, benchmark map[time.Time]float64) ([]float64, []float64) {
+	var timestamps []time.Time
+	for ts := range asset {
+		if _, ok := benchmark[ts]; ok {
+			timestamps = append(timestamps, ts)
+		}
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i].Before(timestamps[j]) })
+
+	assetCloses := make([]float64, len(timestamps))
+	benchmarkCloses := make([]float64, len(timestamps))
+	for i, ts := range timestamps {
+		assetCloses[i] = asset[ts]
+		benchmarkCloses[i] = benchmark[ts]
+	}
+
+	return indicator.Returns(assetCloses), indicator.Returns(benchmarkCloses)
+}
@@ -0,0 +1,66 @@
+package risk
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// storeDailyCloses 마켓의 일봉 종가 저장 (시간순)
+func storeDailyCloses(t *testing.T, db *gorm.DB, marketID string, closes ...float64) {
+	t.Helper()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, c := range closes {
+		candle := model.Candlestick{MarketID: marketID, Timeframe: "days", Timestamp: start.AddDate(0, 0, i), Open: c, High: c, Low: c, Close: c, Volume: 1}
+		if err := db.Create(&candle).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestExposureReportWeightsBetaByValue(t *testing.T) {
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+
+	// ETH는 BTC 수익률의 두 배로 움직인다
+	storeDailyCloses(t, db, "KRW-BTC", 100, 101, 99.99, 102.9897)
+	storeDailyCloses(t, db, "KRW-ETH", 100, 102, 99.96, 105.9576)
+
+	positions := []model.Position{
+		{MarketID: "KRW-BTC", EntryPrice: 100, EntryTime: time.Now(), Quantity: 1000, Status: "OPEN", LastPrice: 100},
+		{MarketID: "KRW-ETH", EntryPrice: 100, EntryTime: time.Now(), Quantity: 3000, Status: "OPEN", LastPrice: 100},
+		{MarketID: "KRW-XRP", EntryPrice: 100, EntryTime: time.Now(), Quantity: 1000, Status: "CLOSED", LastPrice: 100},
+	}
+	for i := range positions {
+		if err := db.Create(&positions[i]).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := m.ExposureReport("", "", 0)
+	if err != nil {
+		t.Fatalf("ExposureReport 오류: %v", err)
+	}
+	if report.Benchmark != "KRW-BTC" || report.Timeframe != "days" || report.Lookback != defaultExposureLookback {
+		t.Fatalf("기본 설정 = %s %s %d", report.Benchmark, report.Timeframe, report.Lookback)
+	}
+	if len(report.Positions) != 2 || report.TotalValue != 400000 {
+		t.Fatalf("열린 포지션 %d개, 평가액 %v, want 2개, 400000", len(report.Positions), report.TotalValue)
+	}
+
+	eth := report.Positions[1]
+	if math.Abs(eth.Beta-2) > 1e-6 || eth.Weight != 0.75 {
+		t.Fatalf("ETH 노출 = %+v, want 베타 2, 비중 0.75", eth)
+	}
+	// 0.25 * 1 + 0.75 * 2
+	if math.Abs(report.PortfolioBeta-1.75) > 1e-6 {
+		t.Fatalf("포트폴리오 베타 = %v, want 1.75", report.PortfolioBeta)
+	}
+	// BTC 1% 변동 시 100,000 * 1% + 300,000 * 2%
+	if math.Abs(report.Exposure-7000) > 1e-3 {
+		t.Fatalf("노출 = %v, want 7000", report.Exposure)
+	}
+}
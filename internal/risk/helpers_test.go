@@ -0,0 +1,195 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/storage"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestDB 모든 모델을 마이그레이션한 테스트용 sqlite 데이터베이스
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("테스트 데이터베이스 열기 실패: %v", err)
+	}
+	if err := db.AutoMigrate(storage.Models()...); err != nil {
+		t.Fatalf("마이그레이션 실패: %v", err)
+	}
+	return db
+}
+
+// fakeClient 위험 관리자 테스트용 거래소
+type fakeClient struct {
+	mu       sync.Mutex
+	accounts []exchange.Account
+	candles  map[string][]exchange.Candle // 마켓/타임프레임별 캔들 (최신순)
+	orders   []fakeOrder
+	errRate  float64
+	requests int
+}
+
+// fakeOrder 테스트 거래소에 들어온 주문
+type fakeOrder struct {
+	marketID, side, orderType string
+	volume, price             float64
+}
+
+// krwAccount 원화 계좌
+func krwAccount(balance float64) exchange.Account {
+	return exchange.Account{Currency: "KRW", Balance: strconv.FormatFloat(balance, 'f', -1, 64), Locked: "0", AvgBuyPrice: "0"}
+}
+
+// coinAccount 코인 계좌
+func coinAccount(currency string, balance, avgPrice float64) exchange.Account {
+	return exchange.Account{
+		Currency:    currency,
+		Balance:     strconv.FormatFloat(balance, 'f', -1, 64),
+		Locked:      "0",
+		AvgBuyPrice: strconv.FormatFloat(avgPrice, 'f', -1, 64),
+	}
+}
+
+// setAccounts 계좌 잔고 교체
+func (f *fakeClient) setAccounts(accounts ...exchange.Account) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.accounts = accounts
+}
+
+func (f *fakeClient) GetAccounts(ctx context.Context) ([]exchange.Account, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]exchange.Account(nil), f.accounts...), nil
+}
+
+func (f *fakeClient) CreateOrder(ctx context.Context, marketID, side, orderType string, volume, price float64) (*exchange.OrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.orders = append(f.orders, fakeOrder{marketID: marketID, side: side, orderType: orderType, volume: volume, price: price})
+	return &exchange.OrderResponse{UUID: "order-" + strconv.Itoa(len(f.orders)), MarketID: marketID, Side: side, State: "wait"}, nil
+}
+
+func (f *fakeClient) GetCandles(ctx context.Context, marketID, timeframe string, count int) ([]exchange.Candle, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.candles[marketID+"/"+timeframe], nil
+}
+
+func (f *fakeClient) CancelAllOrders(ctx context.Context, marketID string) ([]exchange.OrderResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeClient) ErrorRate() (float64, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.errRate, f.requests
+}
+
+// expirePriceWrites 최근 가격 저장과 낙폭 확인 간격이 지난 것으로 처리 (시세를 연달아 반영하는 테스트용)
+func expirePriceWrites(m *Manager) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.priceWrites = make(map[string]time.Time)
+	m.drawdownCheckedAt = time.Time{}
+}
+
+// newTestManager 테스트용 위험 관리자
+func newTestManager(t *testing.T, client *fakeClient, cfg config.RiskConfig) (*Manager, *gorm.DB) {
+	t.Helper()
+	db := newTestDB(t)
+	return NewManager(db, client, &cfg), db
+}
+
+// fillingExchange 주문을 바로 전량 체결하는 주문 실행기용 거래소
+type fillingExchange struct {
+	mu     sync.Mutex
+	price  float64
+	orders []exchange.OrderResponse
+}
+
+// bids 들어온 매수 주문 수
+func (f *fillingExchange) bids() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	count := 0
+	for _, order := range f.orders {
+		if order.Side == "bid" {
+			count++
+		}
+	}
+	return count
+}
+
+func (f *fillingExchange) CreateOrder(ctx context.Context, marketID, side, orderType string, volume, price float64) (*exchange.OrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	order := exchange.OrderResponse{
+		UUID:           "order-" + strconv.Itoa(len(f.orders)+1),
+		MarketID:       marketID,
+		Side:           side,
+		OrderType:      orderType,
+		State:          "done",
+		ExecutedVolume: strconv.FormatFloat(volume, 'f', -1, 64),
+	}
+	f.orders = append(f.orders, order)
+	return &order, nil
+}
+
+func (f *fillingExchange) CancelOrder(ctx context.Context, uuid string) (*exchange.OrderResponse, error) {
+	return f.GetOrder(ctx, uuid)
+}
+
+func (f *fillingExchange) GetOrder(ctx context.Context, uuid string) (*exchange.OrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, order := range f.orders {
+		if order.UUID == uuid {
+			return &order, nil
+		}
+	}
+	return nil, fmt.Errorf("주문 없음: %s", uuid)
+}
+
+func (f *fillingExchange) GetOrderTrades(ctx context.Context, uuid string) ([]exchange.OrderTrade, error) {
+	order, err := f.GetOrder(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return []exchange.OrderTrade{{
+		UUID:   uuid + "-trade",
+		Price:  strconv.FormatFloat(f.price, 'f', -1, 64),
+		Volume: order.ExecutedVolume,
+	}}, nil
+}
+
+func (f *fillingExchange) GetAccounts(ctx context.Context) ([]exchange.Account, error) {
+	return nil, nil
+}
+
+func (f *fillingExchange) GetOrderChance(ctx context.Context, marketID string) (*exchange.OrderChance, error) {
+	return nil, errors.New("주문 가능 정보 없음")
+}
+
+func (f *fillingExchange) GetTicker(ctx context.Context, marketID string) (*exchange.Ticker, error) {
+	return &exchange.Ticker{MarketID: marketID, TradePrice: f.price}, nil
+}
+
+func (f *fillingExchange) GetOrderbook(ctx context.Context, marketID string) (*exchange.Orderbook, error) {
+	return nil, errors.New("호가 없음")
+}
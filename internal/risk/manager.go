@@ -0,0 +1,291 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/metrics"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/notify"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	pruneInterval           = time.Minute
+	recordMaxAge            = 24 * time.Hour
+	defaultSnapshotInterval = 5 * time.Minute
+	apiHealthInterval       = 10 * time.Second
+	metricsInterval         = 15 * time.Second
+)
+
+var (
+	// ErrReentryCooldown 손절 후 쿨다운 중
+	ErrReentryCooldown = errors.New("손절 후 재진입 쿨다운 중입니다")
+	// ErrReentryTrendBroken 상위 추세 이탈로 재진입 불가
+	ErrReentryTrendBroken = errors.New("상위 타임프레임 추세 이탈로 재진입이 연장 차단되었습니다")
+	// ErrMaxPositionsReached 최대 동시 포지션 수 도달
+	ErrMaxPositionsReached = errors.New("최대 동시 포지션 수에 도달했습니다")
+)
+
+// Client 위험 관리자가 사용하는 거래소 인터페이스
+// 모의 거래에서는 가상 계좌 잔고로 자산을 계산하도록 PaperClient를 넘긴다.
+type Client interface {
+	GetAccounts(ctx context.Context) ([]exchange.Account, error)
+	CreateOrder(ctx context.Context, marketID, side, orderType string, volume, price float64) (*exchange.OrderResponse, error)
+	GetCandles(ctx context.Context, marketID, timeframe string, count int) ([]exchange.Candle, error)
+	CancelAllOrders(ctx context.Context, marketID string) ([]exchange.OrderResponse, error)
+	ErrorRate() (float64, int)
+}
+
+// Manager 위험 관리자
+type Manager struct {
+	db       *gorm.DB
+	client   Client
+	cfg      config.RiskConfig
+	logger   *utils.Logger
+	notifier notify.Notifier
+	metrics  *metrics.Metrics
+	reentry  *ReentryGuard
+	sizer    *PositionSizer
+
+	mu          sync.Mutex
+	cancel      context.CancelFunc
+	paused      bool
+	pauseReason string
+	apiPaused   bool
+
+	trailingExits     map[string]time.Time // 마켓별 마지막 추적 손절 신호 시각
+	priceWrites       map[string]time.Time // 마켓별 마지막 최근 가격 저장 시각
+	drawdownCheckedAt time.Time            // 마지막 시세 기반 낙폭 확인 시각
+	dailyLossNotified time.Time            // 일일 손실 한도 알림을 보낸 날짜 (하루 한 번)
+	krw               float64              // 낙폭 계산용 KRW 잔고 캐시
+	krwAt             time.Time
+
+	ddMu     sync.Mutex
+	drawdown *model.DrawdownState
+}
+
+// ManagerOption 위험 관리자 옵션
+type ManagerOption func(*Manager)
+
+// WithNotifier 낙폭 차단과 일일 손실 한도 도달 알림 설정
+func WithNotifier(notifier notify.Notifier) ManagerOption {
+	return func(m *Manager) {
+		m.notifier = notifier
+	}
+}
+
+// WithMetrics 열린 포지션 수, 자산, 당일 실현 손익 지표 설정
+func WithMetrics(m *metrics.Metrics) ManagerOption {
+	return func(manager *Manager) {
+		manager.metrics = m
+	}
+}
+
+// NewManager 새로운 위험 관리자 생성
+func NewManager(db *gorm.DB, client Client, cfg *config.RiskConfig, opts ...ManagerOption) *Manager {
+	if cfg == nil {
+		cfg = &config.RiskConfig{}
+	}
+
+	var sizer *PositionSizer
+	if cfg.RiskPerTrade > 0 {
+		sizer = NewPositionSizer(client, cfg.RiskPerTrade, cfg.DustThreshold)
+	}
+
+	m := &Manager{
+		db:       db,
+		client:   client,
+		cfg:      *cfg,
+		logger:   utils.NewLogger("risk"),
+		notifier: notify.Nop{},
+		reentry:  NewReentryGuard(cfg.Reentry),
+		sizer:    sizer,
+
+		trailingExits: make(map[string]time.Time),
+		priceWrites:   make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Start 위험 관리 시작
+func (m *Manager) Start(ctx context.Context) {
+	m.restoreDrawdown()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.cancel != nil {
+		return
+	}
+	ctx, m.cancel = context.WithCancel(ctx)
+
+	go m.run(ctx)
+	m.logger.Info("위험 관리자 시작")
+}
+
+// Stop 위험 관리 중지
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	m.cancel = nil
+	m.logger.Info("위험 관리자 중지")
+}
+
+// run 주기적 정리 및 자산 스냅샷 작업
+func (m *Manager) run(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	snapshotInterval := defaultSnapshotInterval
+	if m.cfg.EquitySnapshotMinutes > 0 {
+		snapshotInterval = time.Duration(m.cfg.EquitySnapshotMinutes) * time.Minute
+	}
+	snapshotTicker := time.NewTicker(snapshotInterval)
+	defer snapshotTicker.Stop()
+
+	healthTicker := time.NewTicker(apiHealthInterval)
+	defer healthTicker.Stop()
+
+	metricsTicker := time.NewTicker(metricsInterval)
+	defer metricsTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.reentry.Prune(now, recordMaxAge)
+		case now := <-snapshotTicker.C:
+			m.snapshotEquity(ctx, now)
+		case <-healthTicker.C:
+			if m.cfg.APIErrorPause.Enabled {
+				m.checkAPIHealth()
+			}
+		case now := <-metricsTicker.C:
+			if m.metrics != nil {
+				m.updateMetrics(ctx, now)
+			}
+		}
+	}
+}
+
+// CheckEntry 신규 진입 신호 검증
+// 판단은 입력을 모은 뒤 decideEntry로 내리며, 재진입 검사는 앞선 검사를 통과했을 때만 수행한다.
+func (m *Manager) CheckEntry(ctx context.Context, signal *model.Signal) error {
+	now := time.Now()
+	in := EntryInputs{
+		MarketID:    signal.MarketID,
+		Now:         now,
+		Paused:      m.IsPaused(),
+		PauseReason: m.PauseReason(),
+		APIPaused:   m.IsAPIPaused(),
+		Blackouts:   m.cfg.Blackouts,
+	}
+
+	if m.cfg.MaxConcurrentPositions > 0 {
+		open, has, err := m.openPositions(signal.MarketID)
+		if err != nil {
+			m.logger.Error("열린 포지션 수 확인 실패, 진입 차단:", signal.MarketID, err)
+			return err
+		}
+		in.OpenCount, in.MaxOpen, in.HasPosition = open, m.cfg.MaxConcurrentPositions, has
+	}
+
+	// 손실 한도를 확인할 수 없으면 진입을 막는다
+	if m.dailyLossEnabled() {
+		status, err := m.DailyLoss(ctx)
+		if err != nil {
+			m.logger.Error("당일 손실 확인 실패, 진입 차단:", signal.MarketID, err)
+			return err
+		}
+		in.DailyLoss, in.DailyLimit = status.Loss, status.Limit
+	}
+
+	err := decideEntry(in)
+	if err == nil && m.cfg.Reentry.Enabled {
+		in.Reentry = reentryResult(m.checkReentry(ctx, signal.MarketID, now))
+		err = decideEntry(in)
+	}
+
+	outcome := OutcomeApprove
+	if err != nil {
+		outcome = OutcomeReject
+		m.logger.Info("진입 차단:", signal.MarketID, err)
+	}
+	if errors.Is(err, ErrDailyLossLimitReached) {
+		m.notifyDailyLoss(ctx, err, now)
+	}
+	m.recordDecision(DecisionEntry, signal.MarketID, signal.ID, outcome, errorReason(err), 0, in, now)
+
+	return err
+}
+
+// openPositions 다른 마켓의 열린 포지션 수와 해당 마켓의 열린 포지션 여부
+// 이미 포지션이 있는 마켓의 추가 매수는 포지션 수를 늘리지 않으므로 따로 센다.
+func (m *Manager) openPositions(marketID string) (int, bool, error) {
+	var markets []string
+	if err := m.db.Model(&model.Position{}).Where("status = ?", "OPEN").Pluck("market_id", &markets).Error; err != nil {
+		return 0, false, fmt.Errorf("열린 포지션 조회 실패: %w", err)
+	}
+
+	count, has := 0, false
+	for _, market := range markets {
+		if market == marketID {
+			has = true
+			continue
+		}
+		count++
+	}
+	return count, has, nil
+}
+
+// checkReentry 손절 후 재진입 검증
+func (m *Manager) checkReentry(ctx context.Context, marketID string, now time.Time) error {
+	var candles []exchange.Candle
+	if m.reentry.NeedsTrendCheck(marketID, now) {
+		var err error
+		candles, err = m.client.GetCandles(ctx, marketID, m.cfg.Reentry.TrendTimeframe, m.cfg.Reentry.TrendPeriod)
+		if err != nil {
+			// 추세를 확인할 수 없으면 추세 이탈로 간주한다
+			m.logger.Error("추세 캔들 조회 실패:", marketID, err)
+		}
+	}
+
+	return m.reentry.Check(marketID, now, candles)
+}
+
+// notify 알림 전송 (실패는 로그만 남김)
+func (m *Manager) notify(ctx context.Context, level, message string) {
+	if err := m.notifier.Send(ctx, level, message); err != nil {
+		m.logger.Error("알림 전송 실패:", err)
+	}
+}
+
+// OnPositionClosed 포지션 청산 처리
+func (m *Manager) OnPositionClosed(position *model.Position) {
+	if position.ExitReason == "STOP" {
+		m.reentry.RecordStop(position.MarketID, position.ExitTime)
+	}
+
+	m.mu.Lock()
+	delete(m.trailingExits, position.MarketID)
+	delete(m.priceWrites, position.MarketID)
+	m.mu.Unlock()
+}
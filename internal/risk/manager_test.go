@@ -0,0 +1,60 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestCheckEntryRejectsBeyondMaxConcurrentPositions(t *testing.T) {
+	const maxOpen = 3
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{MaxConcurrentPositions: maxOpen})
+	ctx := context.Background()
+
+	for i := 0; i < maxOpen; i++ {
+		marketID := fmt.Sprintf("KRW-C%d", i)
+		if err := m.CheckEntry(ctx, &model.Signal{MarketID: marketID, SignalType: "BUY"}); err != nil {
+			t.Fatalf("%d번째 진입 오류: %v", i+1, err)
+		}
+		position := model.Position{MarketID: marketID, EntryPrice: 1000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+		if err := db.Create(&position).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := m.CheckEntry(ctx, &model.Signal{MarketID: "KRW-NEW", SignalType: "BUY"}); !errors.Is(err, ErrMaxPositionsReached) {
+		t.Fatalf("%d번째 진입 오류 = %v, want ErrMaxPositionsReached", maxOpen+1, err)
+	}
+
+	// 이미 포지션이 있는 마켓의 추가 매수는 포지션 수를 늘리지 않는다
+	if err := m.CheckEntry(ctx, &model.Signal{MarketID: "KRW-C0", SignalType: "BUY"}); err != nil {
+		t.Fatalf("기존 포지션 추가 매수 오류: %v", err)
+	}
+
+	// 청산된 포지션은 세지 않는다
+	if err := db.Model(&model.Position{}).Where("market_id = ?", "KRW-C1").Update("status", "CLOSED").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := m.CheckEntry(ctx, &model.Signal{MarketID: "KRW-NEW", SignalType: "BUY"}); err != nil {
+		t.Fatalf("청산 후 진입 오류: %v", err)
+	}
+}
+
+func TestCheckEntryIgnoresPositionCountWhenUnlimited(t *testing.T) {
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+	for i := 0; i < 5; i++ {
+		position := model.Position{MarketID: fmt.Sprintf("KRW-C%d", i), EntryPrice: 1000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+		if err := db.Create(&position).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := m.CheckEntry(context.Background(), &model.Signal{MarketID: "KRW-NEW", SignalType: "BUY"}); err != nil {
+		t.Fatalf("제한 없음 진입 오류: %v", err)
+	}
+}
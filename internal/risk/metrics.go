@@ -0,0 +1,36 @@
+package risk
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// updateMetrics 열린 포지션 수, 시가 평가 자산, 당일 실현 손익 지표 갱신
+func (m *Manager) updateMetrics(ctx context.Context, now time.Time) {
+	var open int64
+	if err := m.db.Model(&model.Position{}).Where("status = ?", "OPEN").Count(&open).Error; err != nil {
+		m.logger.Error("열린 포지션 수 조회 실패:", err)
+	} else {
+		m.metrics.SetOpenPositions(int(open))
+	}
+
+	if equity, err := m.markToMarketEquity(ctx, now); err != nil {
+		m.logger.Error("시가 평가 자산 계산 실패:", err)
+	} else {
+		m.metrics.SetEquity(equity)
+	}
+
+	m.updateDailyProfitMetric(now)
+}
+
+// updateDailyProfitMetric 당일 실현 손익 지표 갱신
+func (m *Manager) updateDailyProfitMetric(now time.Time) {
+	profit, err := m.dailyProfit(now)
+	if err != nil {
+		m.logger.Error(err)
+		return
+	}
+	m.metrics.SetDailyProfit(profit)
+}
@@ -0,0 +1,52 @@
+package risk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// gaugeValue 레지스트리에서 게이지 값 조회
+func gaugeValue(t *testing.T, reg *prometheus.Registry, name string) float64 {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("지표 수집 실패: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() == name {
+			return family.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+	t.Fatalf("%s 지표 없음", name)
+	return 0
+}
+
+func TestUpdateMetricsSetsGauges(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(900000))
+	db := newTestDB(t)
+	m := NewManager(db, client, &config.RiskConfig{}, WithMetrics(metrics.New(reg)))
+	openDrawdownPosition(t, m)
+
+	now := time.Now()
+	if err := m.RecordRealized("KRW-ETH", -30000, -3, now); err != nil {
+		t.Fatalf("실현 손익 기록 실패: %v", err)
+	}
+	m.updateMetrics(context.Background(), now)
+
+	if got := gaugeValue(t, reg, "upbit_bot_open_positions"); got != 1 {
+		t.Fatalf("열린 포지션 수 = %v, want 1", got)
+	}
+	if got := gaugeValue(t, reg, "upbit_bot_equity_krw"); got != 1000000 {
+		t.Fatalf("자산 = %v, want 1000000", got)
+	}
+	if got := gaugeValue(t, reg, "upbit_bot_daily_realized_profit_krw"); got != -30000 {
+		t.Fatalf("당일 실현 손익 = %v, want -30000", got)
+	}
+}
@@ -0,0 +1,108 @@
+package risk
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/indicator"
+)
+
+// stopRecord 손절 기록
+type stopRecord struct {
+	stoppedAt    time.Time
+	blockedUntil time.Time
+}
+
+// ReentryGuard 손절 후 재진입 가드
+// 손절 직후에는 쿨다운 동안 진입을 막고, 쿨다운이 끝난 뒤에도
+// 상위 타임프레임 추세가 유지될 때만 재진입을 허용한다. 추세가 꺾였다면 쿨다운을 연장한다.
+type ReentryGuard struct {
+	cfg     config.ReentryConfig
+	mu      sync.Mutex
+	records map[string]*stopRecord
+}
+
+// NewReentryGuard 새로운 재진입 가드 생성
+func NewReentryGuard(cfg config.ReentryConfig) *ReentryGuard {
+	return &ReentryGuard{
+		cfg:     cfg,
+		records: make(map[string]*stopRecord),
+	}
+}
+
+// RecordStop 손절 기록
+func (g *ReentryGuard) RecordStop(marketID string, at time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.records[marketID] = &stopRecord{
+		stoppedAt:    at,
+		blockedUntil: at.Add(time.Duration(g.cfg.CooldownMinutes) * time.Minute),
+	}
+}
+
+// NeedsTrendCheck 쿨다운이 끝나 추세 확인이 필요한지 여부
+func (g *ReentryGuard) NeedsTrendCheck(marketID string, now time.Time) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	record, ok := g.records[marketID]
+	return ok && !now.Before(record.blockedUntil)
+}
+
+// Check 재진입 허용 여부 확인
+// candles는 상위 타임프레임 캔들이며 업비트 응답과 같이 최신 캔들이 먼저 온다.
+func (g *ReentryGuard) Check(marketID string, now time.Time, candles []exchange.Candle) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	record, ok := g.records[marketID]
+	if !ok {
+		return nil
+	}
+
+	if now.Before(record.blockedUntil) {
+		return ErrReentryCooldown
+	}
+
+	if trendIntact(candles, g.cfg.TrendPeriod) {
+		delete(g.records, marketID)
+		return nil
+	}
+
+	// 추세 이탈 시 쿨다운 연장
+	record.blockedUntil = now.Add(time.Duration(g.cfg.ExtendedCooldownMinutes) * time.Minute)
+	return ErrReentryTrendBroken
+}
+
+// Prune 만료된 기록 정리
+// 쿨다운이 끝난 뒤에도 오래 재진입 시도가 없던 기록을 제거한다.
+func (g *ReentryGuard) Prune(now time.Time, maxAge time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for marketID, record := range g.records {
+		if now.Sub(record.blockedUntil) > maxAge {
+			delete(g.records, marketID)
+		}
+	}
+}
+
+// trendIntact 마지막 종가가 이동평균 위에 있는지 확인
+func trendIntact(candles []exchange.Candle, period int) bool {
+	if period <= 0 || len(candles) < period {
+		return false
+	}
+
+	// 업비트 캔들은 최신순이므로 시간순으로 뒤집는다
+	closes := make([]float64, len(candles))
+	for i, candle := range candles {
+		closes[len(candles)-1-i] = candle.TradePrice
+	}
+
+	sma := indicator.SMA(closes, period)
+	last := len(closes) - 1
+	return closes[last] >= sma[last]
+}
@@ -0,0 +1,123 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// newestFirst 시간순 종가를 업비트 응답처럼 최신순 캔들로 변환
+func newestFirst(closes ...float64) []exchange.Candle {
+	candles := make([]exchange.Candle, len(closes))
+	for i, c := range closes {
+		candles[len(closes)-1-i] = exchange.Candle{TradePrice: c}
+	}
+	return candles
+}
+
+func TestReentryGuardAllowsReentryWhenTrendIntact(t *testing.T) {
+	guard := NewReentryGuard(config.ReentryConfig{CooldownMinutes: 30, ExtendedCooldownMinutes: 60, TrendPeriod: 3})
+	stoppedAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	guard.RecordStop("KRW-BTC", stoppedAt)
+
+	rising := newestFirst(100, 101, 102, 103, 104)
+	if err := guard.Check("KRW-BTC", stoppedAt.Add(10*time.Minute), rising); !errors.Is(err, ErrReentryCooldown) {
+		t.Fatalf("쿨다운 중 오류 = %v, want ErrReentryCooldown", err)
+	}
+
+	afterCooldown := stoppedAt.Add(31 * time.Minute)
+	if !guard.NeedsTrendCheck("KRW-BTC", afterCooldown) {
+		t.Fatal("쿨다운이 끝났는데 추세 확인이 필요하지 않다고 판단함")
+	}
+	if err := guard.Check("KRW-BTC", afterCooldown, rising); err != nil {
+		t.Fatalf("추세 유지 시 재진입 거부: %v", err)
+	}
+	if guard.NeedsTrendCheck("KRW-BTC", afterCooldown) {
+		t.Fatal("재진입을 허용한 뒤에도 손절 기록이 남아 있음")
+	}
+}
+
+func TestReentryGuardExtendsCooldownWhenTrendBroken(t *testing.T) {
+	guard := NewReentryGuard(config.ReentryConfig{CooldownMinutes: 30, ExtendedCooldownMinutes: 60, TrendPeriod: 3})
+	stoppedAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	guard.RecordStop("KRW-BTC", stoppedAt)
+
+	falling := newestFirst(104, 103, 102, 101, 100)
+	checkedAt := stoppedAt.Add(31 * time.Minute)
+	if err := guard.Check("KRW-BTC", checkedAt, falling); !errors.Is(err, ErrReentryTrendBroken) {
+		t.Fatalf("추세 이탈 시 오류 = %v, want ErrReentryTrendBroken", err)
+	}
+
+	// 연장된 쿨다운 동안은 추세가 회복되어도 막는다
+	rising := newestFirst(100, 101, 102, 103, 104)
+	if err := guard.Check("KRW-BTC", checkedAt.Add(59*time.Minute), rising); !errors.Is(err, ErrReentryCooldown) {
+		t.Fatalf("연장된 쿨다운 중 오류 = %v, want ErrReentryCooldown", err)
+	}
+	if err := guard.Check("KRW-BTC", checkedAt.Add(61*time.Minute), rising); err != nil {
+		t.Fatalf("연장된 쿨다운 후 추세 회복 시 재진입 거부: %v", err)
+	}
+}
+
+func TestReentryGuardIgnoresMarketsWithoutStop(t *testing.T) {
+	guard := NewReentryGuard(config.ReentryConfig{CooldownMinutes: 30, TrendPeriod: 3})
+	if err := guard.Check("KRW-ETH", time.Now(), nil); err != nil {
+		t.Fatalf("손절 기록이 없는 마켓 진입 거부: %v", err)
+	}
+}
+
+func TestExecutorStopFillBlocksNextEntry(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(1000000))
+	m, db := newTestManager(t, client, config.RiskConfig{Reentry: config.ReentryConfig{Enabled: true, CooldownMinutes: 30, TrendPeriod: 3}})
+
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 50000, EntryTime: time.Now().Add(-time.Hour), Quantity: 2, Status: "OPEN", LastPrice: 45000}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	// 전략 관리자가 저장해 전달하는 추적 손절 신호
+	stop := model.Signal{MarketID: "KRW-BTC", StrategyName: trailingStopSource, SignalType: "SELL", Confidence: 1, Timestamp: time.Now(), Parameters: model.Parameters{"exit_reason": "STOP"}}
+	if err := db.Create(&stop).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	ex := &fillingExchange{price: 45000}
+	signals := make(chan model.Signal)
+	e := exchange.NewOrderExecutor(db, ex, m, signals, nil, &config.TradingConfig{EntryOrderType: "market", MaxPositionSize: 10, OrderPollSeconds: 1})
+	e.Start(context.Background())
+	defer e.Stop()
+
+	signals <- stop
+
+	// 주문 추적 루프가 손절 매도 체결을 반영해 포지션을 닫을 때까지 기다린다
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if err := db.First(&position, position.ID).Error; err != nil {
+			t.Fatal(err)
+		}
+		if position.Status == "CLOSED" {
+			if position.ExitReason != "STOP" {
+				t.Fatalf("청산 사유 = %q, want STOP", position.ExitReason)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("포지션 상태 = %s, want CLOSED", position.Status)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	signals <- model.Signal{MarketID: "KRW-BTC", SignalType: "BUY", Price: 45000, Confidence: 1, Timestamp: time.Now()}
+	e.Stop()
+
+	if bids := ex.bids(); bids != 0 {
+		t.Fatalf("손절 직후 매수 주문 수 = %d, want 0", bids)
+	}
+	if err := m.CheckEntry(context.Background(), &model.Signal{MarketID: "KRW-BTC", SignalType: "BUY"}); !errors.Is(err, ErrReentryCooldown) {
+		t.Fatalf("손절 직후 진입 확인 오류 = %v, want ErrReentryCooldown", err)
+	}
+}
@@ -0,0 +1,81 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+)
+
+// ErrInvalidStopPrice 손절가가 진입가 이상이라 위험 거리를 계산할 수 없음
+var ErrInvalidStopPrice = errors.New("손절가는 진입가보다 낮아야 합니다")
+
+// PositionSizer 거래당 위험 기준 포지션 크기 계산기
+// 진입가에서 손절가까지 내려갔을 때의 손실이 총 자산의 riskPercent(%)가 되도록 수량을 정한다.
+type PositionSizer struct {
+	client        Client
+	riskPercent   float64
+	dustThreshold float64
+}
+
+// NewPositionSizer 새로운 포지션 크기 계산기 생성
+func NewPositionSizer(client Client, riskPercent, dustThreshold float64) *PositionSizer {
+	return &PositionSizer{
+		client:        client,
+		riskPercent:   riskPercent,
+		dustThreshold: dustThreshold,
+	}
+}
+
+// Size 진입가와 손절가로 주문 수량 계산
+// 위험 금액은 총 자산 기준이지만, 주문 금액은 사용할 수 있는 KRW 잔고를 넘지 않도록 줄인다.
+func (s *PositionSizer) Size(ctx context.Context, entryPrice, stopPrice float64) (float64, error) {
+	if entryPrice <= 0 || stopPrice <= 0 || stopPrice >= entryPrice {
+		return 0, fmt.Errorf("%w: 진입가 %.8g, 손절가 %.8g", ErrInvalidStopPrice, entryPrice, stopPrice)
+	}
+
+	accounts, err := s.client.GetAccounts(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("계정 정보 조회 실패: %w", err)
+	}
+
+	equity := accountsEquity(exchange.HoldingAccounts(accounts, s.dustThreshold))
+	available := krwAvailable(accounts)
+
+	return riskSizedVolume(equity, available, s.riskPercent, entryPrice, stopPrice), nil
+}
+
+// riskSizedVolume 거래당 위험 금액을 손절 거리로 나눈 수량 (KRW 잔고 한도 적용)
+func riskSizedVolume(equity, available, riskPercent, entryPrice, stopPrice float64) float64 {
+	if equity <= 0 || riskPercent <= 0 {
+		return 0
+	}
+
+	volume := equity * riskPercent / 100 / (entryPrice - stopPrice)
+	if volume*entryPrice > available {
+		volume = available / entryPrice
+	}
+	if volume < 0 {
+		return 0
+	}
+	return volume
+}
+
+// krwAvailable 주문에 사용할 수 있는 KRW 잔고 (미체결 주문에 묶인 금액 제외)
+func krwAvailable(accounts []exchange.Account) float64 {
+	for _, account := range accounts {
+		if account.Currency == "KRW" {
+			return account.Available()
+		}
+	}
+	return 0
+}
+
+// SizePosition 거래당 위험 기준 매수 수량 (거래당 위험이 설정되지 않았으면 0)
+func (m *Manager) SizePosition(ctx context.Context, entryPrice, stopPrice float64) (float64, error) {
+	if m.sizer == nil {
+		return 0, nil
+	}
+	return m.sizer.Size(ctx, entryPrice, stopPrice)
+}
@@ -0,0 +1,45 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPositionSizerRisksFixedFractionOfEquity(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(1000000))
+	sizer := NewPositionSizer(client, 1, 0)
+
+	// 자산 100만원의 1% = 1만원, 손절 거리 5,000원 -> 2개
+	volume, err := sizer.Size(context.Background(), 100000, 95000)
+	if err != nil {
+		t.Fatalf("Size 오류: %v", err)
+	}
+	if math.Abs(volume-2) > 1e-9 {
+		t.Fatalf("수량 = %v, want 2", volume)
+	}
+}
+
+func TestPositionSizerCapsAtAvailableKRW(t *testing.T) {
+	client := &fakeClient{}
+	client.setAccounts(krwAccount(100000), coinAccount("BTC", 1, 900000))
+	sizer := NewPositionSizer(client, 1, 0)
+
+	// 위험 기준으로는 2개(20만원)지만 KRW 잔고 10만원으로 살 수 있는 1개로 줄인다
+	volume, err := sizer.Size(context.Background(), 100000, 95000)
+	if err != nil {
+		t.Fatalf("Size 오류: %v", err)
+	}
+	if math.Abs(volume-1) > 1e-9 {
+		t.Fatalf("수량 = %v, want 1", volume)
+	}
+}
+
+func TestPositionSizerRejectsStopAboveEntry(t *testing.T) {
+	sizer := NewPositionSizer(&fakeClient{}, 1, 0)
+	if _, err := sizer.Size(context.Background(), 100000, 100000); !errors.Is(err, ErrInvalidStopPrice) {
+		t.Fatalf("Size 오류 = %v, want ErrInvalidStopPrice", err)
+	}
+}
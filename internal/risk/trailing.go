@@ -0,0 +1,135 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+const (
+	// trailingStopSource 추적 손절 신호의 전략 이름
+	trailingStopSource = "TRAILING_STOP"
+	// trailingExitRetry 청산 주문이 처리되지 않았을 때 같은 마켓에 신호를 다시 보내는 간격
+	trailingExitRetry = time.Minute
+	// priceWriteInterval 마켓별 최근 가격 저장과 시세 기반 낙폭 확인의 최소 간격
+	priceWriteInterval = time.Second
+)
+
+// trailingStop 최고가 갱신 및 추적 손절 판단 (입력만으로 결정되는 함수)
+// 최고가는 오르기만 하므로 손절가도 내려가지 않는다. 최고가가 진입가 대비 trigger(%) 이상 오르기 전에는
+// 추적하지 않으며, 추적 중 가격이 손절가 이하로 내려가면 hit를 돌려준다.
+func trailingStop(position *model.Position, price, trigger float64) (stop float64, hit bool) {
+	if position.TrailingStopPercent <= 0 || price <= 0 {
+		return 0, false
+	}
+	if price > position.HighestPrice {
+		position.HighestPrice = price
+	}
+	if position.HighestPrice < position.EntryPrice*(1+trigger/100) {
+		return 0, false
+	}
+
+	stop = position.HighestPrice * (1 - position.TrailingStopPercent/100)
+	return stop, price <= stop
+}
+
+// CheckExit 시세마다 열린 포지션의 낙폭 차단기와 추적 손절 확인
+// 포지션의 최근 가격은 노출도·비중 계산에도 쓰이므로 갱신하되, 시세마다 DB에 쓰지 않도록 가격이 바뀌었을 때 마켓별로 priceWriteInterval에 한 번만 저장한다.
+// 낙폭 한도가 설정되어 있으면 가격을 저장할 때 시가 평가 자산의 낙폭을 확인하며, 열린 포지션 전체를 조회하므로 전체에서도 priceWriteInterval에 한 번만 확인한다.
+// 추적 손절은 최고가를 포지션에 기록하고, 손절가에 닿으면 청산 사유 STOP을 담은 시장가 매도 신호를 돌려준다.
+// 사유는 매도가 체결되어 포지션이 닫힐 때 주문 실행기가 신호에서 읽어 기록한다.
+// 청산 주문이 처리되는 동안 신호가 반복되지 않도록 같은 마켓에는 trailingExitRetry 간격으로만 보낸다.
+func (m *Manager) CheckExit(ctx context.Context, ticker exchange.Ticker) *model.Signal {
+	var position model.Position
+	err := m.db.Where("market_id = ? AND status = ?", ticker.MarketID, "OPEN").First(&position).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		m.logger.Error("포지션 조회 실패:", ticker.MarketID, err)
+		return nil
+	}
+
+	now := time.Now()
+	if ticker.TradePrice > 0 && ticker.TradePrice != position.LastPrice && m.allowPriceWrite(ticker.MarketID, now) {
+		if err := m.db.Model(&position).Update("last_price", ticker.TradePrice).Error; err != nil {
+			m.logger.Error("최근 가격 저장 실패:", ticker.MarketID, err)
+		}
+		if m.allowDrawdownCheck(now) {
+			m.updateDrawdown(ctx, now, false)
+		}
+	}
+
+	if position.TrailingStopPercent <= 0 {
+		if !m.cfg.TrailingStop.Enabled || m.cfg.TrailingStop.Percent <= 0 {
+			return nil
+		}
+		position.TrailingStopPercent = m.cfg.TrailingStop.Percent
+	}
+
+	highest := position.HighestPrice
+	stop, hit := trailingStop(&position, ticker.TradePrice, m.cfg.TrailingStop.TriggerPercent)
+	if position.HighestPrice != highest {
+		updates := map[string]interface{}{
+			"highest_price":         position.HighestPrice,
+			"trailing_stop_percent": position.TrailingStopPercent,
+		}
+		if err := m.db.Model(&position).Updates(updates).Error; err != nil {
+			m.logger.Error("최고가 저장 실패:", ticker.MarketID, err)
+		}
+	}
+	if !hit {
+		return nil
+	}
+
+	m.mu.Lock()
+	if last, ok := m.trailingExits[ticker.MarketID]; ok && now.Sub(last) < trailingExitRetry {
+		m.mu.Unlock()
+		return nil
+	}
+	m.trailingExits[ticker.MarketID] = now
+	m.mu.Unlock()
+
+	m.logger.Info("추적 손절:", ticker.MarketID, ticker.TradePrice, "손절가", stop, "최고가", position.HighestPrice)
+
+	// 가격을 비워 두면 주문 실행기가 시장가로 청산한다
+	return &model.Signal{
+		MarketID:     ticker.MarketID,
+		StrategyName: trailingStopSource,
+		SignalType:   "SELL",
+		Confidence:   1,
+		Timestamp:    now,
+		Parameters: model.Parameters{
+			"exit_reason":   "STOP",
+			"trailing_stop": stop,
+			"highest_price": position.HighestPrice,
+			"trade_price":   ticker.TradePrice,
+		},
+	}
+}
+
+// allowPriceWrite 마켓의 최근 가격을 저장할 차례인지 확인 (차례면 저장 시각 기록)
+func (m *Manager) allowPriceWrite(marketID string, now time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if last, ok := m.priceWrites[marketID]; ok && now.Sub(last) < priceWriteInterval {
+		return false
+	}
+	m.priceWrites[marketID] = now
+	return true
+}
+
+// allowDrawdownCheck 시세 기반 낙폭 확인 차례인지 확인 (차례면 확인 시각 기록)
+func (m *Manager) allowDrawdownCheck(now time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if now.Sub(m.drawdownCheckedAt) < priceWriteInterval {
+		return false
+	}
+	m.drawdownCheckedAt = now
+	return true
+}
@@ -0,0 +1,150 @@
+package risk
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestTrailingStopWaitsForTrigger(t *testing.T) {
+	position := model.Position{EntryPrice: 100, TrailingStopPercent: 5}
+
+	// 최고가 105는 진입가 대비 10% 발동 기준에 못 미친다
+	if stop, hit := trailingStop(&position, 105, 10); stop != 0 || hit {
+		t.Fatalf("발동 전 손절 = %v, %v, want 0, false", stop, hit)
+	}
+	if stop, hit := trailingStop(&position, 90, 10); stop != 0 || hit {
+		t.Fatalf("발동 전 하락 손절 = %v, %v, want 0, false", stop, hit)
+	}
+	if position.HighestPrice != 105 {
+		t.Fatalf("최고가 = %v, want 105", position.HighestPrice)
+	}
+}
+
+func TestCheckExitRatchetsStopAndSellsAtRatchetedLevel(t *testing.T) {
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{
+		TrailingStop: config.TrailingStopConfig{Enabled: true, Percent: 5, TriggerPercent: 10},
+	})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	// 오르는 동안 손절가는 최고가를 따라 올라가고, 되돌림에서도 내려가지 않는다
+	for _, price := range []float64{105000, 112000, 120000, 116000, 118000, 114500} {
+		if signal := m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: price}); signal != nil {
+			t.Fatalf("가격 %v에서 청산 신호: %+v", price, signal)
+		}
+	}
+
+	var stored model.Position
+	if err := db.First(&stored, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.HighestPrice != 120000 || stored.TrailingStopPercent != 5 {
+		t.Fatalf("저장된 최고가 = %v, 손절 폭 = %v, want 120000, 5", stored.HighestPrice, stored.TrailingStopPercent)
+	}
+
+	// 손절가는 120,000 × 95% = 114,000원
+	signal := m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: 114000})
+	if signal == nil || signal.SignalType != "SELL" || signal.StrategyName != trailingStopSource {
+		t.Fatalf("청산 신호 = %+v, want 추적 손절 SELL", signal)
+	}
+	if stop := signal.Parameters["trailing_stop"].(float64); math.Abs(stop-114000) > 1e-6 {
+		t.Fatalf("손절가 = %v, want 114000", stop)
+	}
+	// 청산 사유는 신호에 담고, 매도가 체결되기 전의 포지션에는 쓰지 않는다
+	if signal.Parameters["exit_reason"] != "STOP" {
+		t.Fatalf("신호 청산 사유 = %v, want STOP", signal.Parameters["exit_reason"])
+	}
+	if err := db.First(&stored, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.ExitReason != "" {
+		t.Fatalf("체결 전 포지션 청산 사유 = %q, want 없음", stored.ExitReason)
+	}
+
+	// 청산 주문이 처리되는 동안에는 신호를 반복하지 않는다
+	if signal := m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: 113000}); signal != nil {
+		t.Fatalf("재시도 간격 안에 신호 반복: %+v", signal)
+	}
+}
+
+func TestCheckExitIgnoresPositionWithoutTrailingStop(t *testing.T) {
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN"}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for _, price := range []float64{150000, 50000} {
+		if signal := m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: price}); signal != nil {
+			t.Fatalf("추적 손절 비활성인데 청산 신호: %+v", signal)
+		}
+	}
+}
+
+func TestCheckExitUpdatesLastPriceWithoutDrawdownGuard(t *testing.T) {
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN", LastPrice: 100000}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	m.CheckExit(context.Background(), exchange.Ticker{MarketID: "KRW-BTC", TradePrice: 123000})
+
+	var stored model.Position
+	if err := db.First(&stored, position.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.LastPrice != 123000 {
+		t.Fatalf("최근 가격 = %v, want 123000", stored.LastPrice)
+	}
+}
+
+func TestCheckExitThrottlesLastPriceWrites(t *testing.T) {
+	m, db := newTestManager(t, &fakeClient{}, config.RiskConfig{})
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100000, EntryTime: time.Now(), Quantity: 1, Status: "OPEN", LastPrice: 100000}
+	if err := db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	lastPrice := func() float64 {
+		t.Helper()
+		var stored model.Position
+		if err := db.First(&stored, position.ID).Error; err != nil {
+			t.Fatal(err)
+		}
+		return stored.LastPrice
+	}
+
+	m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: 101000})
+	if got := lastPrice(); got != 101000 {
+		t.Fatalf("최근 가격 = %v, want 101000", got)
+	}
+
+	// 저장 간격 안의 시세는 DB에 쓰지 않는다
+	m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: 102000})
+	if got := lastPrice(); got != 101000 {
+		t.Fatalf("간격 안의 최근 가격 = %v, want 101000 유지", got)
+	}
+
+	// 간격이 지나도 가격이 같으면 쓰지 않고 저장 차례도 남겨 둔다
+	expirePriceWrites(m)
+	m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: 101000})
+	if _, ok := m.priceWrites["KRW-BTC"]; ok {
+		t.Fatal("가격이 같은데 저장 차례를 소비함")
+	}
+
+	m.CheckExit(ctx, exchange.Ticker{MarketID: "KRW-BTC", TradePrice: 103000})
+	if got := lastPrice(); got != 103000 {
+		t.Fatalf("간격 후 최근 가격 = %v, want 103000", got)
+	}
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultCandleBatchSize 캔들 일괄 저장 기본 배치 크기
+const DefaultCandleBatchSize = 500
+
+// SaveCandles 설정된 배치 크기로 캔들 일괄 저장
+func (d *Database) SaveCandles(candles []model.Candlestick) error {
+	return SaveCandles(d.db, candles, d.candleBatchSize)
+}
+
+// SaveCandles 캔들을 배치 단위로 일괄 저장 (batchSize가 0 이하면 기본값)
+// (market_id, timeframe, timestamp)가 같은 캔들이 이미 있으면 시가, 고가, 저가, 종가, 거래량을 갱신하므로
+// 같은 구간을 다시 백필해도 중복 행이 생기지 않는다.
+func SaveCandles(db *gorm.DB, candles []model.Candlestick, batchSize int) error {
+	candles = uniqueCandles(candles)
+	if len(candles) == 0 {
+		return nil
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultCandleBatchSize
+	}
+
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "market_id"}, {Name: "timeframe"}, {Name: "timestamp"}},
+		DoUpdates: clause.AssignmentColumns([]string{"open", "high", "low", "close", "volume", "updated_at"}),
+	}).CreateInBatches(candles, batchSize).Error
+	if err != nil {
+		return fmt.Errorf("캔들 저장 실패: %w", err)
+	}
+
+	return nil
+}
+
+// uniqueCandles 같은 캔들이 여러 번 있으면 마지막 것만 남김
+// 한 INSERT 문 안에 충돌 키가 중복되면 PostgreSQL이 ON CONFLICT 갱신을 거부한다.
+func uniqueCandles(candles []model.Candlestick) []model.Candlestick {
+	type key struct {
+		marketID  string
+		timeframe string
+		timestamp int64
+	}
+
+	index := make(map[key]int, len(candles))
+	result := make([]model.Candlestick, 0, len(candles))
+	for _, candle := range candles {
+		k := key{candle.MarketID, candle.Timeframe, candle.Timestamp.UnixNano()}
+		if i, ok := index[k]; ok {
+			result[i] = candle
+			continue
+		}
+		index[k] = len(result)
+		result = append(result, candle)
+	}
+
+	return result
+}
+
+// GetCandles 마켓, 타임프레임의 from 이상 to 미만 캔들을 시간순으로 조회
+// to가 0이면 끝을 제한하지 않고, limit가 0 이하면 개수를 제한하지 않는다 (limit가 있으면 from부터 limit개).
+func (d *Database) GetCandles(marketID, timeframe string, from, to time.Time, limit int) ([]model.Candlestick, error) {
+	return GetCandles(d.db, marketID, timeframe, from, to, limit)
+}
+
+// GetCandles 마켓, 타임프레임의 from 이상 to 미만 캔들을 시간순으로 조회
+func GetCandles(db *gorm.DB, marketID, timeframe string, from, to time.Time, limit int) ([]model.Candlestick, error) {
+	query := db.Where("market_id = ? AND timeframe = ? AND timestamp >= ?", marketID, timeframe, from)
+	if !to.IsZero() {
+		query = query.Where("timestamp < ?", to)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var candles []model.Candlestick
+	if err := query.Order("timestamp ASC").Find(&candles).Error; err != nil {
+		return nil, fmt.Errorf("캔들 조회 실패: %w", err)
+	}
+
+	return candles, nil
+}
+
+// GetLatestCandle 마켓, 타임프레임의 가장 최근 저장 캔들 (없으면 nil)
+func (d *Database) GetLatestCandle(marketID, timeframe string) (*model.Candlestick, error) {
+	return GetLatestCandle(d.db, marketID, timeframe)
+}
+
+// GetLatestCandle 마켓, 타임프레임의 가장 최근 저장 캔들 (없으면 nil)
+func GetLatestCandle(db *gorm.DB, marketID, timeframe string) (*model.Candlestick, error) {
+	var candle model.Candlestick
+	err := db.Where("market_id = ? AND timeframe = ?", marketID, timeframe).
+		Order("timestamp DESC").
+		First(&candle).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("최근 캔들 조회 실패: %w", err)
+	}
+
+	return &candle, nil
+}
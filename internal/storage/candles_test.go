@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// minuteCandles start부터 1분 간격 캔들 n개 (종가 = close)
+func minuteCandles(start time.Time, n int, close float64) []model.Candlestick {
+	candles := make([]model.Candlestick, n)
+	for i := range candles {
+		candles[i] = model.Candlestick{
+			MarketID:  "KRW-BTC",
+			Timeframe: "minutes/1",
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+			Open:      close,
+			High:      close,
+			Low:       close,
+			Close:     close,
+			Volume:    1,
+		}
+	}
+	return candles
+}
+
+func TestSaveCandlesUpsertsWithoutDuplicates(t *testing.T) {
+	db := newMemoryDatabase(t).GetDB()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := SaveCandles(db, minuteCandles(start, 25, 100), 10); err != nil {
+		t.Fatalf("SaveCandles 오류: %v", err)
+	}
+	// 같은 구간을 다시 백필하면 행이 늘지 않고 값만 갱신된다
+	if err := SaveCandles(db, minuteCandles(start.Add(20*time.Minute), 10, 200), 4); err != nil {
+		t.Fatalf("재백필 SaveCandles 오류: %v", err)
+	}
+
+	var count int64
+	db.Model(&model.Candlestick{}).Count(&count)
+	if count != 30 {
+		t.Fatalf("캔들 수 = %d, want 30", count)
+	}
+	var updated model.Candlestick
+	if err := db.Where("timestamp = ?", start.Add(20*time.Minute)).First(&updated).Error; err != nil {
+		t.Fatal(err)
+	}
+	if updated.Close != 200 {
+		t.Fatalf("겹친 캔들 종가 = %v, want 200", updated.Close)
+	}
+}
+
+func TestSaveCandlesKeepsLastDuplicateInBatch(t *testing.T) {
+	db := newMemoryDatabase(t).GetDB()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	candles := append(minuteCandles(start, 1, 100), minuteCandles(start, 1, 101)...)
+	if err := SaveCandles(db, candles, 0); err != nil {
+		t.Fatalf("SaveCandles 오류: %v", err)
+	}
+
+	var saved []model.Candlestick
+	db.Find(&saved)
+	if len(saved) != 1 || saved[0].Close != 101 {
+		t.Fatalf("저장된 캔들 = %+v, want 종가 101 1개", saved)
+	}
+}
+
+func TestUniqueIndexRejectsDuplicateCandle(t *testing.T) {
+	db := newMemoryDatabase(t).GetDB()
+	candle := minuteCandles(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 1, 100)[0]
+
+	if err := db.Create(&candle).Error; err != nil {
+		t.Fatal(err)
+	}
+	duplicate := candle
+	duplicate.ID = 0
+	if err := db.Create(&duplicate).Error; err == nil {
+		t.Fatal("중복 캔들이 저장됨, want 고유 인덱스 위반")
+	}
+}
+
+func BenchmarkSaveCandles(b *testing.B) {
+	candles := minuteCandles(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 2000, 100)
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			db := newBenchmarkDatabase(b)
+			if err := SaveCandles(db.GetDB(), candles, DefaultCandleBatchSize); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			db := newBenchmarkDatabase(b)
+			for j := range candles {
+				candle := candles[j]
+				if err := db.GetDB().Create(&candle).Error; err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func TestGetCandlesRangeIsHalfOpen(t *testing.T) {
+	db := newMemoryDatabase(t).GetDB()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := SaveCandles(db, minuteCandles(start, 10, 100), 0); err != nil {
+		t.Fatal(err)
+	}
+	other := minuteCandles(start, 3, 100)
+	for i := range other {
+		other[i].Timeframe = "minutes/5"
+	}
+	if err := SaveCandles(db, other, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// from은 포함, to는 제외
+	candles, err := GetCandles(db, "KRW-BTC", "minutes/1", start.Add(2*time.Minute), start.Add(5*time.Minute), 0)
+	if err != nil {
+		t.Fatalf("GetCandles 오류: %v", err)
+	}
+	if len(candles) != 3 || !candles[0].Timestamp.Equal(start.Add(2*time.Minute)) || !candles[2].Timestamp.Equal(start.Add(4*time.Minute)) {
+		t.Fatalf("캔들 = %d개 %v ~ %v, want 02분 ~ 04분 3개", len(candles), candles[0].Timestamp, candles[len(candles)-1].Timestamp)
+	}
+
+	// to가 0이면 끝까지, limit는 from부터 센다
+	candles, err = GetCandles(db, "KRW-BTC", "minutes/1", start.Add(5*time.Minute), time.Time{}, 2)
+	if err != nil {
+		t.Fatalf("GetCandles 오류: %v", err)
+	}
+	if len(candles) != 2 || !candles[0].Timestamp.Equal(start.Add(5*time.Minute)) || !candles[1].Timestamp.Equal(start.Add(6*time.Minute)) {
+		t.Fatalf("limit 캔들 = %+v, want 05분, 06분", candles)
+	}
+
+	candles, err = GetCandles(db, "KRW-BTC", "minutes/1", start, time.Time{}, 0)
+	if err != nil || len(candles) != 10 {
+		t.Fatalf("전체 1분봉 = %d개, %v, want 10개", len(candles), err)
+	}
+}
+
+func TestGetLatestCandle(t *testing.T) {
+	db := newMemoryDatabase(t).GetDB()
+
+	latest, err := GetLatestCandle(db, "KRW-BTC", "minutes/1")
+	if err != nil || latest != nil {
+		t.Fatalf("빈 테이블 GetLatestCandle = %v, %v, want nil, nil", latest, err)
+	}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := SaveCandles(db, minuteCandles(start, 10, 100), 0); err != nil {
+		t.Fatal(err)
+	}
+	latest, err = GetLatestCandle(db, "KRW-BTC", "minutes/1")
+	if err != nil {
+		t.Fatalf("GetLatestCandle 오류: %v", err)
+	}
+	if latest == nil || !latest.Timestamp.Equal(start.Add(9*time.Minute)) {
+		t.Fatalf("최근 캔들 = %+v, want 09분", latest)
+	}
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// 지원하는 데이터베이스 드라이버
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// Database 데이터베이스 연결
+type Database struct {
+	db              *gorm.DB
+	candleBatchSize int
+	logger          *utils.Logger
+}
+
+// NewDatabase 새로운 데이터베이스 연결 생성 (설정의 드라이버 postgres, sqlite 중 하나로 연결)
+func NewDatabase(cfg config.DatabaseConfig) (*Database, error) {
+	dialector, err := Dialector(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("데이터베이스 연결 실패: %w", err)
+	}
+
+	return &Database{
+		db:              db,
+		candleBatchSize: cfg.CandleBatchSize,
+		logger:          utils.NewLogger("storage"),
+	}, nil
+}
+
+// Dialector 설정의 드라이버에 맞는 GORM 다이얼렉터
+// postgres는 DSN이 있으면 그대로 쓰고 없으면 개별 항목으로 접속 문자열을 만든다.
+// sqlite는 DBName을 데이터베이스 파일 경로로 쓰며, ":memory:"이면 메모리 데이터베이스를 연다.
+func Dialector(cfg config.DatabaseConfig) (gorm.Dialector, error) {
+	switch cfg.Driver {
+	case DriverPostgres, "":
+		if cfg.DSN != "" {
+			return postgres.Open(cfg.DSN), nil
+		}
+		dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s TimeZone=Asia/Seoul",
+			cfg.Host, cfg.Port, cfg.Username, cfg.Password, cfg.DBName, cfg.SSLMode)
+		return postgres.Open(dsn), nil
+	case DriverSQLite:
+		if cfg.DBName == "" {
+			return nil, fmt.Errorf("sqlite 데이터베이스 파일 경로(dbname)가 없습니다")
+		}
+		return sqlite.Open(cfg.DBName), nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 데이터베이스 드라이버: %s", cfg.Driver)
+	}
+}
+
+// GetDB GORM 연결 반환
+func (d *Database) GetDB() *gorm.DB {
+	return d.db
+}
+
+// Close 데이터베이스 연결 종료
+func (d *Database) Close() error {
+	sqlDB, err := d.db.DB()
+	if err != nil {
+		return fmt.Errorf("데이터베이스 연결 조회 실패: %w", err)
+	}
+	return sqlDB.Close()
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// newMemoryDatabase 마이그레이션한 sqlite 메모리 데이터베이스
+// 메모리 데이터베이스는 연결마다 따로 생기므로 연결을 하나로 묶는다.
+func newMemoryDatabase(t testing.TB) *Database {
+	t.Helper()
+
+	d, err := NewDatabase(config.DatabaseConfig{Driver: DriverSQLite, DBName: ":memory:"})
+	if err != nil {
+		t.Fatalf("데이터베이스 연결 실패: %v", err)
+	}
+	sqlDB, err := d.GetDB().DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { d.Close() })
+
+	if err := d.Migrate(); err != nil {
+		t.Fatalf("마이그레이션 실패: %v", err)
+	}
+	return d
+}
+
+// newBenchmarkDatabase 벤치마크 반복마다 새로 만드는 메모리 데이터베이스 (타이머 제외)
+func newBenchmarkDatabase(b *testing.B) *Database {
+	b.StopTimer()
+	defer b.StartTimer()
+	return newMemoryDatabase(b)
+}
+
+func TestDialectorSelectsDriver(t *testing.T) {
+	cases := []struct {
+		cfg     config.DatabaseConfig
+		want    string
+		wantErr bool
+	}{
+		{cfg: config.DatabaseConfig{}, want: "postgres"},
+		{cfg: config.DatabaseConfig{Driver: DriverPostgres, DSN: "host=localhost"}, want: "postgres"},
+		{cfg: config.DatabaseConfig{Driver: DriverSQLite, DBName: ":memory:"}, want: "sqlite"},
+		{cfg: config.DatabaseConfig{Driver: DriverSQLite}, wantErr: true},
+		{cfg: config.DatabaseConfig{Driver: "mysql"}, wantErr: true},
+	}
+	for _, tc := range cases {
+		dialector, err := Dialector(tc.cfg)
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("Dialector(%+v) 오류 없음, want 오류", tc.cfg)
+			}
+			continue
+		}
+		if err != nil || dialector.Name() != tc.want {
+			t.Fatalf("Dialector(%+v) = %v, %v, want %s", tc.cfg, dialector, err, tc.want)
+		}
+	}
+}
+
+func TestSQLiteRoundTripsStrategyParameters(t *testing.T) {
+	db := newMemoryDatabase(t).GetDB()
+
+	saved := model.StrategyConfig{
+		MarketID:     "KRW-BTC",
+		StrategyName: "RSI",
+		Timeframe:    "minutes/5",
+		Enabled:      true,
+		Parameters:   model.Parameters{"period": 14.0, "timeframes": []interface{}{"minutes/5", "minutes/60"}, "note": "테스트"},
+	}
+	if err := db.Create(&saved).Error; err != nil {
+		t.Fatalf("전략 설정 저장 실패: %v", err)
+	}
+
+	var loaded model.StrategyConfig
+	if err := db.First(&loaded, saved.ID).Error; err != nil {
+		t.Fatalf("전략 설정 조회 실패: %v", err)
+	}
+	if loaded.Parameters["period"] != 14.0 || loaded.Parameters["note"] != "테스트" {
+		t.Fatalf("파라미터 = %v, want period 14, note 테스트", loaded.Parameters)
+	}
+	if timeframes, ok := loaded.Parameters["timeframes"].([]interface{}); !ok || len(timeframes) != 2 || timeframes[1] != "minutes/60" {
+		t.Fatalf("timeframes 파라미터 = %v, want [minutes/5 minutes/60]", loaded.Parameters["timeframes"])
+	}
+}
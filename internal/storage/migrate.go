@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// Models 마이그레이션 대상 모델
+func Models() []interface{} {
+	return []interface{}{
+		&model.Candlestick{},
+		&model.Signal{},
+		&model.Order{},
+		&model.Trade{},
+		&model.Position{},
+		&model.StrategyConfig{},
+		&model.PerformanceMetric{},
+		&model.DailyPerformance{},
+		&model.EquitySnapshot{},
+		&model.OrderEvent{},
+		&model.RiskDecision{},
+		&model.DrawdownState{},
+	}
+}
+
+// Migrate 모든 모델의 테이블 마이그레이션
+// AutoMigrate는 없는 테이블과 컬럼만 추가하므로 여러 번 실행해도 안전하며, 생성하거나 컬럼을 추가한 테이블은 로그로 남긴다.
+func (d *Database) Migrate() error {
+	migrator := d.db.Migrator()
+
+	for _, m := range Models() {
+		stmt := &gorm.Statement{DB: d.db}
+		if err := stmt.Parse(m); err != nil {
+			return fmt.Errorf("모델 분석 실패: %w", err)
+		}
+		table := stmt.Schema.Table
+
+		existed := migrator.HasTable(m)
+		var before map[string]bool
+		if existed {
+			columns, err := columnNames(d.db, m)
+			if err != nil {
+				return err
+			}
+			before = columns
+		}
+
+		if err := migrator.AutoMigrate(m); err != nil {
+			return fmt.Errorf("%s 마이그레이션 실패: %w", table, err)
+		}
+
+		if !existed {
+			d.logger.Info("테이블 생성:", table)
+			continue
+		}
+
+		after, err := columnNames(d.db, m)
+		if err != nil {
+			return err
+		}
+		var added []string
+		for _, field := range stmt.Schema.DBNames {
+			if after[field] && !before[field] {
+				added = append(added, field)
+			}
+		}
+		if len(added) > 0 {
+			d.logger.Info("테이블 변경:", table, "컬럼 추가", strings.Join(added, ", "))
+		}
+	}
+
+	return nil
+}
+
+// columnNames 테이블의 현재 컬럼 이름
+func columnNames(db *gorm.DB, m interface{}) (map[string]bool, error) {
+	columns, err := db.Migrator().ColumnTypes(m)
+	if err != nil {
+		return nil, fmt.Errorf("컬럼 조회 실패: %w", err)
+	}
+
+	names := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		names[column.Name()] = true
+	}
+	return names, nil
+}
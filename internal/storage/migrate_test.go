@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+func TestMigrateCreatesAllTables(t *testing.T) {
+	d := newMemoryDatabase(t)
+
+	for _, m := range Models() {
+		if !d.GetDB().Migrator().HasTable(m) {
+			stmt := &gorm.Statement{DB: d.GetDB()}
+			stmt.Parse(m)
+			t.Fatalf("테이블 없음: %s", stmt.Schema.Table)
+		}
+	}
+	if !d.GetDB().Migrator().HasIndex(&model.Candlestick{}, "idx_candles_market_timeframe_timestamp") {
+		t.Fatal("캔들 고유 인덱스 없음")
+	}
+}
+
+func TestMigrateIsIdempotent(t *testing.T) {
+	d := newMemoryDatabase(t)
+
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100, Quantity: 1, Status: "OPEN"}
+	if err := d.GetDB().Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// 다시 실행해도 오류 없이 기존 데이터를 유지한다
+	if err := d.Migrate(); err != nil {
+		t.Fatalf("재실행 마이그레이션 실패: %v", err)
+	}
+	var count int64
+	d.GetDB().Model(&model.Position{}).Count(&count)
+	if count != 1 {
+		t.Fatalf("재실행 후 포지션 수 = %d, want 1", count)
+	}
+}
+
+func TestMigrateUsesTextForJSONColumnsOnSQLite(t *testing.T) {
+	d := newMemoryDatabase(t)
+
+	for _, tc := range []struct {
+		model  interface{}
+		column string
+	}{
+		{&model.StrategyConfig{}, "parameters"},
+		{&model.Signal{}, "parameters"},
+		{&model.RiskDecision{}, "inputs"},
+	} {
+		columns, err := d.GetDB().Migrator().ColumnTypes(tc.model)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, column := range columns {
+			if column.Name() == tc.column && column.DatabaseTypeName() != "TEXT" {
+				t.Fatalf("%T.%s 컬럼 타입 = %s, want TEXT", tc.model, tc.column, column.DatabaseTypeName())
+			}
+		}
+	}
+
+	decision := model.RiskDecision{Kind: "ENTRY", Outcome: "APPROVE", Inputs: `{"market_id":"KRW-BTC"}`}
+	if err := d.GetDB().Create(&decision).Error; err != nil {
+		t.Fatalf("판단 저장 실패: %v", err)
+	}
+	var loaded model.RiskDecision
+	if err := d.GetDB().First(&loaded, decision.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Inputs != decision.Inputs {
+		t.Fatalf("판단 입력 = %s, want %s", loaded.Inputs, decision.Inputs)
+	}
+}
@@ -0,0 +1,69 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestEvaluateAlignsTimeframesToLastClosedCandle(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 50, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 9, 3, 30, 0, time.UTC)
+
+	// 1분봉은 09:03, 5분봉은 09:00 캔들이 아직 진행 중이다
+	buffers := map[string][]model.Candlestick{
+		"minutes/1": candleSeries("minutes/1", start, time.Minute, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14),
+		"minutes/5": candleSeries("minutes/5", start, 5*time.Minute, 100, 200, 300),
+	}
+	s := &recordingStrategy{name: "recording", timeframes: []string{"minutes/1", "minutes/5"}}
+	m := newBufferedManager(t, make(chan Signal, 1), buffers, now, &runner{strategy: s})
+
+	m.evaluate(context.Background(), exchange.MarketData{Type: exchange.DataTypeTicker, MarketID: "KRW-BTC", TradePrice: 14}, now)
+
+	if s.calls != 1 {
+		t.Fatalf("전략 평가 횟수 = %d, want 1", s.calls)
+	}
+	minute := s.candles["minutes/1"]
+	if last := minute[len(minute)-1].Timestamp; !last.Equal(time.Date(2024, 1, 1, 9, 2, 0, 0, time.UTC)) {
+		t.Fatalf("1분봉 마지막 캔들 = %s, want 09:02", last)
+	}
+	five := s.candles["minutes/5"]
+	if last := five[len(five)-1].Timestamp; !last.Equal(time.Date(2024, 1, 1, 8, 55, 0, 0, time.UTC)) {
+		t.Fatalf("5분봉 마지막 캔들 = %s, want 08:55", last)
+	}
+	for timeframe, candles := range s.candles {
+		for _, c := range candles {
+			if c.Provisional {
+				t.Fatalf("%s 마감되지 않은 캔들이 전달됨: %s", timeframe, c.Timestamp)
+			}
+		}
+	}
+
+	if p := s.provisional["minutes/5"]; !p.Provisional || !p.Timestamp.Equal(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Fatalf("5분봉 진행 중 캔들 = %+v, want 09:00 Provisional", p)
+	}
+	if p := s.provisional["minutes/1"]; !p.Provisional || !p.Timestamp.Equal(time.Date(2024, 1, 1, 9, 3, 0, 0, time.UTC)) {
+		t.Fatalf("1분봉 진행 중 캔들 = %+v, want 09:03 Provisional", p)
+	}
+}
+
+func TestConfiguredTimeframesMergesWithoutDuplicates(t *testing.T) {
+	cfg := model.StrategyConfig{
+		Timeframe:  "minutes/15",
+		Parameters: model.Parameters{"timeframes": []interface{}{"minutes/60", "minutes/15", "days"}},
+	}
+
+	got := ConfiguredTimeframes(cfg, "days", "minutes/240")
+	want := []string{"minutes/15", "minutes/60", "days", "minutes/240"}
+	if len(got) != len(want) {
+		t.Fatalf("타임프레임 = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("타임프레임 = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/indicator"
+)
+
+// BollingerStrategyName 볼린저 밴드 평균 회귀 전략 이름
+const BollingerStrategyName = "Bollinger Mean Reversion"
+
+// BollingerStrategy 볼린저 밴드 평균 회귀 전략
+// 종가가 하단 밴드 아래로 새로 내려가면 매수, 상단 밴드 위로 새로 올라가면 매도한다.
+// 밴드 폭은 신호 파라미터에 남겨 이후 스퀴즈 조건 필터에 쓸 수 있게 한다.
+type BollingerStrategy struct {
+	timeframes []string
+	period     int
+	stdDevMult float64
+	lastCandle time.Time
+}
+
+// NewBollingerStrategy 새로운 볼린저 밴드 전략 생성
+func NewBollingerStrategy(cfg model.StrategyConfig) (*BollingerStrategy, error) {
+	s := &BollingerStrategy{}
+	if err := s.Init(cfg); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Init 설정 파라미터로 전략 초기화
+func (s *BollingerStrategy) Init(cfg model.StrategyConfig) error {
+	*s = BollingerStrategy{
+		timeframes: ConfiguredTimeframes(cfg),
+		period:     intParam(cfg.Parameters, "period", 20),
+		stdDevMult: floatParam(cfg.Parameters, "std_dev_mult", 2.0),
+	}
+
+	if len(s.timeframes) == 0 {
+		return fmt.Errorf("타임프레임이 설정되지 않았습니다")
+	}
+	if s.period < 2 {
+		return fmt.Errorf("잘못된 period 값: %d", s.period)
+	}
+	if s.stdDevMult <= 0 {
+		return fmt.Errorf("표준편차 배수는 0보다 커야 합니다: %v", s.stdDevMult)
+	}
+
+	return nil
+}
+
+// Name 전략 이름
+func (s *BollingerStrategy) Name() string {
+	return BollingerStrategyName
+}
+
+// Timeframes 필요한 타임프레임 목록
+func (s *BollingerStrategy) Timeframes() []string {
+	return s.timeframes
+}
+
+// Evaluate 신호 평가
+// 직전 캔들까지 밴드 안에 있던 종가가 새로 마감된 캔들에서 밴드를 벗어났을 때만 신호를 낸다.
+// 캔들 수가 기간에 못 미치면 다른 전략처럼 신호 없이 넘어간다 (시작 직후 캔들이 쌓이는 동안).
+func (s *BollingerStrategy) Evaluate(candles map[string][]model.Candlestick, ticker exchange.Ticker) (*Signal, error) {
+	series := candles[s.timeframes[0]]
+	if len(series) < s.period+1 {
+		return nil, nil
+	}
+
+	last := series[len(series)-1]
+	if !last.Timestamp.After(s.lastCandle) {
+		return nil, nil
+	}
+	s.lastCandle = last.Timestamp
+
+	closes := make([]float64, len(series))
+	for i, candle := range series {
+		closes[i] = candle.Close
+	}
+	middle, upper, lower := indicator.BollingerBands(closes, s.period, s.stdDevMult)
+	n := len(closes) - 1
+	if middle[n] == 0 {
+		return nil, nil
+	}
+
+	halfWidth := upper[n] - middle[n]
+	var signalType string
+	var distance float64
+	switch {
+	case closes[n] < lower[n] && closes[n-1] >= lower[n-1]:
+		signalType, distance = "BUY", lower[n]-closes[n]
+	case closes[n] > upper[n] && closes[n-1] <= upper[n-1]:
+		signalType, distance = "SELL", closes[n]-upper[n]
+	default:
+		return nil, nil
+	}
+
+	confidence := 1.0
+	if halfWidth > 0 {
+		confidence = math.Min(1, distance/halfWidth)
+	}
+
+	return &Signal{
+		SignalType: signalType,
+		Price:      ticker.TradePrice,
+		Confidence: confidence,
+		Parameters: model.Parameters{
+			"period":       s.period,
+			"std_dev_mult": s.stdDevMult,
+			"middle":       middle[n],
+			"upper":        upper[n],
+			"lower":        lower[n],
+			"band_width":   (upper[n] - lower[n]) / middle[n], // 중심선 대비 밴드 폭 (스퀴즈 판단용)
+			"last_close":   last.Close,
+		},
+	}, nil
+}
@@ -0,0 +1,88 @@
+package strategy
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// newTestBollinger period 3, 표준편차 배수 1인 1분봉 볼린저 밴드 전략
+func newTestBollinger(t *testing.T) *BollingerStrategy {
+	t.Helper()
+	s, err := NewBollingerStrategy(model.StrategyConfig{
+		Timeframe:  "minutes/1",
+		Parameters: model.Parameters{"period": 3.0, "std_dev_mult": 1.0},
+	})
+	if err != nil {
+		t.Fatalf("볼린저 전략 생성 실패: %v", err)
+	}
+	return s
+}
+
+func TestBollingerSkipsInsufficientCandles(t *testing.T) {
+	s := newTestBollinger(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 100, 90)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 90})
+	if err != nil || signal != nil {
+		t.Fatalf("Evaluate = %+v, %v, want nil, nil", signal, err)
+	}
+}
+
+func TestBollingerBuysOnCrossBelowLowerBand(t *testing.T) {
+	s := newTestBollinger(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	// 직전 밴드는 폭 0으로 종가 100에 붙어 있고, 마지막 캔들이 하단(약 91.96) 아래로 내려간다
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 100, 100, 90)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 90})
+	if err != nil {
+		t.Fatalf("Evaluate 오류: %v", err)
+	}
+	if signal == nil || signal.SignalType != "BUY" {
+		t.Fatalf("신호 = %+v, want BUY", signal)
+	}
+
+	mean := 290.0 / 3
+	halfWidth := math.Sqrt((2*(100-mean)*(100-mean) + (90-mean)*(90-mean)) / 3)
+	if lower := signal.Parameters["lower"].(float64); math.Abs(lower-(mean-halfWidth)) > 1e-9 {
+		t.Fatalf("하단 밴드 = %v, want %v", lower, mean-halfWidth)
+	}
+	if want := (mean - halfWidth - 90) / halfWidth; math.Abs(signal.Confidence-want) > 1e-9 {
+		t.Fatalf("신뢰도 = %v, want %v", signal.Confidence, want)
+	}
+
+	if signal, _ := s.Evaluate(candles, exchange.Ticker{TradePrice: 90}); signal != nil {
+		t.Fatalf("같은 캔들에서 신호 반복: %+v", signal)
+	}
+}
+
+func TestBollingerSellsOnCrossAboveUpperBand(t *testing.T) {
+	s := newTestBollinger(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 100, 100, 110)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 110})
+	if err != nil {
+		t.Fatalf("Evaluate 오류: %v", err)
+	}
+	if signal == nil || signal.SignalType != "SELL" || signal.Price != 110 {
+		t.Fatalf("신호 = %+v, want 가격 110의 SELL", signal)
+	}
+}
+
+func TestBollingerIgnoresCloseAlreadyOutsideBand(t *testing.T) {
+	s := newTestBollinger(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	// 직전 캔들부터 이미 하단 밖에 있었으므로 새로 벗어난 것이 아니다
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 100, 100, 90, 80)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 80})
+	if err != nil || signal != nil {
+		t.Fatalf("Evaluate = %+v, %v, want nil, nil", signal, err)
+	}
+}
@@ -0,0 +1,184 @@
+package strategy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// ConfigExport 백업/공유용 전략 설정
+type ConfigExport struct {
+	MarketID     string                 `json:"market_id" yaml:"market_id"`
+	StrategyName string                 `json:"strategy_name" yaml:"strategy_name"`
+	Timeframe    string                 `json:"timeframe" yaml:"timeframe"`
+	ProfitTarget float64                `json:"profit_target" yaml:"profit_target"`
+	StopLoss     float64                `json:"stop_loss" yaml:"stop_loss"`
+	Enabled      bool                   `json:"enabled" yaml:"enabled"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+}
+
+// ImportResult 전략 설정 가져오기 결과
+type ImportResult struct {
+	Created int      `json:"created"`
+	Updated int      `json:"updated"`
+	Skipped []string `json:"skipped,omitempty"` // 기존 설정과 충돌해 건너뛴 항목 (마켓/전략)
+}
+
+// ExportConfigs 모든 전략 설정 내보내기 (마켓, 전략 이름순)
+func (m *Manager) ExportConfigs() ([]ConfigExport, error) {
+	var configs []model.StrategyConfig
+	if err := m.db.Order("market_id, strategy_name").Find(&configs).Error; err != nil {
+		return nil, fmt.Errorf("전략 설정 조회 실패: %w", err)
+	}
+
+	exports := make([]ConfigExport, len(configs))
+	for i, cfg := range configs {
+		exports[i] = ConfigExport{
+			MarketID:     cfg.MarketID,
+			StrategyName: cfg.StrategyName,
+			Timeframe:    cfg.Timeframe,
+			ProfitTarget: cfg.ProfitTarget,
+			StopLoss:     cfg.StopLoss,
+			Enabled:      cfg.Enabled,
+			Parameters:   cfg.Parameters,
+		}
+	}
+
+	return exports, nil
+}
+
+// ImportConfigs 전략 설정 가져오기
+// 모든 항목을 먼저 검증한 뒤 하나의 트랜잭션으로 마켓+전략 기준 upsert 한다.
+// 같은 마켓+전략 설정이 이미 있으면 merge가 false일 때 건너뛰고, true일 때 가져온 값으로 갱신하되
+// 파라미터는 키 단위로 합친다 (가져온 키가 우선). 실행 중인 전략에는 Reload 후 반영된다.
+func (m *Manager) ImportConfigs(exports []ConfigExport, merge bool) (*ImportResult, error) {
+	configs := make([]model.StrategyConfig, len(exports))
+	seen := make(map[string]bool, len(exports))
+	for i, export := range exports {
+		cfg, err := export.toModel()
+		if err != nil {
+			return nil, fmt.Errorf("%d번째 설정: %w", i+1, err)
+		}
+
+		key := cfg.MarketID + "/" + cfg.StrategyName
+		if seen[key] {
+			return nil, fmt.Errorf("%d번째 설정: 중복된 마켓/전략 %s", i+1, key)
+		}
+		seen[key] = true
+		configs[i] = cfg
+	}
+
+	result := &ImportResult{}
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		for _, cfg := range configs {
+			var existing model.StrategyConfig
+			err := tx.Where("market_id = ? AND strategy_name = ?", cfg.MarketID, cfg.StrategyName).First(&existing).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				if err := tx.Create(&cfg).Error; err != nil {
+					return fmt.Errorf("전략 설정 저장 실패: %w", err)
+				}
+				result.Created++
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("전략 설정 조회 실패: %w", err)
+			}
+
+			if !merge {
+				result.Skipped = append(result.Skipped, cfg.MarketID+"/"+cfg.StrategyName)
+				continue
+			}
+
+			parameters := model.Parameters{}
+			for key, value := range existing.Parameters {
+				parameters[key] = value
+			}
+			for key, value := range cfg.Parameters {
+				parameters[key] = value
+			}
+
+			existing.Timeframe = cfg.Timeframe
+			existing.ProfitTarget = cfg.ProfitTarget
+			existing.StopLoss = cfg.StopLoss
+			existing.Enabled = cfg.Enabled
+			existing.Parameters = parameters
+			if _, err := newStrategy(existing); err != nil {
+				return fmt.Errorf("합친 설정이 유효하지 않습니다 (%s/%s): %w", cfg.MarketID, cfg.StrategyName, err)
+			}
+			if err := tx.Save(&existing).Error; err != nil {
+				return fmt.Errorf("전략 설정 갱신 실패: %w", err)
+			}
+			result.Updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("전략 설정 가져오기:", result.Created, result.Updated, len(result.Skipped))
+	return result, nil
+}
+
+// toModel 가져온 설정을 검증하고 모델로 변환
+// 파라미터는 JSON으로 다시 읽어 DB에서 읽은 값과 같은 형식(숫자는 float64)으로 맞춘다.
+func (e ConfigExport) toModel() (model.StrategyConfig, error) {
+	if e.MarketID == "" || e.StrategyName == "" {
+		return model.StrategyConfig{}, fmt.Errorf("마켓과 전략 이름은 필수입니다")
+	}
+
+	parameters := model.Parameters{}
+	if len(e.Parameters) > 0 {
+		data, err := json.Marshal(normalizeYAML(e.Parameters))
+		if err != nil {
+			return model.StrategyConfig{}, fmt.Errorf("파라미터 변환 실패: %w", err)
+		}
+		if err := json.Unmarshal(data, &parameters); err != nil {
+			return model.StrategyConfig{}, fmt.Errorf("파라미터 변환 실패: %w", err)
+		}
+	}
+
+	cfg := model.StrategyConfig{
+		MarketID:     e.MarketID,
+		StrategyName: e.StrategyName,
+		Timeframe:    e.Timeframe,
+		ProfitTarget: e.ProfitTarget,
+		StopLoss:     e.StopLoss,
+		Enabled:      e.Enabled,
+		Parameters:   parameters,
+	}
+	if _, err := newStrategy(cfg); err != nil {
+		return model.StrategyConfig{}, fmt.Errorf("%s/%s: %w", e.MarketID, e.StrategyName, err)
+	}
+
+	return cfg, nil
+}
+
+// normalizeYAML YAML 디코딩 결과의 map[interface{}]interface{}를 JSON으로 변환 가능한 형태로 바꿈
+func normalizeYAML(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[fmt.Sprint(key)] = normalizeYAML(item)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = normalizeYAML(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = normalizeYAML(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
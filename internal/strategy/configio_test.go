@@ -0,0 +1,152 @@
+package strategy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gopkg.in/yaml.v2"
+)
+
+// newConfigManager 전략 설정을 미리 저장한 전략 관리자
+func newConfigManager(t *testing.T, configs ...model.StrategyConfig) *Manager {
+	t.Helper()
+	db := newTestDB(t)
+	for _, cfg := range configs {
+		if err := db.Create(&cfg).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return NewManager(db, nil, nil, nil)
+}
+
+// momentumConfig 1분봉 모멘텀 전략 설정
+func momentumConfig(marketID string, parameters model.Parameters) model.StrategyConfig {
+	return model.StrategyConfig{
+		MarketID:     marketID,
+		StrategyName: MomentumStrategyName,
+		Timeframe:    "minutes/1",
+		ProfitTarget: 3,
+		StopLoss:     2,
+		Enabled:      true,
+		Parameters:   parameters,
+	}
+}
+
+// storedConfig 저장된 마켓+전략 설정
+func storedConfig(t *testing.T, m *Manager, marketID string) model.StrategyConfig {
+	t.Helper()
+	var cfg model.StrategyConfig
+	if err := m.db.Where("market_id = ? AND strategy_name = ?", marketID, MomentumStrategyName).First(&cfg).Error; err != nil {
+		t.Fatalf("%s 설정 조회 실패: %v", marketID, err)
+	}
+	return cfg
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	source := newConfigManager(t,
+		momentumConfig("KRW-BTC", model.Parameters{"window": 5.0, "entry_percent": 1.5}),
+		momentumConfig("KRW-ETH", model.Parameters{"window": 3.0}),
+	)
+	exports, err := source.ExportConfigs()
+	if err != nil {
+		t.Fatalf("ExportConfigs 오류: %v", err)
+	}
+
+	for _, format := range []string{"json", "yaml"} {
+		var data []byte
+		var decoded []ConfigExport
+		if format == "yaml" {
+			data, _ = yaml.Marshal(exports)
+			err = yaml.Unmarshal(data, &decoded)
+		} else {
+			data, _ = json.Marshal(exports)
+			err = json.Unmarshal(data, &decoded)
+		}
+		if err != nil {
+			t.Fatalf("%s 디코딩 실패: %v", format, err)
+		}
+
+		target := newConfigManager(t)
+		result, err := target.ImportConfigs(decoded, false)
+		if err != nil {
+			t.Fatalf("%s ImportConfigs 오류: %v", format, err)
+		}
+		if result.Created != 2 || result.Updated != 0 {
+			t.Fatalf("%s 가져오기 결과 = %+v, want 2개 생성", format, result)
+		}
+
+		reexported, err := target.ExportConfigs()
+		if err != nil {
+			t.Fatalf("ExportConfigs 오류: %v", err)
+		}
+		want, _ := json.Marshal(exports)
+		got, _ := json.Marshal(reexported)
+		if string(got) != string(want) {
+			t.Fatalf("%s 왕복 결과 = %s, want %s", format, got, want)
+		}
+	}
+}
+
+func TestImportSkipsExistingWithoutMerge(t *testing.T) {
+	m := newConfigManager(t, momentumConfig("KRW-BTC", model.Parameters{"window": 5.0}))
+
+	imported := []ConfigExport{
+		{MarketID: "KRW-BTC", StrategyName: MomentumStrategyName, Timeframe: "minutes/1", StopLoss: 4, Parameters: map[string]interface{}{"window": 10}},
+	}
+	result, err := m.ImportConfigs(imported, false)
+	if err != nil {
+		t.Fatalf("ImportConfigs 오류: %v", err)
+	}
+	if result.Updated != 0 || len(result.Skipped) != 1 || result.Skipped[0] != "KRW-BTC/"+MomentumStrategyName {
+		t.Fatalf("가져오기 결과 = %+v, want 충돌 항목 건너뜀", result)
+	}
+
+	cfg := storedConfig(t, m, "KRW-BTC")
+	if cfg.StopLoss != 2 || cfg.Parameters["window"] != 5.0 {
+		t.Fatalf("기존 설정 = %+v, want 변경 없음", cfg)
+	}
+}
+
+func TestImportMergesParametersWithMerge(t *testing.T) {
+	m := newConfigManager(t, momentumConfig("KRW-BTC", model.Parameters{"window": 5.0, "entry_percent": 1.5}))
+
+	imported := []ConfigExport{
+		{MarketID: "KRW-BTC", StrategyName: MomentumStrategyName, Timeframe: "minutes/1", StopLoss: 4, Parameters: map[string]interface{}{"window": 10}},
+	}
+	result, err := m.ImportConfigs(imported, true)
+	if err != nil {
+		t.Fatalf("ImportConfigs 오류: %v", err)
+	}
+	if result.Updated != 1 || len(result.Skipped) != 0 {
+		t.Fatalf("가져오기 결과 = %+v, want 1개 갱신", result)
+	}
+
+	cfg := storedConfig(t, m, "KRW-BTC")
+	if cfg.StopLoss != 4 || cfg.Parameters["window"] != 10.0 || cfg.Parameters["entry_percent"] != 1.5 {
+		t.Fatalf("합친 설정 = %+v, want 손절 4, window 10, entry_percent 1.5 유지", cfg)
+	}
+}
+
+func TestImportRejectsInvalidConfigAtomically(t *testing.T) {
+	m := newConfigManager(t)
+
+	imported := []ConfigExport{
+		{MarketID: "KRW-BTC", StrategyName: MomentumStrategyName, Timeframe: "minutes/1"},
+		{MarketID: "KRW-ETH", StrategyName: "unknown"},
+	}
+	if _, err := m.ImportConfigs(imported, false); err == nil {
+		t.Fatal("지원되지 않는 전략을 오류 없이 가져옴")
+	}
+
+	duplicated := []ConfigExport{imported[0], imported[0]}
+	if _, err := m.ImportConfigs(duplicated, false); err == nil {
+		t.Fatal("중복된 마켓/전략을 오류 없이 가져옴")
+	}
+
+	var count int64
+	m.db.Model(&model.StrategyConfig{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("저장된 설정 %d개, want 0 (일부만 저장되면 안 됨)", count)
+	}
+}
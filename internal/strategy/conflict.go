@@ -0,0 +1,75 @@
+package strategy
+
+import "math"
+
+// 같은 마켓에서 여러 전략의 신호가 엇갈릴 때의 처리 방식
+const (
+	// ConflictPolicyNone 반대 방향 신호가 함께 나오면 모두 버린다
+	ConflictPolicyNone = "none"
+	// ConflictPolicyConfidence 신뢰도가 가장 높은 신호를 따른다
+	ConflictPolicyConfidence = "confidence"
+	// ConflictPolicyNet 방향별 신뢰도 합의 차이로 방향을 정한다
+	ConflictPolicyNet = "net"
+)
+
+// WithConflictPolicy 전략 간 신호 충돌 처리 방식 설정
+func WithConflictPolicy(policy string) ManagerOption {
+	return func(m *Manager) {
+		switch policy {
+		case ConflictPolicyConfidence, ConflictPolicyNet:
+			m.conflictPolicy = policy
+		default:
+			m.conflictPolicy = ConflictPolicyNone
+		}
+	}
+}
+
+// resolveSignals 한 마켓에서 같은 시점에 나온 신호들을 하나로 정리
+// 매매가 반복해서 뒤집히지 않도록 마켓마다 한 번에 하나의 신호만 보낸다.
+// 방향이 같으면 신뢰도가 가장 높은 신호를 고르고, 방향이 엇갈리면 policy에 따라 처리한다.
+func resolveSignals(signals []*Signal, policy string) *Signal {
+	var best, bestBuy, bestSell *Signal
+	buyScore, sellScore := 0.0, 0.0
+	for _, signal := range signals {
+		if best == nil || signal.Confidence > best.Confidence {
+			best = signal
+		}
+		switch signal.SignalType {
+		case "BUY":
+			buyScore += signal.Confidence
+			if bestBuy == nil || signal.Confidence > bestBuy.Confidence {
+				bestBuy = signal
+			}
+		case "SELL":
+			sellScore += signal.Confidence
+			if bestSell == nil || signal.Confidence > bestSell.Confidence {
+				bestSell = signal
+			}
+		}
+	}
+
+	if bestBuy == nil || bestSell == nil {
+		return best
+	}
+
+	switch policy {
+	case ConflictPolicyConfidence:
+		if bestBuy.Confidence == bestSell.Confidence {
+			return nil
+		}
+		return best
+	case ConflictPolicyNet:
+		net := buyScore - sellScore
+		if net == 0 {
+			return nil
+		}
+		winner := bestBuy
+		if net < 0 {
+			winner = bestSell
+		}
+		winner.Confidence = math.Abs(net)
+		return winner
+	default:
+		return nil
+	}
+}
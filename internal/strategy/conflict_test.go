@@ -0,0 +1,84 @@
+package strategy
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestResolveSignalsSameDirectionPicksHighestConfidence(t *testing.T) {
+	signals := []*Signal{
+		{StrategyName: "a", SignalType: "BUY", Confidence: 0.4},
+		{StrategyName: "b", SignalType: "BUY", Confidence: 0.9},
+	}
+	for _, policy := range []string{ConflictPolicyNone, ConflictPolicyConfidence, ConflictPolicyNet} {
+		if got := resolveSignals(signals, policy); got == nil || got.StrategyName != "b" {
+			t.Fatalf("%s 정책 결과 = %+v, want b", policy, got)
+		}
+	}
+}
+
+func TestResolveSignalsConflictPolicies(t *testing.T) {
+	newSignals := func() []*Signal {
+		return []*Signal{
+			{StrategyName: "buy1", SignalType: "BUY", Confidence: 0.5},
+			{StrategyName: "buy2", SignalType: "BUY", Confidence: 0.3},
+			{StrategyName: "sell", SignalType: "SELL", Confidence: 0.6},
+		}
+	}
+
+	if got := resolveSignals(newSignals(), ConflictPolicyNone); got != nil {
+		t.Fatalf("none 정책 결과 = %+v, want nil", got)
+	}
+	if got := resolveSignals(newSignals(), ConflictPolicyConfidence); got == nil || got.StrategyName != "sell" {
+		t.Fatalf("confidence 정책 결과 = %+v, want sell", got)
+	}
+
+	// 매수 0.8, 매도 0.6: 매수 우세, 신뢰도는 차이 0.2
+	got := resolveSignals(newSignals(), ConflictPolicyNet)
+	if got == nil || got.StrategyName != "buy1" || math.Abs(got.Confidence-0.2) > 1e-9 {
+		t.Fatalf("net 정책 결과 = %+v, want buy1 신뢰도 0.2", got)
+	}
+}
+
+func TestResolveSignalsDropsTies(t *testing.T) {
+	signals := func() []*Signal {
+		return []*Signal{
+			{SignalType: "BUY", Confidence: 0.5},
+			{SignalType: "SELL", Confidence: 0.5},
+		}
+	}
+	if got := resolveSignals(signals(), ConflictPolicyConfidence); got != nil {
+		t.Fatalf("confidence 동률 결과 = %+v, want nil", got)
+	}
+	if got := resolveSignals(signals(), ConflictPolicyNet); got != nil {
+		t.Fatalf("net 동률 결과 = %+v, want nil", got)
+	}
+}
+
+func TestEvaluateSendsOneSignalPerMarket(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 50, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 9, 0, 30, 0, time.UTC)
+	buffers := map[string][]model.Candlestick{
+		"minutes/1": candleSeries("minutes/1", start, time.Minute, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11),
+	}
+	buy := &recordingStrategy{name: "buy", timeframes: []string{"minutes/1"}, signal: &Signal{SignalType: "BUY", Confidence: 0.9}}
+	sell := &recordingStrategy{name: "sell", timeframes: []string{"minutes/1"}, signal: &Signal{SignalType: "SELL", Confidence: 0.4}}
+
+	signalCh := make(chan Signal, 2)
+	m := newBufferedManager(t, signalCh, buffers, now, &runner{strategy: buy}, &runner{strategy: sell})
+	WithConflictPolicy(ConflictPolicyConfidence)(m)
+
+	m.evaluate(context.Background(), exchange.MarketData{Type: exchange.DataTypeTicker, MarketID: "KRW-BTC", TradePrice: 11}, now)
+
+	if len(signalCh) != 1 {
+		t.Fatalf("전달된 신호 수 = %d, want 1", len(signalCh))
+	}
+	if signal := <-signalCh; signal.StrategyName != "buy" || signal.SignalType != "BUY" {
+		t.Fatalf("전달된 신호 = %+v, want buy BUY", signal)
+	}
+}
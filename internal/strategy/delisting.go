@@ -0,0 +1,64 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+// ExitReasonDelisted 상장 폐지 청산 사유
+const ExitReasonDelisted = "DELISTED"
+
+// handleDelisted 상장 폐지 마켓 처리
+// 전략 평가를 멈추고, 열린 포지션이 있으면 시장가 청산 신호를 보낸다.
+// 거래가 이미 막혔다면 청산 주문은 실패하며 운영자가 직접 정리해야 한다.
+func (m *Manager) handleDelisted(ctx context.Context, marketID string) {
+	m.mu.Lock()
+	runners := m.runners[marketID]
+	delete(m.runners, marketID)
+	delete(m.orderbooks, marketID)
+	for key := range m.buffers {
+		if key.marketID == marketID {
+			delete(m.buffers, key)
+		}
+	}
+	m.mu.Unlock()
+
+	m.logger.Error("상장 폐지 마켓 전략 평가 중단:", marketID)
+
+	var position model.Position
+	err := m.db.Where("market_id = ? AND status = ?", marketID, "OPEN").First(&position).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return
+	}
+	if err != nil {
+		m.logger.Error("포지션 조회 실패:", marketID, err)
+		return
+	}
+
+	strategyName := ExitReasonDelisted
+	if len(runners) > 0 {
+		strategyName = runners[0].strategy.Name()
+	}
+
+	// 가격을 비워 두면 주문 실행기가 시장가로 청산한다
+	signal := &Signal{
+		MarketID:     marketID,
+		StrategyName: strategyName,
+		SignalType:   "SELL",
+		Confidence:   1,
+		Timestamp:    time.Now(),
+		Parameters:   model.Parameters{"exit_reason": ExitReasonDelisted},
+	}
+	if !m.saveSignal(signal) {
+		return
+	}
+
+	select {
+	case m.signalCh <- *signal:
+	case <-ctx.Done():
+	}
+}
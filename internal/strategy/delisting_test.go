@@ -0,0 +1,63 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestHandleDelistedStopsEvaluationAndExitsPosition(t *testing.T) {
+	now := time.Now()
+	buffers := map[string][]model.Candlestick{
+		"minutes/1": candleSeries("minutes/1", now.Add(-5*time.Minute), time.Minute, 1, 2, 3),
+	}
+	signalCh := make(chan Signal, 1)
+	m := newBufferedManager(t, signalCh, buffers, now, &runner{strategy: &recordingStrategy{name: "rsi", timeframes: []string{"minutes/1"}}})
+
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 100, EntryTime: now, Quantity: 1, Status: "OPEN"}
+	if err := m.db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	m.handleDelisted(context.Background(), "KRW-BTC")
+
+	if len(m.runners["KRW-BTC"]) != 0 || len(m.buffers) != 0 {
+		t.Fatalf("상장 폐지 후 남은 전략 %d개, 캔들 버퍼 %d개, want 0", len(m.runners["KRW-BTC"]), len(m.buffers))
+	}
+
+	select {
+	case signal := <-signalCh:
+		if signal.SignalType != "SELL" || signal.StrategyName != "rsi" || signal.Price != 0 {
+			t.Fatalf("청산 신호 = %+v, want rsi 시장가 매도", signal)
+		}
+		if signal.Parameters["exit_reason"] != ExitReasonDelisted {
+			t.Fatalf("청산 사유 = %v, want %s", signal.Parameters["exit_reason"], ExitReasonDelisted)
+		}
+		// 신호 저장을 꺼도 체결 후 포지션에 기록할 청산 사유는 저장된 신호에 남는다
+		var saved model.Signal
+		if err := m.db.First(&saved, signal.ID).Error; err != nil {
+			t.Fatalf("청산 신호 조회 실패: %v", err)
+		}
+		if saved.Parameters["exit_reason"] != ExitReasonDelisted {
+			t.Fatalf("저장된 청산 사유 = %v, want %s", saved.Parameters["exit_reason"], ExitReasonDelisted)
+		}
+	default:
+		t.Fatal("청산 신호가 전달되지 않음")
+	}
+}
+
+func TestHandleDelistedWithoutPositionSendsNoSignal(t *testing.T) {
+	signalCh := make(chan Signal, 1)
+	m := newBufferedManager(t, signalCh, nil, time.Now(), &runner{strategy: &recordingStrategy{name: "rsi"}})
+
+	m.handleDelisted(context.Background(), "KRW-BTC")
+
+	if len(signalCh) != 0 {
+		t.Fatal("포지션이 없는데 청산 신호가 전달됨")
+	}
+	if len(m.runners["KRW-BTC"]) != 0 {
+		t.Fatal("상장 폐지 마켓 전략이 남아 있음")
+	}
+}
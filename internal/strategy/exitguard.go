@@ -0,0 +1,45 @@
+package strategy
+
+import (
+	"context"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+)
+
+// ExitGuard 전략과 별개로 시세마다 청산 여부를 판단하는 모듈 (위험 관리자의 추적 손절 등)
+type ExitGuard interface {
+	CheckExit(ctx context.Context, ticker exchange.Ticker) *Signal
+}
+
+// WithExitGuard 시세마다 전략 평가 전에 확인할 청산 판단 모듈 설정
+func WithExitGuard(guard ExitGuard) ManagerOption {
+	return func(m *Manager) {
+		m.exitGuard = guard
+	}
+}
+
+// checkExit 청산 판단 모듈의 매도 신호 전달
+// 전략 설정이 없는 마켓의 포지션도 확인하도록 전략 평가와 관계없이 모든 티커에 대해 호출된다.
+func (m *Manager) checkExit(ctx context.Context, data exchange.MarketData) {
+	if m.exitGuard == nil {
+		return
+	}
+
+	ticker := exchange.Ticker{
+		MarketID:   data.MarketID,
+		TradePrice: data.TradePrice,
+		Timestamp:  data.Timestamp,
+	}
+	signal := m.exitGuard.CheckExit(ctx, ticker)
+	if signal == nil {
+		return
+	}
+	if !m.saveSignal(signal) {
+		return
+	}
+
+	select {
+	case m.signalCh <- *signal:
+	case <-ctx.Done():
+	}
+}
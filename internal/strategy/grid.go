@@ -0,0 +1,81 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// ErrGridSpacingTooTight 그리드 간격이 왕복 수수료보다 좁음
+var ErrGridSpacingTooTight = errors.New("그리드 간격이 왕복 수수료를 감당하지 못합니다")
+
+// GridConfig 그리드 매매 설정
+type GridConfig struct {
+	LowerPrice float64 // 그리드 하단 가격
+	UpperPrice float64 // 그리드 상단 가격
+	Levels     int     // 그리드 구간 수
+}
+
+// ParseGridConfig 전략 파라미터에서 그리드 설정 파싱
+func ParseGridConfig(params model.Parameters) (GridConfig, error) {
+	lower, _ := params["lower_price"].(float64)
+	upper, _ := params["upper_price"].(float64)
+	levels, _ := params["levels"].(float64)
+
+	cfg := GridConfig{
+		LowerPrice: lower,
+		UpperPrice: upper,
+		Levels:     int(levels),
+	}
+
+	if cfg.LowerPrice <= 0 || cfg.UpperPrice <= cfg.LowerPrice {
+		return GridConfig{}, fmt.Errorf("잘못된 그리드 가격 범위: %v ~ %v", cfg.LowerPrice, cfg.UpperPrice)
+	}
+	if cfg.Levels < 1 {
+		return GridConfig{}, fmt.Errorf("잘못된 그리드 구간 수: %d", cfg.Levels)
+	}
+
+	return cfg, nil
+}
+
+// Step 그리드 한 구간의 가격 간격
+func (g GridConfig) Step() float64 {
+	return (g.UpperPrice - g.LowerPrice) / float64(g.Levels)
+}
+
+// MinSpacingPercent 가장 좁은 구간의 간격 (%)
+// 등간격 그리드에서는 상단 구간(상단가 - 간격에 매수, 상단가에 매도)의 수익률이 가장 작다.
+func (g GridConfig) MinSpacingPercent() float64 {
+	step := g.Step()
+	return step / (g.UpperPrice - step) * 100
+}
+
+// Validate 그리드 간격이 왕복 수수료를 넘는지 검증
+// feeRate는 한쪽 거래의 수수료율(%)이다.
+func (g GridConfig) Validate(feeRate float64) error {
+	roundTripFee := feeRate * 2
+	spacing := g.MinSpacingPercent()
+	if spacing <= roundTripFee {
+		return fmt.Errorf("%w: 최소 간격 %.4f%%, 왕복 수수료 %.4f%% (구간 수를 %d개 이하로 줄이세요)",
+			ErrGridSpacingTooTight, spacing, roundTripFee, g.maxLevels(roundTripFee))
+	}
+
+	return nil
+}
+
+// maxLevels 왕복 수수료를 넘는 최대 구간 수
+func (g GridConfig) maxLevels(roundTripFee float64) int {
+	// 상단 구간 수익률 step/(upper-step) > fee 를 step에 대해 풀면 step > upper*fee/(100+fee)
+	minStep := g.UpperPrice * roundTripFee / (100 + roundTripFee)
+	if minStep <= 0 {
+		return g.Levels
+	}
+
+	levels := int((g.UpperPrice - g.LowerPrice) / minStep)
+	if float64(levels)*minStep >= g.UpperPrice-g.LowerPrice {
+		levels--
+	}
+
+	return levels
+}
@@ -0,0 +1,55 @@
+package strategy
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestGridValidateRejectsSpacingBelowRoundTripFee(t *testing.T) {
+	grid := GridConfig{LowerPrice: 100, UpperPrice: 101, Levels: 10}
+
+	err := grid.Validate(0.05)
+	if !errors.Is(err, ErrGridSpacingTooTight) {
+		t.Fatalf("좁은 그리드 검증 오류 = %v, want ErrGridSpacingTooTight", err)
+	}
+	if !strings.Contains(err.Error(), "9개 이하") {
+		t.Fatalf("오류 메시지에 권장 구간 수가 없음: %v", err)
+	}
+
+	// 권장한 구간 수로 줄이면 통과해야 한다
+	grid.Levels = 9
+	if err := grid.Validate(0.05); err != nil {
+		t.Fatalf("권장 구간 수로 줄인 그리드 거부: %v", err)
+	}
+}
+
+func TestGridValidateAcceptsWideSpacing(t *testing.T) {
+	grid := GridConfig{LowerPrice: 100, UpperPrice: 200, Levels: 10}
+	if err := grid.Validate(0.05); err != nil {
+		t.Fatalf("넓은 그리드 거부: %v", err)
+	}
+}
+
+func TestParseGridConfigRejectsInvalidParameters(t *testing.T) {
+	cases := map[string]model.Parameters{
+		"하단가 없음":      {"upper_price": 200.0, "levels": 10.0},
+		"상단가가 하단가 이하": {"lower_price": 200.0, "upper_price": 100.0, "levels": 10.0},
+		"구간 수 없음":     {"lower_price": 100.0, "upper_price": 200.0},
+	}
+	for name, params := range cases {
+		if _, err := ParseGridConfig(params); err == nil {
+			t.Errorf("%s: 오류 없이 파싱됨", name)
+		}
+	}
+
+	cfg, err := ParseGridConfig(model.Parameters{"lower_price": 100.0, "upper_price": 200.0, "levels": 10.0})
+	if err != nil {
+		t.Fatalf("올바른 그리드 파싱 실패: %v", err)
+	}
+	if cfg.Step() != 10 {
+		t.Fatalf("Step() = %v, want 10", cfg.Step())
+	}
+}
@@ -0,0 +1,126 @@
+package strategy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/storage"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestDB 모든 모델을 마이그레이션한 테스트용 sqlite 데이터베이스
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("테스트 데이터베이스 열기 실패: %v", err)
+	}
+	if err := db.AutoMigrate(storage.Models()...); err != nil {
+		t.Fatalf("마이그레이션 실패: %v", err)
+	}
+	return db
+}
+
+// redirectTransport 업비트 주소로 가는 요청을 테스트 서버로 보내는 전송기
+type redirectTransport struct {
+	target *url.URL
+}
+
+// RoundTrip 요청 주소의 호스트만 테스트 서버로 바꿔 전송
+func (r redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestUpbitClient handler가 업비트 API 응답을 대신하는 클라이언트 생성
+func newTestUpbitClient(t *testing.T, handler http.Handler) *exchange.UpbitClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("테스트 서버 주소 파싱 실패: %v", err)
+	}
+
+	return exchange.NewUpbitClient("access", "secret",
+		exchange.WithHTTPClient(&http.Client{Transport: redirectTransport{target: target}}))
+}
+
+// recordingStrategy 받은 캔들을 기록하고 정해진 신호를 돌려주는 테스트 전략
+type recordingStrategy struct {
+	name       string
+	timeframes []string
+	signal     *Signal
+
+	mu          sync.Mutex
+	candles     map[string][]model.Candlestick
+	provisional map[string]model.Candlestick
+	calls       int
+}
+
+func (s *recordingStrategy) Name() string                        { return s.name }
+func (s *recordingStrategy) Init(cfg model.StrategyConfig) error { return nil }
+func (s *recordingStrategy) Timeframes() []string                { return s.timeframes }
+
+func (s *recordingStrategy) SetProvisional(candles map[string]model.Candlestick) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.provisional = candles
+}
+
+func (s *recordingStrategy) Evaluate(candles map[string][]model.Candlestick, ticker exchange.Ticker) (*Signal, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.candles = candles
+	s.calls++
+	if s.signal == nil {
+		return nil, nil
+	}
+	signal := *s.signal
+	return &signal, nil
+}
+
+// candleSeries start부터 interval 간격으로 종가가 closes인 시간순 캔들
+func candleSeries(timeframe string, start time.Time, interval time.Duration, closes ...float64) []model.Candlestick {
+	candles := make([]model.Candlestick, len(closes))
+	for i, c := range closes {
+		candles[i] = model.Candlestick{
+			MarketID:  "KRW-BTC",
+			Timeframe: timeframe,
+			Timestamp: start.Add(time.Duration(i) * interval),
+			Open:      c,
+			High:      c,
+			Low:       c,
+			Close:     c,
+			Volume:    1,
+		}
+	}
+	return candles
+}
+
+// newBufferedManager 캔들 버퍼를 미리 채운 전략 관리자 (거래소 조회 없이 평가, 신호는 테스트 DB에 저장)
+func newBufferedManager(t *testing.T, signalCh chan Signal, buffers map[string][]model.Candlestick, now time.Time, runners ...*runner) *Manager {
+	t.Helper()
+	m := NewManager(newTestDB(t), nil, nil, signalCh)
+	for timeframe, candles := range buffers {
+		m.buffers[bufferKey{marketID: "KRW-BTC", timeframe: timeframe}] = &candleBuffer{candles: candles, fetchedAt: now}
+	}
+	m.runners["KRW-BTC"] = runners
+	return m
+}
@@ -0,0 +1,368 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	candleBufferSize = 200
+	minRefetchPeriod = 10 * time.Second
+)
+
+// bufferKey 캔들 버퍼 키
+type bufferKey struct {
+	marketID  string
+	timeframe string
+}
+
+// candleBuffer 마켓/타임프레임별 캔들 버퍼 (시간순)
+type candleBuffer struct {
+	candles   []model.Candlestick
+	fetchedAt time.Time
+}
+
+// runner 마켓에 적용된 전략
+type runner struct {
+	config   model.StrategyConfig
+	strategy Strategy
+
+	lastStrengthExit time.Time
+}
+
+// Manager 전략 관리자
+type Manager struct {
+	db           *gorm.DB
+	client       *exchange.UpbitClient
+	marketDataCh <-chan exchange.MarketData
+	signalCh     chan<- Signal
+	logger       *utils.Logger
+
+	mu         sync.RWMutex
+	runners    map[string][]*runner
+	buffers    map[bufferKey]*candleBuffer
+	orderbooks map[string]*exchange.Orderbook
+
+	persistSignals bool
+	strength       config.SellIntoStrengthConfig
+	conflictPolicy string
+	exitGuard      ExitGuard
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// ManagerOption 전략 관리자 옵션
+type ManagerOption func(*Manager)
+
+// WithSignalPersistence 신호와 함께 지표 스냅샷을 DB에 저장할지 설정
+// 신호 자체는 신호당 주문 수 한도를 위해 설정과 관계없이 항상 저장된다.
+func WithSignalPersistence(enabled bool) ManagerOption {
+	return func(m *Manager) {
+		m.persistSignals = enabled
+	}
+}
+
+// NewManager 새로운 전략 관리자 생성
+func NewManager(db *gorm.DB, client *exchange.UpbitClient, marketDataCh <-chan exchange.MarketData, signalCh chan<- Signal, opts ...ManagerOption) *Manager {
+	m := &Manager{
+		db:           db,
+		client:       client,
+		marketDataCh: marketDataCh,
+		signalCh:     signalCh,
+		logger:       utils.NewLogger("strategy"),
+		runners:      make(map[string][]*runner),
+		buffers:      make(map[bufferKey]*candleBuffer),
+		orderbooks:   make(map[string]*exchange.Orderbook),
+
+		conflictPolicy: ConflictPolicyNone,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// LoadStrategies 활성화된 전략 설정 로드
+func (m *Manager) LoadStrategies() error {
+	var configs []model.StrategyConfig
+	if err := m.db.Where("enabled = ?", true).Find(&configs).Error; err != nil {
+		return fmt.Errorf("전략 설정 조회 실패: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, cfg := range configs {
+		s, err := newStrategy(cfg)
+		if err != nil {
+			m.logger.Error("전략 생성 실패:", cfg.MarketID, cfg.StrategyName, err)
+			continue
+		}
+		m.runners[cfg.MarketID] = append(m.runners[cfg.MarketID], &runner{config: cfg, strategy: s})
+		m.logger.Info("전략 로드:", cfg.MarketID, s.Name(), s.Timeframes())
+	}
+
+	return nil
+}
+
+// Markets 전략이 적용된 마켓 목록
+func (m *Manager) Markets() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	markets := make([]string, 0, len(m.runners))
+	for marketID := range m.runners {
+		markets = append(markets, marketID)
+	}
+
+	return markets
+}
+
+// Start 전략 평가 시작
+func (m *Manager) Start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.wg.Add(1)
+	go m.run(ctx)
+	m.logger.Info("전략 관리자 시작")
+}
+
+// Stop 전략 평가 중지
+func (m *Manager) Stop() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	m.wg.Wait()
+	m.cancel = nil
+	m.logger.Info("전략 관리자 중지")
+}
+
+// run 시장 데이터 수신 루프
+func (m *Manager) run(ctx context.Context) {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case data, ok := <-m.marketDataCh:
+			if !ok {
+				return
+			}
+			switch data.Type {
+			case exchange.DataTypeTicker:
+				m.checkExit(ctx, data)
+				m.evaluate(ctx, data, time.Now())
+			case exchange.DataTypeOrderbook:
+				m.updateOrderbook(data)
+			case exchange.DataTypeDelisted:
+				m.handleDelisted(ctx, data.MarketID)
+			}
+		}
+	}
+}
+
+// updateOrderbook 마켓의 최신 호가 저장
+func (m *Manager) updateOrderbook(data exchange.MarketData) {
+	if data.Orderbook == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.orderbooks[data.MarketID] = data.Orderbook
+}
+
+// Orderbook 웹소켓으로 받은 마켓의 최신 호가
+func (m *Manager) Orderbook(marketID string) (*exchange.Orderbook, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	orderbook, ok := m.orderbooks[marketID]
+	return orderbook, ok
+}
+
+// evaluate 마켓 전략 평가
+// 마켓에 적용된 모든 전략을 같은 캔들로 평가하고, 나온 신호들은 충돌 처리 방식에 따라 하나로 정리한다.
+func (m *Manager) evaluate(ctx context.Context, data exchange.MarketData, now time.Time) {
+	m.mu.RLock()
+	runners := m.runners[data.MarketID]
+	m.mu.RUnlock()
+	if len(runners) == 0 {
+		return
+	}
+
+	var timeframes []string
+	for _, r := range runners {
+		for _, timeframe := range r.strategy.Timeframes() {
+			timeframes = appendUnique(timeframes, timeframe)
+		}
+	}
+	if m.strength.Enabled {
+		timeframes = appendUnique(timeframes, m.strengthTimeframe(runners[0].strategy))
+	}
+
+	candles, err := m.candleSet(ctx, data.MarketID, timeframes, now)
+	if err != nil {
+		m.logger.Error("캔들 갱신 실패:", data.MarketID, err)
+		return
+	}
+	aligned, provisional := alignCandles(candles, now)
+
+	ticker := exchange.Ticker{
+		MarketID:   data.MarketID,
+		TradePrice: data.TradePrice,
+		Timestamp:  data.Timestamp,
+	}
+
+	var signals []*Signal
+	for _, r := range runners {
+		if aware, ok := r.strategy.(ProvisionalAware); ok {
+			aware.SetProvisional(provisional)
+		}
+		signal, err := r.strategy.Evaluate(aligned, ticker)
+		if err != nil {
+			m.logger.Error("전략 평가 실패:", data.MarketID, r.strategy.Name(), err)
+			continue
+		}
+		if signal == nil {
+			continue
+		}
+		if signal.StrategyName == "" {
+			signal.StrategyName = r.strategy.Name()
+		}
+		signals = append(signals, signal)
+	}
+	if len(signals) == 0 && m.strength.Enabled {
+		signal, err := m.strengthExit(runners[0], aligned, data)
+		if err != nil {
+			m.logger.Error("부분 익절 판단 실패:", data.MarketID, err)
+			return
+		}
+		if signal != nil {
+			signals = append(signals, signal)
+		}
+	}
+	if len(signals) == 0 {
+		return
+	}
+
+	signal := resolveSignals(signals, m.conflictPolicy)
+	if len(signals) > 1 {
+		m.logger.Info("전략 신호 충돌 처리:", data.MarketID, m.conflictPolicy, len(signals), signal != nil)
+	}
+	if signal == nil {
+		return
+	}
+
+	if signal.MarketID == "" {
+		signal.MarketID = data.MarketID
+	}
+	if signal.StrategyName == "" {
+		signal.StrategyName = runners[0].strategy.Name()
+	}
+	if signal.Price == 0 {
+		signal.Price = data.TradePrice
+	}
+	if signal.Timestamp.IsZero() {
+		signal.Timestamp = now
+	}
+
+	if !m.saveSignal(signal) {
+		return
+	}
+
+	select {
+	case m.signalCh <- *signal:
+	case <-ctx.Done():
+	}
+}
+
+// saveSignal 신호 저장
+// 주문 실행기가 신호당 주문 수를 셀 수 있도록 저장 설정과 관계없이 모든 신호에 ID를 남긴다.
+// 전략이 Parameters에 담은 지표 스냅샷은 신호 저장이 켜져 있을 때만 함께 저장되어 사후 검증에 사용된다.
+// 저장에 실패하면 주문 수 한도를 확인할 수 없으므로 false를 돌려주고 신호를 버린다.
+func (m *Manager) saveSignal(signal *Signal) bool {
+	record := signal
+	if m.persistSignals {
+		if len(signal.Parameters) == 0 {
+			m.logger.Error("지표 스냅샷 없는 신호:", signal.MarketID, signal.StrategyName)
+		}
+	} else {
+		// 청산 사유는 체결 후 포지션에 기록되므로 지표 스냅샷을 빼도 남긴다
+		stripped := *signal
+		stripped.Parameters = nil
+		if reason, ok := signal.Parameters["exit_reason"]; ok {
+			stripped.Parameters = model.Parameters{"exit_reason": reason}
+		}
+		record = &stripped
+	}
+
+	if err := m.db.Create(record).Error; err != nil {
+		m.logger.Error("신호 저장 실패, 신호 폐기:", signal.MarketID, signal.SignalType, err)
+		return false
+	}
+	signal.Model = record.Model
+	return true
+}
+
+// candleSet 전략에 필요한 타임프레임별 캔들 조회
+// 버퍼의 마지막 캔들이 마감되어 새 캔들이 생겼을 때만 다시 조회한다.
+// 캔들 조회는 락 밖에서 하므로 느린 응답이 다른 마켓의 평가나 전략 재적재를 막지 않는다.
+// 같은 버퍼를 동시에 갱신하면 나중에 받은 응답이 남지만 둘 다 같은 시점의 캔들이므로 문제없다.
+func (m *Manager) candleSet(ctx context.Context, marketID string, timeframes []string, now time.Time) (map[string][]model.Candlestick, error) {
+	result := make(map[string][]model.Candlestick, len(timeframes))
+	for _, timeframe := range timeframes {
+		key := bufferKey{marketID: marketID, timeframe: timeframe}
+		m.mu.RLock()
+		buffer, ok := m.buffers[key]
+		refresh := !ok || m.needsRefresh(buffer, timeframe, now)
+		m.mu.RUnlock()
+
+		if refresh {
+			candles, err := m.client.GetCandles(ctx, marketID, timeframe, candleBufferSize)
+			if err != nil {
+				return nil, err
+			}
+			candlesticks, err := exchange.ToCandlesticks(candles, timeframe)
+			if err != nil {
+				return nil, err
+			}
+			buffer = &candleBuffer{candles: candlesticks, fetchedAt: now}
+
+			m.mu.Lock()
+			m.buffers[key] = buffer
+			m.mu.Unlock()
+		}
+		result[timeframe] = buffer.candles
+	}
+
+	return result, nil
+}
+
+// needsRefresh 버퍼 갱신 필요 여부
+func (m *Manager) needsRefresh(buffer *candleBuffer, timeframe string, now time.Time) bool {
+	if len(buffer.candles) == 0 {
+		return now.Sub(buffer.fetchedAt) >= minRefetchPeriod
+	}
+
+	duration, err := exchange.TimeframeDuration(timeframe)
+	if err != nil {
+		return false
+	}
+
+	last := buffer.candles[len(buffer.candles)-1]
+	closed := !last.Timestamp.Add(duration).After(now)
+	return closed && now.Sub(buffer.fetchedAt) >= minRefetchPeriod
+}
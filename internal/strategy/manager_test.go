@@ -0,0 +1,172 @@
+package strategy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+func TestEvaluatePersistsSignalWithIndicatorSnapshot(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 50, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 9, 0, 30, 0, time.UTC)
+	buffers := map[string][]model.Candlestick{
+		"minutes/1": candleSeries("minutes/1", start, time.Minute, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11),
+	}
+	s := &recordingStrategy{
+		name:       "rsi",
+		timeframes: []string{"minutes/1"},
+		signal: &Signal{
+			SignalType: "BUY",
+			Confidence: 0.8,
+			Parameters: model.Parameters{"rsi": 27.5, "oversold": 30.0},
+		},
+	}
+
+	signalCh := make(chan Signal, 1)
+	m := newBufferedManager(t, signalCh, buffers, now, &runner{strategy: s})
+	db := m.db
+	m.persistSignals = true
+
+	m.evaluate(context.Background(), exchange.MarketData{Type: exchange.DataTypeTicker, MarketID: "KRW-BTC", TradePrice: 11}, now)
+
+	var saved []model.Signal
+	if err := db.Find(&saved).Error; err != nil {
+		t.Fatalf("신호 조회 실패: %v", err)
+	}
+	if len(saved) != 1 {
+		t.Fatalf("저장된 신호 수 = %d, want 1", len(saved))
+	}
+	got := saved[0]
+	if got.MarketID != "KRW-BTC" || got.StrategyName != "rsi" || got.Price != 11 || !got.Timestamp.Equal(now) {
+		t.Fatalf("저장된 신호 = %+v", got)
+	}
+	if got.Parameters["rsi"] != 27.5 || got.Parameters["oversold"] != 30.0 {
+		t.Fatalf("저장된 지표 스냅샷 = %v, want rsi 27.5, oversold 30", got.Parameters)
+	}
+
+	select {
+	case sent := <-signalCh:
+		if sent.ID != got.ID {
+			t.Fatalf("전달된 신호 ID = %d, 저장된 신호 ID = %d", sent.ID, got.ID)
+		}
+	default:
+		t.Fatal("신호가 전달되지 않음")
+	}
+}
+
+func TestEvaluateSavesSignalWithoutSnapshotWhenPersistenceDisabled(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 50, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 9, 0, 30, 0, time.UTC)
+	buffers := map[string][]model.Candlestick{
+		"minutes/1": candleSeries("minutes/1", start, time.Minute, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11),
+	}
+	s := &recordingStrategy{
+		name:       "rsi",
+		timeframes: []string{"minutes/1"},
+		signal:     &Signal{SignalType: "BUY", Parameters: model.Parameters{"rsi": 27.5}},
+	}
+
+	signalCh := make(chan Signal, 1)
+	m := newBufferedManager(t, signalCh, buffers, now, &runner{strategy: s})
+
+	m.evaluate(context.Background(), exchange.MarketData{Type: exchange.DataTypeTicker, MarketID: "KRW-BTC", TradePrice: 11}, now)
+
+	// 신호당 주문 수 한도가 동작하도록 저장을 꺼도 신호 ID는 남기고 지표 스냅샷만 뺀다
+	var saved []model.Signal
+	if err := m.db.Find(&saved).Error; err != nil {
+		t.Fatalf("신호 조회 실패: %v", err)
+	}
+	if len(saved) != 1 || len(saved[0].Parameters) != 0 {
+		t.Fatalf("저장 비활성 시 저장된 신호 = %+v, want 지표 스냅샷 없는 신호 1개", saved)
+	}
+
+	select {
+	case sent := <-signalCh:
+		if sent.ID == 0 || sent.ID != saved[0].ID {
+			t.Fatalf("전달된 신호 ID = %d, want 저장된 신호 ID %d", sent.ID, saved[0].ID)
+		}
+		if sent.Parameters["rsi"] != 27.5 {
+			t.Fatalf("전달된 신호 파라미터 = %v, want rsi 27.5", sent.Parameters)
+		}
+	default:
+		t.Fatal("신호가 전달되지 않음")
+	}
+}
+
+func TestEvaluateDropsSignalWhenSaveFails(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 50, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 9, 0, 30, 0, time.UTC)
+	buffers := map[string][]model.Candlestick{
+		"minutes/1": candleSeries("minutes/1", start, time.Minute, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11),
+	}
+	s := &recordingStrategy{name: "rsi", timeframes: []string{"minutes/1"}, signal: &Signal{SignalType: "BUY"}}
+
+	signalCh := make(chan Signal, 1)
+	m := newBufferedManager(t, signalCh, buffers, now, &runner{strategy: s})
+	if err := m.db.Migrator().DropTable(&model.Signal{}); err != nil {
+		t.Fatal(err)
+	}
+
+	m.evaluate(context.Background(), exchange.MarketData{Type: exchange.DataTypeTicker, MarketID: "KRW-BTC", TradePrice: 11}, now)
+
+	// ID 없는 신호는 주문 수 한도를 우회하므로 전달하지 않는다
+	if len(signalCh) != 0 {
+		t.Fatal("저장에 실패한 신호가 전달됨")
+	}
+}
+
+func TestCandleSetFetchesOutsideManagerLock(t *testing.T) {
+	requested := make(chan struct{})
+	release := make(chan struct{})
+	client := newTestUpbitClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(requested)
+		<-release
+		json.NewEncoder(w).Encode([]exchange.Candle{
+			{MarketID: "KRW-BTC", CandleDateTimeUTC: "2024-01-01T09:00:00", TradePrice: 100},
+		})
+	}))
+	m := NewManager(nil, client, nil, make(chan Signal, 1))
+	now := time.Date(2024, 1, 1, 9, 0, 30, 0, time.UTC)
+
+	type fetched struct {
+		candles map[string][]model.Candlestick
+		err     error
+	}
+	done := make(chan fetched, 1)
+	go func() {
+		candles, err := m.candleSet(context.Background(), "KRW-BTC", []string{"minutes/1"}, now)
+		done <- fetched{candles, err}
+	}()
+	<-requested
+
+	// 캔들 응답을 기다리는 동안에도 관리자 락을 잡을 수 있어야 한다
+	locked := make(chan struct{})
+	go func() {
+		m.mu.Lock()
+		m.mu.Unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		close(release)
+		t.Fatal("캔들 조회 중 관리자 락이 잡혀 있음")
+	}
+	close(release)
+
+	result := <-done
+	if result.err != nil {
+		t.Fatalf("candleSet 오류: %v", result.err)
+	}
+	if candles := result.candles["minutes/1"]; len(candles) != 1 || candles[0].Close != 100 {
+		t.Fatalf("캔들 = %+v, want 종가 100 1개", candles)
+	}
+	if _, ok := m.buffers[bufferKey{marketID: "KRW-BTC", timeframe: "minutes/1"}]; !ok {
+		t.Fatal("조회한 캔들이 버퍼에 저장되지 않음")
+	}
+}
@@ -0,0 +1,118 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// MomentumStrategyName 가격 변화율 모멘텀 전략 이름
+const MomentumStrategyName = "Price Momentum"
+
+// MomentumStrategy 가격 변화율 모멘텀 전략
+// 최근 window개 캔들 동안 가격이 entry_percent 이상 오르면 매수(돌파),
+// exit_percent 이상 내리면 매도한다. 지표 없이 순수 가격 변화만 사용한다.
+type MomentumStrategy struct {
+	timeframes   []string
+	window       int
+	entryPercent float64
+	exitPercent  float64
+	lastCandle   time.Time
+}
+
+// NewMomentumStrategy 새로운 모멘텀 전략 생성
+func NewMomentumStrategy(cfg model.StrategyConfig) (*MomentumStrategy, error) {
+	s := &MomentumStrategy{}
+	if err := s.Init(cfg); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Init 설정 파라미터로 전략 초기화
+func (s *MomentumStrategy) Init(cfg model.StrategyConfig) error {
+	*s = MomentumStrategy{
+		timeframes:   ConfiguredTimeframes(cfg),
+		window:       intParam(cfg.Parameters, "window", 10),
+		entryPercent: floatParam(cfg.Parameters, "entry_percent", 3.0),
+	}
+	s.exitPercent = floatParam(cfg.Parameters, "exit_percent", s.entryPercent)
+
+	if len(s.timeframes) == 0 {
+		return fmt.Errorf("타임프레임이 설정되지 않았습니다")
+	}
+	if s.window < 1 {
+		return fmt.Errorf("잘못된 window 값: %d", s.window)
+	}
+	if s.entryPercent <= 0 || s.exitPercent <= 0 {
+		return fmt.Errorf("변화율 기준은 0보다 커야 합니다: entry=%v exit=%v", s.entryPercent, s.exitPercent)
+	}
+
+	return nil
+}
+
+// Name 전략 이름
+func (s *MomentumStrategy) Name() string {
+	return MomentumStrategyName
+}
+
+// Timeframes 필요한 타임프레임 목록
+func (s *MomentumStrategy) Timeframes() []string {
+	return s.timeframes
+}
+
+// Evaluate 신호 평가
+// 변화율이 기준선을 새로 넘어선 캔들에서만 신호를 낸다.
+func (s *MomentumStrategy) Evaluate(candles map[string][]model.Candlestick, ticker exchange.Ticker) (*Signal, error) {
+	series := candles[s.timeframes[0]]
+	if len(series) < s.window+2 {
+		return nil, nil
+	}
+
+	last := series[len(series)-1]
+	if !last.Timestamp.After(s.lastCandle) {
+		return nil, nil
+	}
+	s.lastCandle = last.Timestamp
+
+	current := priceChange(series, len(series)-1, s.window)
+	previous := priceChange(series, len(series)-2, s.window)
+
+	var signalType string
+	var threshold float64
+	switch {
+	case current >= s.entryPercent && previous < s.entryPercent:
+		signalType, threshold = "BUY", s.entryPercent
+	case current <= -s.exitPercent && previous > -s.exitPercent:
+		signalType, threshold = "SELL", s.exitPercent
+	default:
+		return nil, nil
+	}
+
+	return &Signal{
+		SignalType: signalType,
+		Price:      ticker.TradePrice,
+		Confidence: math.Min(1, math.Abs(current)/(threshold*2)),
+		Parameters: model.Parameters{
+			"window":         s.window,
+			"change_percent": current,
+			"threshold":      threshold,
+			"base_close":     series[len(series)-1-s.window].Close,
+			"last_close":     last.Close,
+			"breakout":       signalType == "BUY",
+		},
+	}, nil
+}
+
+// priceChange end 캔들 종가의 window개 이전 대비 변화율 (%)
+func priceChange(series []model.Candlestick, end, window int) float64 {
+	base := series[end-window].Close
+	if base == 0 {
+		return 0
+	}
+
+	return (series[end].Close - base) / base * 100
+}
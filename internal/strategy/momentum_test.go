@@ -0,0 +1,92 @@
+package strategy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// newTestMomentum window 2, 진입/청산 기준 3%인 1분봉 모멘텀 전략
+func newTestMomentum(t *testing.T) *MomentumStrategy {
+	t.Helper()
+	s, err := NewMomentumStrategy(model.StrategyConfig{
+		Timeframe:  "minutes/1",
+		Parameters: model.Parameters{"window": 2.0, "entry_percent": 3.0},
+	})
+	if err != nil {
+		t.Fatalf("모멘텀 전략 생성 실패: %v", err)
+	}
+	return s
+}
+
+func TestMomentumBuysOnUpwardBreakout(t *testing.T) {
+	s := newTestMomentum(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 100, 100, 100, 104)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 104})
+	if err != nil {
+		t.Fatalf("Evaluate 오류: %v", err)
+	}
+	if signal == nil || signal.SignalType != "BUY" {
+		t.Fatalf("신호 = %+v, want BUY", signal)
+	}
+	if signal.Parameters["change_percent"] != 4.0 || signal.Parameters["base_close"] != 100.0 {
+		t.Fatalf("지표 스냅샷 = %v, want change_percent 4, base_close 100", signal.Parameters)
+	}
+
+	// 같은 캔들로 다시 평가하면 신호를 반복하지 않는다
+	if signal, _ := s.Evaluate(candles, exchange.Ticker{TradePrice: 104}); signal != nil {
+		t.Fatalf("같은 캔들에서 신호 반복: %+v", signal)
+	}
+}
+
+func TestMomentumSellsOnDownwardMove(t *testing.T) {
+	s := newTestMomentum(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 100, 100, 100, 96)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 96})
+	if err != nil {
+		t.Fatalf("Evaluate 오류: %v", err)
+	}
+	if signal == nil || signal.SignalType != "SELL" {
+		t.Fatalf("신호 = %+v, want SELL", signal)
+	}
+}
+
+func TestMomentumIgnoresMoveAlreadyAboveThreshold(t *testing.T) {
+	s := newTestMomentum(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	// 직전 캔들에서 이미 기준을 넘었으므로 새 돌파가 아니다
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 100, 100, 104, 105)}
+
+	if signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 105}); err != nil || signal != nil {
+		t.Fatalf("신호 = %+v, %v, want nil", signal, err)
+	}
+}
+
+func TestMomentumWaitsForEnoughCandles(t *testing.T) {
+	s := newTestMomentum(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 100, 104)}
+
+	if signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 104}); err != nil || signal != nil {
+		t.Fatalf("캔들 부족 시 신호 = %+v, %v, want nil", signal, err)
+	}
+}
+
+func TestMomentumRejectsInvalidParameters(t *testing.T) {
+	cases := map[string]model.StrategyConfig{
+		"타임프레임 없음": {Parameters: model.Parameters{}},
+		"window 0": {Timeframe: "minutes/1", Parameters: model.Parameters{"window": 0.0}},
+		"음수 진입 기준": {Timeframe: "minutes/1", Parameters: model.Parameters{"entry_percent": -1.0}},
+	}
+	for name, cfg := range cases {
+		if _, err := NewMomentumStrategy(cfg); err == nil {
+			t.Errorf("%s: 오류 없이 생성됨", name)
+		}
+	}
+}
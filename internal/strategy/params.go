@@ -0,0 +1,20 @@
+package strategy
+
+import "github.com/kyi000/upbit-auto-trading-bot/internal/model"
+
+// floatParam 전략 파라미터 실수 값 조회
+// JSONB에서 읽은 숫자는 float64이므로 그대로 사용하고, 없거나 형식이 다르면 기본값을 반환한다.
+func floatParam(params model.Parameters, key string, defaultValue float64) float64 {
+	if v, ok := params[key].(float64); ok {
+		return v
+	}
+	return defaultValue
+}
+
+// intParam 전략 파라미터 정수 값 조회
+func intParam(params model.Parameters, key string, defaultValue int) int {
+	if v, ok := params[key].(float64); ok {
+		return int(v)
+	}
+	return defaultValue
+}
@@ -0,0 +1,169 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// PivotStrategyName 피벗 지지/저항 전략 이름
+const PivotStrategyName = "Pivot Support Resistance"
+
+// PivotLevels 피벗으로 찾은 지지/저항 가격 (시간순)
+type PivotLevels struct {
+	Supports    []float64
+	Resistances []float64
+}
+
+// Pivots 캔들에서 피벗 고점/저점 검출
+// 앞뒤 lookback개 캔들보다 고가가 높은 캔들을 저항, 저가가 낮은 캔들을 지지로 본다.
+// 뒤쪽 lookback개 캔들이 아직 없는 최근 캔들은 확정되지 않았으므로 제외한다.
+func Pivots(candles []model.Candlestick, lookback int) PivotLevels {
+	var levels PivotLevels
+	if lookback < 1 {
+		return levels
+	}
+
+	for i := lookback; i < len(candles)-lookback; i++ {
+		isHigh, isLow := true, true
+		for j := i - lookback; j <= i+lookback; j++ {
+			if j == i {
+				continue
+			}
+			if candles[j].High >= candles[i].High {
+				isHigh = false
+			}
+			if candles[j].Low <= candles[i].Low {
+				isLow = false
+			}
+		}
+
+		if isHigh {
+			levels.Resistances = append(levels.Resistances, candles[i].High)
+		}
+		if isLow {
+			levels.Supports = append(levels.Supports, candles[i].Low)
+		}
+	}
+
+	return levels
+}
+
+// nearestBelow price 이하에서 가장 가까운 가격 (없으면 0)
+func nearestBelow(levels []float64, price float64) float64 {
+	nearest := 0.0
+	for _, level := range levels {
+		if level <= price && level > nearest {
+			nearest = level
+		}
+	}
+	return nearest
+}
+
+// nearestAbove price 이상에서 가장 가까운 가격 (없으면 0)
+func nearestAbove(levels []float64, price float64) float64 {
+	nearest := 0.0
+	for _, level := range levels {
+		if level >= price && (nearest == 0 || level < nearest) {
+			nearest = level
+		}
+	}
+	return nearest
+}
+
+// PivotStrategy 피벗 지지/저항 전략
+// 현재가가 가까운 지지선 위 tolerance_percent 이내로 내려오면 매수,
+// 가까운 저항선 아래 tolerance_percent 이내로 올라가면 매도한다.
+type PivotStrategy struct {
+	timeframes       []string
+	lookback         int
+	tolerancePercent float64
+	lastCandle       time.Time
+}
+
+// NewPivotStrategy 새로운 피벗 전략 생성
+func NewPivotStrategy(cfg model.StrategyConfig) (*PivotStrategy, error) {
+	s := &PivotStrategy{}
+	if err := s.Init(cfg); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Init 설정 파라미터로 전략 초기화
+func (s *PivotStrategy) Init(cfg model.StrategyConfig) error {
+	*s = PivotStrategy{
+		timeframes:       ConfiguredTimeframes(cfg),
+		lookback:         intParam(cfg.Parameters, "pivot_lookback", 5),
+		tolerancePercent: floatParam(cfg.Parameters, "tolerance_percent", 0.5),
+	}
+
+	if len(s.timeframes) == 0 {
+		return fmt.Errorf("타임프레임이 설정되지 않았습니다")
+	}
+	if s.lookback < 1 {
+		return fmt.Errorf("잘못된 pivot_lookback 값: %d", s.lookback)
+	}
+	if s.tolerancePercent <= 0 {
+		return fmt.Errorf("tolerance_percent는 0보다 커야 합니다: %v", s.tolerancePercent)
+	}
+
+	return nil
+}
+
+// Name 전략 이름
+func (s *PivotStrategy) Name() string {
+	return PivotStrategyName
+}
+
+// Timeframes 필요한 타임프레임 목록
+func (s *PivotStrategy) Timeframes() []string {
+	return s.timeframes
+}
+
+// Evaluate 신호 평가
+// 같은 캔들 구간에서는 한 번만 신호를 낸다.
+func (s *PivotStrategy) Evaluate(candles map[string][]model.Candlestick, ticker exchange.Ticker) (*Signal, error) {
+	series := candles[s.timeframes[0]]
+	if len(series) < s.lookback*2+1 || ticker.TradePrice <= 0 {
+		return nil, nil
+	}
+
+	last := series[len(series)-1]
+	if !last.Timestamp.After(s.lastCandle) {
+		return nil, nil
+	}
+
+	price := ticker.TradePrice
+	levels := Pivots(series, s.lookback)
+	support := nearestBelow(levels.Supports, price)
+	resistance := nearestAbove(levels.Resistances, price)
+
+	var signalType string
+	var distance float64
+	switch {
+	case support > 0 && (price-support)/support*100 <= s.tolerancePercent:
+		signalType, distance = "BUY", (price-support)/support*100
+	case resistance > 0 && (resistance-price)/resistance*100 <= s.tolerancePercent:
+		signalType, distance = "SELL", (resistance-price)/resistance*100
+	default:
+		return nil, nil
+	}
+	s.lastCandle = last.Timestamp
+
+	return &Signal{
+		SignalType: signalType,
+		Price:      price,
+		Confidence: math.Max(0, 1-distance/s.tolerancePercent),
+		Parameters: model.Parameters{
+			"pivot_lookback":    s.lookback,
+			"tolerance_percent": s.tolerancePercent,
+			"support":           support,
+			"resistance":        resistance,
+			"distance_percent":  distance,
+		},
+	}, nil
+}
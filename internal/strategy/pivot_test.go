@@ -0,0 +1,95 @@
+package strategy
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// pivotCandles 110 저항과 95 지지가 있는 1분봉
+func pivotCandles() map[string][]model.Candlestick {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	return map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 110, 105, 95, 100, 103)}
+}
+
+// newTestPivot lookback 1, 허용 범위 0.5%인 1분봉 피벗 전략
+func newTestPivot(t *testing.T) *PivotStrategy {
+	t.Helper()
+	s, err := NewPivotStrategy(model.StrategyConfig{
+		Timeframe:  "minutes/1",
+		Parameters: model.Parameters{"pivot_lookback": 1.0, "tolerance_percent": 0.5},
+	})
+	if err != nil {
+		t.Fatalf("피벗 전략 생성 실패: %v", err)
+	}
+	return s
+}
+
+func TestPivotsFindsConfirmedHighsAndLows(t *testing.T) {
+	levels := Pivots(pivotCandles()["minutes/1"], 1)
+	if !reflect.DeepEqual(levels.Resistances, []float64{110}) || !reflect.DeepEqual(levels.Supports, []float64{95}) {
+		t.Fatalf("Pivots = %+v, want 저항 110, 지지 95", levels)
+	}
+}
+
+func TestPivotEvaluateSignalsNearLevels(t *testing.T) {
+	cases := []struct {
+		price float64
+		want  string
+		level string
+	}{
+		{95.3, "BUY", "support"},
+		{109.8, "SELL", "resistance"},
+		{102, "", ""},
+	}
+
+	for _, tc := range cases {
+		s := newTestPivot(t)
+		signal, err := s.Evaluate(pivotCandles(), exchange.Ticker{TradePrice: tc.price})
+		if err != nil {
+			t.Fatalf("Evaluate 오류: %v", err)
+		}
+		if tc.want == "" {
+			if signal != nil {
+				t.Fatalf("가격 %v 신호 = %+v, want 없음", tc.price, signal)
+			}
+			continue
+		}
+		if signal == nil || signal.SignalType != tc.want {
+			t.Fatalf("가격 %v 신호 = %+v, want %s", tc.price, signal, tc.want)
+		}
+		if signal.Confidence <= 0 || signal.Confidence > 1 {
+			t.Fatalf("신뢰도 = %v, want 0~1", signal.Confidence)
+		}
+		if signal.Parameters[tc.level] == 0.0 {
+			t.Fatalf("지표 스냅샷 = %v, want %s 포함", signal.Parameters, tc.level)
+		}
+	}
+}
+
+func TestPivotEvaluateSignalsOncePerCandle(t *testing.T) {
+	s := newTestPivot(t)
+	candles := pivotCandles()
+
+	if signal, _ := s.Evaluate(candles, exchange.Ticker{TradePrice: 95.3}); signal == nil {
+		t.Fatal("첫 평가에서 신호 없음")
+	}
+	if signal, _ := s.Evaluate(candles, exchange.Ticker{TradePrice: 95.2}); signal != nil {
+		t.Fatalf("같은 캔들에서 신호 반복: %+v", signal)
+	}
+}
+
+func TestNewPivotStrategyRejectsInvalidParameters(t *testing.T) {
+	cases := []model.Parameters{
+		{"pivot_lookback": 0.0},
+		{"tolerance_percent": -1.0},
+	}
+	for _, params := range cases {
+		if _, err := NewPivotStrategy(model.StrategyConfig{Timeframe: "minutes/1", Parameters: params}); err == nil {
+			t.Fatalf("파라미터 %v 오류 = nil, want 오류", params)
+		}
+	}
+}
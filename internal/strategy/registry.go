@@ -0,0 +1,69 @@
+package strategy
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// Factory 초기화 전의 빈 전략을 만드는 함수
+type Factory func() Strategy
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Factory)
+)
+
+func init() {
+	Register(MomentumStrategyName, func() Strategy { return &MomentumStrategy{} })
+	Register(PivotStrategyName, func() Strategy { return &PivotStrategy{} })
+	Register(RSIStrategyName, func() Strategy { return &RSIStrategy{} })
+	Register(BollingerStrategyName, func() Strategy { return &BollingerStrategy{} })
+}
+
+// Register 전략 등록
+// 전략 설정(StrategyConfig)의 strategy_name이 name과 같으면 factory로 만든 전략을 Init으로 초기화해 사용한다.
+// 보통 전략 패키지의 init에서 호출하며, 같은 이름을 두 번 등록하거나 factory가 nil이면 패닉한다.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if factory == nil {
+		panic("전략 생성 함수가 nil입니다: " + name)
+	}
+	if _, exists := registry[name]; exists {
+		panic("이미 등록된 전략입니다: " + name)
+	}
+	registry[name] = factory
+}
+
+// Registered 등록된 전략 이름 목록 (정렬됨)
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newStrategy 설정에 맞는 전략 생성
+func newStrategy(cfg model.StrategyConfig) (Strategy, error) {
+	registryMu.RLock()
+	factory, ok := registry[cfg.StrategyName]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("지원되지 않는 전략: %s", cfg.StrategyName)
+	}
+
+	s := factory()
+	if err := s.Init(cfg); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
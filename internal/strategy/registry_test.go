@@ -0,0 +1,82 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// testStrategyName 테스트에서 등록하는 전략 이름 (레지스트리는 전역이므로 init에서 한 번만 등록)
+const testStrategyName = "Test Always Buy"
+
+func init() {
+	Register(testStrategyName, func() Strategy {
+		return &recordingStrategy{
+			name:       testStrategyName,
+			timeframes: []string{"minutes/1"},
+			signal:     &Signal{SignalType: "BUY", Confidence: 0.5, Parameters: model.Parameters{"test": 1.0}},
+		}
+	})
+}
+
+func TestRegisteredIncludesBuiltinAndCustomStrategies(t *testing.T) {
+	names := make(map[string]bool)
+	for _, name := range Registered() {
+		names[name] = true
+	}
+	for _, want := range []string{MomentumStrategyName, PivotStrategyName, RSIStrategyName, BollingerStrategyName, testStrategyName} {
+		if !names[want] {
+			t.Fatalf("등록된 전략 = %v, %s 없음", Registered(), want)
+		}
+	}
+}
+
+func TestRegisterPanicsOnDuplicateName(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("중복 등록이 패닉하지 않음")
+		}
+	}()
+	Register(testStrategyName, func() Strategy { return &recordingStrategy{} })
+}
+
+func TestLoadStrategiesUsesRegisteredFactory(t *testing.T) {
+	db := newTestDB(t)
+	configs := []model.StrategyConfig{
+		{MarketID: "KRW-BTC", StrategyName: testStrategyName, Timeframe: "minutes/1", Enabled: true},
+		{MarketID: "KRW-ETH", StrategyName: "Unknown Strategy", Timeframe: "minutes/1", Enabled: true},
+	}
+	if err := db.Create(&configs).Error; err != nil {
+		t.Fatalf("전략 설정 저장 실패: %v", err)
+	}
+
+	signalCh := make(chan Signal, 1)
+	m := NewManager(db, nil, nil, signalCh)
+	if err := m.LoadStrategies(); err != nil {
+		t.Fatalf("LoadStrategies 오류: %v", err)
+	}
+	// 등록되지 않은 전략은 건너뛴다
+	if markets := m.Markets(); len(markets) != 1 || markets[0] != "KRW-BTC" {
+		t.Fatalf("전략 마켓 = %v, want [KRW-BTC]", markets)
+	}
+
+	start := time.Date(2024, 1, 1, 8, 50, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 9, 0, 30, 0, time.UTC)
+	m.buffers[bufferKey{marketID: "KRW-BTC", timeframe: "minutes/1"}] = &candleBuffer{
+		candles:   candleSeries("minutes/1", start, time.Minute, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11),
+		fetchedAt: now,
+	}
+	m.evaluate(context.Background(), exchange.MarketData{Type: exchange.DataTypeTicker, MarketID: "KRW-BTC", TradePrice: 11}, now)
+
+	select {
+	case signal := <-signalCh:
+		if signal.StrategyName != testStrategyName || signal.SignalType != "BUY" || signal.MarketID != "KRW-BTC" || signal.Price != 11 {
+			t.Fatalf("전달된 신호 = %+v, want %s의 KRW-BTC BUY", signal, testStrategyName)
+		}
+	default:
+		t.Fatal("등록한 전략의 신호가 전달되지 않음")
+	}
+}
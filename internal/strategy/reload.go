@@ -0,0 +1,94 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// ErrInvalidStrategyConfig 다시 읽은 전략 설정 중 전략을 만들 수 없는 설정이 있음
+var ErrInvalidStrategyConfig = errors.New("잘못된 전략 설정")
+
+// ReloadResult 전략 설정 다시 읽기 결과 (항목은 마켓/전략)
+type ReloadResult struct {
+	Started []string `json:"started"`          // 새로 활성화되어 시작한 전략
+	Stopped []string `json:"stopped"`          // 비활성화되거나 삭제되어 멈춘 전략
+	Updated []string `json:"updated"`          // 설정이 바뀌어 다시 만든 전략
+	Failed  []string `json:"failed,omitempty"` // 설정이 잘못되어 만들지 못한 전략 (하나라도 있으면 아무것도 반영하지 않음)
+}
+
+// Reload 전략 설정을 다시 읽어 실행 중인 전략에 반영
+// 데이터베이스의 활성 전략과 현재 전략을 비교해 새 전략은 시작하고, 비활성화된 전략은 멈추고,
+// 설정이 바뀐 전략만 새 설정으로 다시 만든다. 바뀌지 않은 전략은 상태를 그대로 유지한다.
+// 전략 목록은 한 번에 교체하며, 만들 수 없는 설정이 하나라도 있으면 기존 전략을 모두 그대로 두고
+// 실패한 전략만 담은 결과와 ErrInvalidStrategyConfig를 반환한다.
+// 캔들 버퍼와 시장 데이터 수신은 건드리지 않으므로 웹소켓 연결이 끊기지 않는다.
+// 수집 대상(collector.markets)에 없는 마켓의 전략은 시작해도 시장 데이터가 들어오지 않는다.
+func (m *Manager) Reload() (*ReloadResult, error) {
+	var configs []model.StrategyConfig
+	if err := m.db.Where("enabled = ?", true).Order("id").Find(&configs).Error; err != nil {
+		return nil, fmt.Errorf("전략 설정 조회 실패: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current := make(map[uint]*runner)
+	for _, runners := range m.runners {
+		for _, r := range runners {
+			current[r.config.ID] = r
+		}
+	}
+
+	result := &ReloadResult{}
+	next := make(map[string][]*runner, len(m.runners))
+	for _, cfg := range configs {
+		key := cfg.MarketID + "/" + cfg.StrategyName
+		existing, running := current[cfg.ID]
+		delete(current, cfg.ID)
+
+		if running && sameStrategyConfig(existing.config, cfg) {
+			next[cfg.MarketID] = append(next[cfg.MarketID], existing)
+			continue
+		}
+
+		s, err := newStrategy(cfg)
+		if err != nil {
+			m.logger.Error("전략 생성 실패:", cfg.MarketID, cfg.StrategyName, err)
+			result.Failed = append(result.Failed, key)
+			continue
+		}
+
+		next[cfg.MarketID] = append(next[cfg.MarketID], &runner{config: cfg, strategy: s})
+		if running {
+			result.Updated = append(result.Updated, key)
+		} else {
+			result.Started = append(result.Started, key)
+		}
+	}
+	if len(result.Failed) > 0 {
+		m.logger.Error("전략 설정 다시 읽기 취소 (기존 전략 유지):", strings.Join(result.Failed, ", "))
+		return &ReloadResult{Failed: result.Failed}, fmt.Errorf("%w: %s", ErrInvalidStrategyConfig, strings.Join(result.Failed, ", "))
+	}
+	for _, r := range current {
+		result.Stopped = append(result.Stopped, r.config.MarketID+"/"+r.config.StrategyName)
+	}
+
+	// evaluate는 잠금 밖에서 이전 슬라이스를 쓸 수 있으므로 기존 슬라이스를 고치지 않고 맵을 바꾼다
+	m.runners = next
+	m.logger.Info("전략 설정 다시 읽기: 시작", len(result.Started), "중지", len(result.Stopped), "변경", len(result.Updated))
+	return result, nil
+}
+
+// sameStrategyConfig 전략 동작에 영향을 주는 설정이 같은지 여부
+func sameStrategyConfig(a, b model.StrategyConfig) bool {
+	return a.MarketID == b.MarketID &&
+		a.StrategyName == b.StrategyName &&
+		a.Timeframe == b.Timeframe &&
+		a.ProfitTarget == b.ProfitTarget &&
+		a.StopLoss == b.StopLoss &&
+		reflect.DeepEqual(a.Parameters, b.Parameters)
+}
@@ -0,0 +1,141 @@
+package strategy
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// runnerSnapshot 마켓/전략별 실행 중인 전략
+func runnerSnapshot(m *Manager) map[string]*runner {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	snapshot := make(map[string]*runner)
+	for _, runners := range m.runners {
+		for _, r := range runners {
+			snapshot[r.config.MarketID+"/"+r.config.StrategyName] = r
+		}
+	}
+	return snapshot
+}
+
+// sortedKeys 정렬한 결과 목록
+func sortedKeys(keys []string) []string {
+	keys = append([]string(nil), keys...)
+	sort.Strings(keys)
+	return keys
+}
+
+func TestReloadAppliesChangedConfigs(t *testing.T) {
+	m := newConfigManager(t,
+		momentumConfig("KRW-BTC", model.Parameters{"window": 5.0}),
+		momentumConfig("KRW-ETH", model.Parameters{"window": 5.0}),
+		momentumConfig("KRW-SOL", model.Parameters{"window": 5.0}),
+	)
+	if err := m.LoadStrategies(); err != nil {
+		t.Fatal(err)
+	}
+	before := runnerSnapshot(m)
+
+	btc := storedConfig(t, m, "KRW-BTC")
+	btc.Parameters = model.Parameters{"window": 7.0}
+	eth := storedConfig(t, m, "KRW-ETH")
+	eth.Enabled = false
+	xrp := momentumConfig("KRW-XRP", model.Parameters{"window": 3.0})
+	if err := m.db.Save(&btc).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := m.db.Save(&eth).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := m.db.Create(&xrp).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := m.Reload()
+	if err != nil {
+		t.Fatalf("Reload 오류: %v", err)
+	}
+	key := func(market string) string { return market + "/" + MomentumStrategyName }
+	if !reflect.DeepEqual(result.Started, []string{key("KRW-XRP")}) ||
+		!reflect.DeepEqual(result.Stopped, []string{key("KRW-ETH")}) ||
+		!reflect.DeepEqual(result.Updated, []string{key("KRW-BTC")}) ||
+		len(result.Failed) != 0 {
+		t.Fatalf("Reload 결과 = %+v", result)
+	}
+
+	after := runnerSnapshot(m)
+	if got := sortedKeys(m.Markets()); !reflect.DeepEqual(got, []string{"KRW-BTC", "KRW-SOL", "KRW-XRP"}) {
+		t.Fatalf("전략 마켓 = %v, want KRW-BTC, KRW-SOL, KRW-XRP", got)
+	}
+	if window := after[key("KRW-BTC")].strategy.(*MomentumStrategy).window; window != 7 {
+		t.Fatalf("KRW-BTC window = %d, want 7", window)
+	}
+	// 바뀌지 않은 전략은 다시 만들지 않고 상태를 그대로 유지한다
+	if after[key("KRW-SOL")] != before[key("KRW-SOL")] {
+		t.Fatal("바뀌지 않은 KRW-SOL 전략이 다시 만들어짐")
+	}
+}
+
+func TestReloadKeepsOldSetWhenConfigInvalid(t *testing.T) {
+	m := newConfigManager(t,
+		momentumConfig("KRW-BTC", model.Parameters{"window": 5.0}),
+		momentumConfig("KRW-ETH", model.Parameters{"window": 5.0}),
+	)
+	if err := m.LoadStrategies(); err != nil {
+		t.Fatal(err)
+	}
+	before := runnerSnapshot(m)
+
+	// 유효한 변경(ETH, XRP)과 잘못된 변경(BTC window 0)을 함께 저장
+	btc := storedConfig(t, m, "KRW-BTC")
+	btc.Parameters = model.Parameters{"window": 0.0}
+	eth := storedConfig(t, m, "KRW-ETH")
+	eth.Parameters = model.Parameters{"window": 9.0}
+	xrp := momentumConfig("KRW-XRP", model.Parameters{"window": 3.0})
+	if err := m.db.Save(&btc).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := m.db.Save(&eth).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := m.db.Create(&xrp).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := m.Reload()
+	if !errors.Is(err, ErrInvalidStrategyConfig) {
+		t.Fatalf("Reload 오류 = %v, want ErrInvalidStrategyConfig", err)
+	}
+	if !reflect.DeepEqual(result.Failed, []string{"KRW-BTC/" + MomentumStrategyName}) || len(result.Started)+len(result.Stopped)+len(result.Updated) != 0 {
+		t.Fatalf("Reload 결과 = %+v, want KRW-BTC 실패만", result)
+	}
+
+	// 유효한 변경도 반영하지 않고 기존 전략을 그대로 둔다
+	after := runnerSnapshot(m)
+	if len(after) != len(before) {
+		t.Fatalf("전략 수 = %d, want %d", len(after), len(before))
+	}
+	for key, r := range before {
+		if after[key] != r {
+			t.Fatalf("%s 전략이 교체됨", key)
+		}
+	}
+
+	// 설정을 고치면 다음 다시 읽기에서 한 번에 반영된다
+	btc.Parameters = model.Parameters{"window": 6.0}
+	if err := m.db.Save(&btc).Error; err != nil {
+		t.Fatal(err)
+	}
+	result, err = m.Reload()
+	if err != nil {
+		t.Fatalf("수정 후 Reload 오류: %v", err)
+	}
+	if len(result.Started) != 1 || len(result.Updated) != 2 {
+		t.Fatalf("수정 후 Reload 결과 = %+v, want 시작 1, 변경 2", result)
+	}
+}
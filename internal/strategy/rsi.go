@@ -0,0 +1,114 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"github.com/kyi000/upbit-auto-trading-bot/pkg/indicator"
+)
+
+// RSIStrategyName RSI 반전 전략 이름
+const RSIStrategyName = "RSI Reversal"
+
+// RSIStrategy RSI 반전 전략
+// RSI가 과매도 기준(oversold)을 아래에서 위로 넘으면 매수, 과매수 기준(overbought)을 위에서 아래로 넘으면 매도한다.
+// 신뢰도는 직전 RSI가 기준선 너머로 얼마나 깊이 들어갔었는지로 정한다.
+type RSIStrategy struct {
+	timeframes []string
+	period     int
+	oversold   float64
+	overbought float64
+	lastCandle time.Time
+}
+
+// NewRSIStrategy 새로운 RSI 전략 생성
+func NewRSIStrategy(cfg model.StrategyConfig) (*RSIStrategy, error) {
+	s := &RSIStrategy{}
+	if err := s.Init(cfg); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Init 설정 파라미터로 전략 초기화
+func (s *RSIStrategy) Init(cfg model.StrategyConfig) error {
+	*s = RSIStrategy{
+		timeframes: ConfiguredTimeframes(cfg),
+		period:     intParam(cfg.Parameters, "period", 14),
+		oversold:   floatParam(cfg.Parameters, "oversold", 30),
+		overbought: floatParam(cfg.Parameters, "overbought", 70),
+	}
+
+	if len(s.timeframes) == 0 {
+		return fmt.Errorf("타임프레임이 설정되지 않았습니다")
+	}
+	if s.period < 2 {
+		return fmt.Errorf("잘못된 period 값: %d", s.period)
+	}
+	if s.oversold <= 0 || s.overbought >= 100 || s.oversold >= s.overbought {
+		return fmt.Errorf("잘못된 RSI 기준: oversold=%v overbought=%v", s.oversold, s.overbought)
+	}
+
+	return nil
+}
+
+// Name 전략 이름
+func (s *RSIStrategy) Name() string {
+	return RSIStrategyName
+}
+
+// Timeframes 필요한 타임프레임 목록
+func (s *RSIStrategy) Timeframes() []string {
+	return s.timeframes
+}
+
+// Evaluate 신호 평가
+// 새로 마감된 캔들에서 RSI가 기준선을 넘어선 경우에만 신호를 낸다.
+func (s *RSIStrategy) Evaluate(candles map[string][]model.Candlestick, ticker exchange.Ticker) (*Signal, error) {
+	series := candles[s.timeframes[0]]
+	if len(series) < s.period+2 {
+		return nil, nil
+	}
+
+	last := series[len(series)-1]
+	if !last.Timestamp.After(s.lastCandle) {
+		return nil, nil
+	}
+	s.lastCandle = last.Timestamp
+
+	closes := make([]float64, len(series))
+	for i, candle := range series {
+		closes[i] = candle.Close
+	}
+	rsi := indicator.RSI(closes, s.period)
+	current, previous := rsi[len(rsi)-1], rsi[len(rsi)-2]
+
+	var signalType string
+	var threshold, confidence float64
+	switch {
+	case previous < s.oversold && current >= s.oversold:
+		signalType, threshold = "BUY", s.oversold
+		confidence = (s.oversold - previous) / (s.oversold / 2)
+	case previous > s.overbought && current <= s.overbought:
+		signalType, threshold = "SELL", s.overbought
+		confidence = (previous - s.overbought) / ((100 - s.overbought) / 2)
+	default:
+		return nil, nil
+	}
+
+	return &Signal{
+		SignalType: signalType,
+		Price:      ticker.TradePrice,
+		Confidence: math.Min(1, confidence),
+		Parameters: model.Parameters{
+			"period":       s.period,
+			"rsi":          current,
+			"previous_rsi": previous,
+			"threshold":    threshold,
+			"last_close":   last.Close,
+		},
+	}, nil
+}
@@ -0,0 +1,103 @@
+package strategy
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// newTestRSI period 3, 과매도 30, 과매수 70인 1분봉 RSI 전략
+func newTestRSI(t *testing.T) *RSIStrategy {
+	t.Helper()
+	s, err := NewRSIStrategy(model.StrategyConfig{
+		Timeframe:  "minutes/1",
+		Parameters: model.Parameters{"period": 3.0, "oversold": 30.0, "overbought": 70.0},
+	})
+	if err != nil {
+		t.Fatalf("RSI 전략 생성 실패: %v", err)
+	}
+	return s
+}
+
+func TestRSIBuysOnCrossAboveOversold(t *testing.T) {
+	s := newTestRSI(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	// 계속 내려 RSI 0에서 반등해 50이 된다
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 95, 90, 85, 80, 90)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 90})
+	if err != nil {
+		t.Fatalf("Evaluate 오류: %v", err)
+	}
+	if signal == nil || signal.SignalType != "BUY" {
+		t.Fatalf("신호 = %+v, want BUY", signal)
+	}
+	if signal.Parameters["previous_rsi"] != 0.0 || math.Abs(signal.Parameters["rsi"].(float64)-50) > 1e-9 {
+		t.Fatalf("지표 스냅샷 = %v, want previous_rsi 0, rsi 50", signal.Parameters)
+	}
+	if signal.Confidence != 1 {
+		t.Fatalf("신뢰도 = %v, want 1 (기준선 아래 끝까지 내려감)", signal.Confidence)
+	}
+
+	if signal, _ := s.Evaluate(candles, exchange.Ticker{TradePrice: 90}); signal != nil {
+		t.Fatalf("같은 캔들에서 신호 반복: %+v", signal)
+	}
+}
+
+func TestRSISellsOnCrossBelowOverbought(t *testing.T) {
+	s := newTestRSI(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 80, 85, 90, 95, 100, 90)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 90})
+	if err != nil {
+		t.Fatalf("Evaluate 오류: %v", err)
+	}
+	if signal == nil || signal.SignalType != "SELL" || signal.Parameters["threshold"] != 70.0 {
+		t.Fatalf("신호 = %+v, want 과매수 기준 70의 SELL", signal)
+	}
+}
+
+func TestRSIConfidenceScalesWithDepth(t *testing.T) {
+	s := newTestRSI(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	// 반등이 섞여 직전 RSI(약 22)가 과매도 기준 아래지만 0보다 높다
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 104, 100, 96, 92, 96)}
+
+	signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 96})
+	if err != nil || signal == nil || signal.SignalType != "BUY" {
+		t.Fatalf("신호 = %+v, %v, want BUY", signal, err)
+	}
+	previous := signal.Parameters["previous_rsi"].(float64)
+	want := (30 - previous) / 15
+	if previous <= 0 || math.Abs(signal.Confidence-want) > 1e-9 || signal.Confidence >= 1 {
+		t.Fatalf("신뢰도 = %v (직전 RSI %v), want %v", signal.Confidence, previous, want)
+	}
+}
+
+func TestRSIWaitsForEnoughCandles(t *testing.T) {
+	s := newTestRSI(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	candles := map[string][]model.Candlestick{"minutes/1": candleSeries("minutes/1", start, time.Minute, 100, 95, 90, 95)}
+
+	if signal, err := s.Evaluate(candles, exchange.Ticker{TradePrice: 95}); err != nil || signal != nil {
+		t.Fatalf("캔들 부족 시 신호 = %+v, %v, want nil", signal, err)
+	}
+}
+
+func TestRSIRejectsInvalidParameters(t *testing.T) {
+	cases := map[string]model.StrategyConfig{
+		"타임프레임 없음": {Parameters: model.Parameters{}},
+		"period 1": {Timeframe: "minutes/1", Parameters: model.Parameters{"period": 1.0}},
+		"기준 역전":    {Timeframe: "minutes/1", Parameters: model.Parameters{"oversold": 70.0, "overbought": 30.0}},
+		"과매수 100":  {Timeframe: "minutes/1", Parameters: model.Parameters{"overbought": 100.0}},
+	}
+	for name, cfg := range cases {
+		if _, err := NewRSIStrategy(cfg); err == nil {
+			t.Errorf("%s: 오류 없이 생성됨", name)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package strategy
+
+import (
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// Signal 전략이 생성하는 매매 신호
+type Signal = model.Signal
+
+// Strategy 매매 전략
+// 새 전략은 이 인터페이스를 구현하고 Register로 이름과 생성 함수를 등록하면 관리자를 고치지 않고 쓸 수 있다.
+type Strategy interface {
+	// Name 전략 이름
+	Name() string
+	// Init 전략 설정(Parameters 등)으로 초기화하며, 잘못된 설정이면 오류를 반환한다
+	Init(cfg model.StrategyConfig) error
+	// Timeframes 평가에 필요한 타임프레임 목록 (첫 번째가 진입 타임프레임)
+	Timeframes() []string
+	// Evaluate 타임프레임별 캔들과 현재가로 신호 생성 (신호가 없으면 nil)
+	// 신호를 만든 지표 값은 Signal.Parameters에 담아 반환한다.
+	Evaluate(candles map[string][]model.Candlestick, ticker exchange.Ticker) (*Signal, error)
+}
+
+// ConfiguredTimeframes 전략 설정의 타임프레임 목록
+// 설정의 기본 타임프레임, Parameters의 "timeframes" 목록, 전략이 추가로 요구하는 타임프레임 순으로 중복 없이 합친다.
+func ConfiguredTimeframes(cfg model.StrategyConfig, required ...string) []string {
+	var timeframes []string
+	seen := make(map[string]bool)
+	add := func(timeframe string) {
+		if timeframe == "" || seen[timeframe] {
+			return
+		}
+		seen[timeframe] = true
+		timeframes = append(timeframes, timeframe)
+	}
+
+	add(cfg.Timeframe)
+	if list, ok := cfg.Parameters["timeframes"].([]interface{}); ok {
+		for _, v := range list {
+			if timeframe, ok := v.(string); ok {
+				add(timeframe)
+			}
+		}
+	}
+	for _, timeframe := range required {
+		add(timeframe)
+	}
+
+	return timeframes
+}
+
+// ProvisionalAware 마감되지 않은 캔들도 참고하는 전략
+// Evaluate에는 마감된 캔들만 전달되며, 이 인터페이스를 구현하면 평가 직전에 타임프레임별 진행 중인 캔들
+// (Provisional=true)을 함께 받는다. 예를 들어 상위 타임프레임 캔들이 아직 마감 전이라면 여기로만 전달된다.
+type ProvisionalAware interface {
+	SetProvisional(candles map[string]model.Candlestick)
+}
+
+// alignCandles 타임프레임별 캔들을 기준 시각의 마지막 마감 캔들까지로 정렬
+// 아직 마감되지 않은 캔들을 잘라내어 모든 타임프레임이 같은 시점을 바라보게 하고,
+// 잘라낸 캔들 중 현재 진행 중인 캔들은 Provisional을 표시해 따로 돌려준다.
+func alignCandles(buffers map[string][]model.Candlestick, now time.Time) (map[string][]model.Candlestick, map[string]model.Candlestick) {
+	aligned := make(map[string][]model.Candlestick, len(buffers))
+	provisional := make(map[string]model.Candlestick)
+	for timeframe, candles := range buffers {
+		duration, err := exchange.TimeframeDuration(timeframe)
+		if err != nil {
+			continue
+		}
+
+		end := len(candles)
+		for end > 0 && candles[end-1].Timestamp.Add(duration).After(now) {
+			end--
+		}
+		aligned[timeframe] = candles[:end]
+
+		if end < len(candles) && !candles[end].Timestamp.After(now) {
+			candle := candles[end]
+			candle.Provisional = true
+			provisional[timeframe] = candle
+		}
+	}
+
+	return aligned, provisional
+}
@@ -0,0 +1,120 @@
+package strategy
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+	"gorm.io/gorm"
+)
+
+const (
+	// ExitReasonSellIntoStrength 거래량 급증 부분 익절 사유
+	ExitReasonSellIntoStrength = "SELL_INTO_STRENGTH"
+
+	defaultStrengthVolumePeriod   = 20
+	defaultStrengthVolumeMultiple = 3.0
+	defaultStrengthMinChange      = 5.0
+	defaultStrengthExitFraction   = 0.5
+)
+
+// WithSellIntoStrength 거래량 급증 시 부분 익절 규칙 설정
+func WithSellIntoStrength(cfg config.SellIntoStrengthConfig) ManagerOption {
+	return func(m *Manager) {
+		if cfg.VolumePeriod <= 0 {
+			cfg.VolumePeriod = defaultStrengthVolumePeriod
+		}
+		if cfg.VolumeMultiple <= 0 {
+			cfg.VolumeMultiple = defaultStrengthVolumeMultiple
+		}
+		if cfg.MinPriceChange <= 0 {
+			cfg.MinPriceChange = defaultStrengthMinChange
+		}
+		if cfg.ExitFraction <= 0 || cfg.ExitFraction > 1 {
+			cfg.ExitFraction = defaultStrengthExitFraction
+		}
+		m.strength = cfg
+	}
+}
+
+// blowOff 마지막 캔들이 거래량 급증을 동반한 급등 캔들인지 확인
+// 직전 period개 캔들의 평균 거래량 대비 배수와 시가 대비 상승률(%)을 함께 반환한다.
+func blowOff(series []model.Candlestick, cfg config.SellIntoStrengthConfig) (bool, float64, float64) {
+	if len(series) < cfg.VolumePeriod+1 {
+		return false, 0, 0
+	}
+
+	last := series[len(series)-1]
+	total := 0.0
+	for _, candle := range series[len(series)-1-cfg.VolumePeriod : len(series)-1] {
+		total += candle.Volume
+	}
+	average := total / float64(cfg.VolumePeriod)
+	if average == 0 || last.Open == 0 {
+		return false, 0, 0
+	}
+
+	ratio := last.Volume / average
+	change := (last.Close - last.Open) / last.Open * 100
+	return ratio >= cfg.VolumeMultiple && change >= cfg.MinPriceChange, ratio, change
+}
+
+// strengthTimeframe 부분 익절 판단 타임프레임
+func (m *Manager) strengthTimeframe(s Strategy) string {
+	if m.strength.Timeframe != "" {
+		return m.strength.Timeframe
+	}
+	return s.Timeframes()[0]
+}
+
+// strengthExit 보유 포지션의 부분 익절 신호 생성
+// 과열된 급등 캔들에서 물량 일부를 넘겨 이익을 확보한다. 같은 캔들에서는 한 번만 신호를 낸다.
+func (m *Manager) strengthExit(r *runner, candles map[string][]model.Candlestick, data exchange.MarketData) (*Signal, error) {
+	series := candles[m.strengthTimeframe(r.strategy)]
+	triggered, ratio, change := blowOff(series, m.strength)
+	if !triggered {
+		return nil, nil
+	}
+
+	last := series[len(series)-1]
+	if !last.Timestamp.After(r.lastStrengthExit) {
+		return nil, nil
+	}
+
+	var position model.Position
+	err := m.db.Where("market_id = ? AND status = ?", data.MarketID, "OPEN").First(&position).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.lastStrengthExit = last.Timestamp
+
+	return &Signal{
+		SignalType: "SELL",
+		Price:      data.TradePrice,
+		Confidence: 1,
+		Parameters: model.Parameters{
+			"exit_reason":    ExitReasonSellIntoStrength,
+			"exit_fraction":  m.strength.ExitFraction,
+			"volume_ratio":   ratio,
+			"change_percent": change,
+			"candle_time":    last.Timestamp.Format(time.RFC3339),
+		},
+	}, nil
+}
+
+// appendUnique 목록에 없는 타임프레임만 추가한 새 목록
+func appendUnique(timeframes []string, timeframe string) []string {
+	for _, t := range timeframes {
+		if t == timeframe {
+			return timeframes
+		}
+	}
+
+	result := make([]string, 0, len(timeframes)+1)
+	return append(append(result, timeframes...), timeframe)
+}
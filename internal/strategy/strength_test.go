@@ -0,0 +1,74 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyi000/upbit-auto-trading-bot/internal/config"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/exchange"
+	"github.com/kyi000/upbit-auto-trading-bot/internal/model"
+)
+
+// blowOffSeries 거래량 1인 평탄한 캔들 뒤에 거래량 lastVolume, 시가 100 종가 lastClose인 캔들이 오는 1분봉
+func blowOffSeries(start time.Time, lastVolume, lastClose float64) []model.Candlestick {
+	candles := candleSeries("minutes/1", start, time.Minute, 100, 100, 100, 100, 100)
+	last := &candles[len(candles)-1]
+	last.Open, last.High, last.Close, last.Volume = 100, lastClose, lastClose, lastVolume
+	return candles
+}
+
+func TestBlowOffRequiresVolumeAndPriceSpike(t *testing.T) {
+	cfg := config.SellIntoStrengthConfig{VolumePeriod: 4, VolumeMultiple: 3, MinPriceChange: 5}
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	if ok, ratio, change := blowOff(blowOffSeries(start, 4, 106), cfg); !ok || ratio != 4 || change != 6 {
+		t.Fatalf("급등 캔들 판단 = %v, 배수 %v, 상승률 %v, want true, 4, 6", ok, ratio, change)
+	}
+	if ok, _, _ := blowOff(blowOffSeries(start, 2, 106), cfg); ok {
+		t.Fatal("거래량이 부족한데 급등 캔들로 판단")
+	}
+	if ok, _, _ := blowOff(blowOffSeries(start, 4, 103), cfg); ok {
+		t.Fatal("상승률이 부족한데 급등 캔들로 판단")
+	}
+}
+
+func TestEvaluateSellsIntoStrengthForOpenPosition(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := start.Add(5*time.Minute + 10*time.Second)
+	buffers := map[string][]model.Candlestick{"minutes/1": blowOffSeries(start, 4, 106)}
+	s := &recordingStrategy{name: "rsi", timeframes: []string{"minutes/1"}}
+
+	signalCh := make(chan Signal, 2)
+	m := newBufferedManager(t, signalCh, buffers, now, &runner{strategy: s})
+	WithSellIntoStrength(config.SellIntoStrengthConfig{Enabled: true, VolumePeriod: 4, VolumeMultiple: 3, MinPriceChange: 5, ExitFraction: 0.3})(m)
+
+	data := exchange.MarketData{Type: exchange.DataTypeTicker, MarketID: "KRW-BTC", TradePrice: 106}
+
+	// 포지션이 없으면 신호를 내지 않는다
+	m.evaluate(context.Background(), data, now)
+	if len(signalCh) != 0 {
+		t.Fatal("포지션이 없는데 부분 익절 신호 발생")
+	}
+
+	position := model.Position{MarketID: "KRW-BTC", EntryPrice: 90, EntryTime: start, Quantity: 1, Status: "OPEN", LastPrice: 106}
+	if err := m.db.Create(&position).Error; err != nil {
+		t.Fatal(err)
+	}
+	m.evaluate(context.Background(), data, now)
+
+	select {
+	case signal := <-signalCh:
+		if signal.SignalType != "SELL" || signal.Parameters["exit_fraction"] != 0.3 || signal.Parameters["exit_reason"] != ExitReasonSellIntoStrength {
+			t.Fatalf("부분 익절 신호 = %+v", signal)
+		}
+	default:
+		t.Fatal("급등 캔들에서 부분 익절 신호가 없음")
+	}
+
+	// 같은 캔들에서는 한 번만 신호를 낸다
+	m.evaluate(context.Background(), data, now)
+	if len(signalCh) != 0 {
+		t.Fatal("같은 캔들에서 부분 익절 신호 반복")
+	}
+}
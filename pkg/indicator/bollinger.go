@@ -0,0 +1,27 @@
+package indicator
+
+import "math"
+
+// BollingerBands 볼린저 밴드
+// 중심선은 period 단순 이동평균, 상·하단은 중심선 ± stdDevMult × 같은 구간의 모표준편차다.
+// 결과는 입력과 길이가 같으며, 기간이 채워지지 않은 앞부분은 0으로 채운다.
+func BollingerBands(closes []float64, period int, stdDevMult float64) (middle, upper, lower []float64) {
+	middle = SMA(closes, period)
+	upper = make([]float64, len(closes))
+	lower = make([]float64, len(closes))
+	if period <= 0 || len(closes) < period {
+		return middle, upper, lower
+	}
+
+	for i := period - 1; i < len(closes); i++ {
+		variance := 0.0
+		for _, v := range closes[i-period+1 : i+1] {
+			variance += (v - middle[i]) * (v - middle[i])
+		}
+		width := stdDevMult * math.Sqrt(variance/float64(period))
+		upper[i] = middle[i] + width
+		lower[i] = middle[i] - width
+	}
+
+	return middle, upper, lower
+}
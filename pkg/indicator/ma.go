@@ -0,0 +1,23 @@
+package indicator
+
+// SMA 단순 이동평균
+// 결과는 입력과 길이가 같으며, 기간이 채워지지 않은 앞부분은 0으로 채운다.
+func SMA(values []float64, period int) []float64 {
+	result := make([]float64, len(values))
+	if period <= 0 || len(values) < period {
+		return result
+	}
+
+	sum := 0.0
+	for i, v := range values {
+		sum += v
+		if i >= period {
+			sum -= values[i-period]
+		}
+		if i >= period-1 {
+			result[i] = sum / float64(period)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,53 @@
+package indicator
+
+// RSI 상대강도지수 (Wilder 평활)
+// 첫 평균 상승·하락폭은 period개 변화의 단순 평균이고, 이후에는 (이전 평균*(period-1) + 현재 변화)/period로 갱신한다.
+// 결과는 입력과 길이가 같으며, 값이 없는 앞의 period개는 0으로 채운다.
+func RSI(closes []float64, period int) []float64 {
+	result := make([]float64, len(closes))
+	if period <= 0 || len(closes) <= period {
+		return result
+	}
+
+	avgGain, avgLoss := 0.0, 0.0
+	for i := 1; i <= period; i++ {
+		gain, loss := change(closes[i-1], closes[i])
+		avgGain += gain
+		avgLoss += loss
+	}
+	avgGain /= float64(period)
+	avgLoss /= float64(period)
+	result[period] = rsiValue(avgGain, avgLoss)
+
+	for i := period + 1; i < len(closes); i++ {
+		gain, loss := change(closes[i-1], closes[i])
+		avgGain = (avgGain*float64(period-1) + gain) / float64(period)
+		avgLoss = (avgLoss*float64(period-1) + loss) / float64(period)
+		result[i] = rsiValue(avgGain, avgLoss)
+	}
+
+	return result
+}
+
+// change 상승폭과 하락폭 (하락폭은 양수)
+func change(previous, current float64) (float64, float64) {
+	diff := current - previous
+	if diff > 0 {
+		return diff, 0
+	}
+	return 0, -diff
+}
+
+// rsiValue 평균 상승·하락폭으로 RSI 계산
+// 하락이 없으면 100, 변화가 전혀 없으면 50이다.
+func rsiValue(avgGain, avgLoss float64) float64 {
+	if avgLoss == 0 {
+		if avgGain == 0 {
+			return 50
+		}
+		return 100
+	}
+
+	rs := avgGain / avgLoss
+	return 100 - 100/(1+rs)
+}
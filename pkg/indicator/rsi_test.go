@@ -0,0 +1,50 @@
+package indicator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRSIMatchesWilderReference(t *testing.T) {
+	// Wilder 방식 14일 RSI 계산 예제 (StockCharts)의 종가와 RSI (소수 둘째 자리 반올림)
+	closes := []float64{
+		44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42, 45.84, 46.08, 45.89, 46.03, 45.61, 46.28,
+		46.28, 46.00, 46.03, 46.41, 46.22, 45.64, 46.21, 46.25, 45.71, 46.45, 45.78, 45.35, 44.03, 44.18,
+		44.22, 44.57, 43.42, 42.66, 43.13,
+	}
+	want := []float64{
+		70.53, 66.32, 66.55, 69.41, 66.36, 57.97, 62.93, 63.26, 56.06, 62.38,
+		54.71, 50.42, 39.99, 41.46, 41.87, 45.46, 37.30, 33.08, 37.77,
+	}
+
+	rsi := RSI(closes, 14)
+	if len(rsi) != len(closes) {
+		t.Fatalf("RSI 길이 = %d, want %d", len(rsi), len(closes))
+	}
+	for i := 0; i < 14; i++ {
+		if rsi[i] != 0 {
+			t.Fatalf("RSI[%d] = %v, want 0 (값 없음)", i, rsi[i])
+		}
+	}
+	// 예제는 중간 평균을 반올림하므로 약간의 오차를 허용한다
+	for i, w := range want {
+		if got := rsi[14+i]; math.Abs(got-w) > 0.1 {
+			t.Fatalf("RSI[%d] = %.2f, want %.2f", 14+i, got, w)
+		}
+	}
+}
+
+func TestRSIEdgeCases(t *testing.T) {
+	if rsi := RSI([]float64{1, 2, 3}, 3); rsi[2] != 0 {
+		t.Fatalf("데이터 부족 RSI = %v, want 모두 0", rsi)
+	}
+	if rsi := RSI([]float64{1, 2, 3, 4}, 3); rsi[3] != 100 {
+		t.Fatalf("상승만 있을 때 RSI = %v, want 100", rsi[3])
+	}
+	if rsi := RSI([]float64{4, 3, 2, 1}, 3); rsi[3] != 0 {
+		t.Fatalf("하락만 있을 때 RSI = %v, want 0", rsi[3])
+	}
+	if rsi := RSI([]float64{5, 5, 5, 5}, 3); rsi[3] != 50 {
+		t.Fatalf("변화가 없을 때 RSI = %v, want 50", rsi[3])
+	}
+}
@@ -0,0 +1,78 @@
+package indicator
+
+import "math"
+
+// Returns 단순 수익률 수열
+// 결과 길이는 입력보다 1 짧다.
+func Returns(values []float64) []float64 {
+	if len(values) < 2 {
+		return nil
+	}
+
+	result := make([]float64, len(values)-1)
+	for i := 1; i < len(values); i++ {
+		if values[i-1] == 0 {
+			continue
+		}
+		result[i-1] = (values[i] - values[i-1]) / values[i-1]
+	}
+
+	return result
+}
+
+// Beta 기준 수열 대비 베타 (공분산 / 기준 분산)
+// 두 수열은 같은 시점끼리 정렬되어 있어야 하며, 길이가 다르면 짧은 쪽에 맞춘다.
+func Beta(asset, benchmark []float64) float64 {
+	n := len(asset)
+	if len(benchmark) < n {
+		n = len(benchmark)
+	}
+	if n < 2 {
+		return 0
+	}
+
+	assetMean := mean(asset[:n])
+	benchmarkMean := mean(benchmark[:n])
+
+	covariance, variance := 0.0, 0.0
+	for i := 0; i < n; i++ {
+		da := asset[i] - assetMean
+		db := benchmark[i] - benchmarkMean
+		covariance += da * db
+		variance += db * db
+	}
+	if variance == 0 {
+		return 0
+	}
+
+	return covariance / variance
+}
+
+// mean 평균
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float64(len(values))
+}
+
+// StdDev 모표준편차
+func StdDev(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+
+	m := mean(values)
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - m) * (v - m)
+	}
+
+	return math.Sqrt(variance / float64(len(values)))
+}
@@ -0,0 +1,47 @@
+package indicator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestReturns(t *testing.T) {
+	got := Returns([]float64{100, 110, 99})
+	want := []float64{0.1, -0.1}
+	if len(got) != len(want) {
+		t.Fatalf("Returns 길이 = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Fatalf("Returns[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if Returns([]float64{100}) != nil {
+		t.Fatal("값이 하나일 때 수익률이 있음")
+	}
+}
+
+func TestBeta(t *testing.T) {
+	benchmark := []float64{0.01, -0.02, 0.03, -0.01}
+	double := make([]float64, len(benchmark))
+	for i, r := range benchmark {
+		double[i] = r * 2
+	}
+
+	if got := Beta(double, benchmark); math.Abs(got-2) > 1e-9 {
+		t.Fatalf("두 배로 움직이는 자산의 베타 = %v, want 2", got)
+	}
+	if got := Beta(benchmark, benchmark); math.Abs(got-1) > 1e-9 {
+		t.Fatalf("기준과 같은 자산의 베타 = %v, want 1", got)
+	}
+	if got := Beta(benchmark, []float64{0.01, 0.01, 0.01, 0.01}); got != 0 {
+		t.Fatalf("기준 분산이 0일 때 베타 = %v, want 0", got)
+	}
+}
+
+func TestStdDev(t *testing.T) {
+	if got := StdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9}); got != 2 {
+		t.Fatalf("StdDev = %v, want 2", got)
+	}
+}
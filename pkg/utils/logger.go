@@ -0,0 +1,179 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// 로그 출력 형식
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
+// LogOptions 로거 설정
+type LogOptions struct {
+	Format string // text(기본), json
+	Level  string // 최소 기록 수준: debug, info(기본), warn, error
+
+	// 파일 출력 (File이 비어 있으면 표준 출력에만 기록)
+	File       string // 로그 파일 경로
+	MaxSize    int    // 파일 하나의 최대 크기(MB, 넘으면 새 파일로 교체, 0이면 100)
+	MaxBackups int    // 보관할 이전 파일 수 (0이면 모두 보관)
+	MaxAge     int    // 이전 파일 보관 기간(일, 0이면 기간 제한 없음)
+	Compress   bool   // 이전 파일 gzip 압축
+}
+
+// base 모든 로거가 공유하는 기록기 (Configure로 교체)
+var base atomic.Pointer[zap.Logger]
+
+// logFile 현재 기록 중인 로그 파일 (Configure로 교체하면 이전 파일을 닫음)
+var logFile atomic.Pointer[lumberjack.Logger]
+
+func init() {
+	logger, _ := newBase(LogOptions{}, zapcore.Lock(os.Stdout))
+	base.Store(logger)
+}
+
+// Configure 모든 로거의 출력 설정
+// 설정 전에 만든 로거도 다음 기록부터 새 설정을 따른다.
+// File을 지정하면 표준 출력과 크기 기준으로 교체되는 파일에 함께 기록한다.
+func Configure(opts LogOptions) error {
+	var file *lumberjack.Logger
+	out := zapcore.AddSync(os.Stdout)
+	if opts.File != "" {
+		file = &lumberjack.Logger{
+			Filename:   opts.File,
+			MaxSize:    opts.MaxSize,
+			MaxBackups: opts.MaxBackups,
+			MaxAge:     opts.MaxAge,
+			Compress:   opts.Compress,
+			LocalTime:  true,
+		}
+		out = zapcore.NewMultiWriteSyncer(out, zapcore.AddSync(file))
+	}
+
+	// 항목 하나를 두 출력에 쓰는 동안 잠가서 여러 고루틴의 기록이 섞이거나 교체 중에 빠지지 않게 한다
+	logger, err := newBase(opts, zapcore.Lock(out))
+	if err != nil {
+		return err
+	}
+	base.Store(logger)
+
+	// 이전 파일은 닫아도 아직 이전 기록기를 쓰는 로거가 있으면 lumberjack이 다시 열어 기록한다
+	if previous := logFile.Swap(file); previous != nil {
+		previous.Close()
+	}
+	return nil
+}
+
+// newBase 설정에 맞는 기록기 생성 (out에 기록)
+func newBase(opts LogOptions, out zapcore.WriteSyncer) (*zap.Logger, error) {
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = "time"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	var encoder zapcore.Encoder
+	switch opts.Format {
+	case LogFormatText, "":
+		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	case LogFormatJSON:
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 로그 형식: %s", opts.Format)
+	}
+
+	level, err := parseLevel(opts.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	core := zapcore.NewCore(encoder, out, level)
+	return zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1)), nil
+}
+
+// parseLevel 설정의 로그 수준 해석 (빈 값은 info)
+func parseLevel(level string) (zapcore.Level, error) {
+	switch level {
+	case "debug":
+		return zapcore.DebugLevel, nil
+	case "info", "":
+		return zapcore.InfoLevel, nil
+	case "warn":
+		return zapcore.WarnLevel, nil
+	case "error":
+		return zapcore.ErrorLevel, nil
+	default:
+		return zapcore.InfoLevel, fmt.Errorf("지원하지 않는 로그 수준: %s", level)
+	}
+}
+
+// Logger 모듈별 로거
+// 인자는 fmt.Println처럼 공백으로 이어 메시지로 기록하고, WithField로 붙인 값은 구조화된 필드로 기록한다.
+type Logger struct {
+	name   string
+	fields []interface{} // 키, 값 순서
+
+	bound atomic.Pointer[boundLogger]
+}
+
+// boundLogger 특정 기록기에 이름과 필드를 붙인 로거
+type boundLogger struct {
+	base  *zap.Logger
+	sugar *zap.SugaredLogger
+}
+
+// NewLogger 새로운 로거 생성 (name은 모듈 이름)
+func NewLogger(name string) *Logger {
+	return &Logger{name: name}
+}
+
+// WithField 필드를 추가한 로거 (원래 로거는 그대로)
+func (l *Logger) WithField(key string, value interface{}) *Logger {
+	fields := make([]interface{}, 0, len(l.fields)+2)
+	fields = append(fields, l.fields...)
+	return &Logger{name: l.name, fields: append(fields, key, value)}
+}
+
+// sugar 현재 기록기에 붙인 로거 (Configure로 기록기가 바뀌었으면 다시 만듦)
+func (l *Logger) sugar() *zap.SugaredLogger {
+	current := base.Load()
+	if bound := l.bound.Load(); bound != nil && bound.base == current {
+		return bound.sugar
+	}
+
+	sugar := current.Named(l.name).Sugar().With(l.fields...)
+	l.bound.Store(&boundLogger{base: current, sugar: sugar})
+	return sugar
+}
+
+// Debug 디버그 로그 (메시지 단위 수신, 요청 단위 응답 등 운영 중에는 끄는 기록)
+func (l *Logger) Debug(args ...interface{}) {
+	l.sugar().Debugln(args...)
+}
+
+// Info 정보 로그 (시작, 연결, 주문 등 동작 단위 기록)
+func (l *Logger) Info(args ...interface{}) {
+	l.sugar().Infoln(args...)
+}
+
+// Warn 경고 로그 (계속 동작하지만 확인이 필요한 상황)
+func (l *Logger) Warn(args ...interface{}) {
+	l.sugar().Warnln(args...)
+}
+
+// Error 오류 로그
+func (l *Logger) Error(args ...interface{}) {
+	l.sugar().Errorln(args...)
+}
+
+// Fatal 치명적 오류 로그 후 종료
+func (l *Logger) Fatal(args ...interface{}) {
+	l.sugar().Fatalln(args...)
+}
@@ -0,0 +1,263 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// captureLogs 모든 로거의 출력을 버퍼로 돌림 (테스트가 끝나면 원래 기록기로 복원)
+func captureLogs(t *testing.T, opts LogOptions) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	logger, err := newBase(opts, zapcore.AddSync(&buf))
+	if err != nil {
+		t.Fatalf("기록기 생성 실패: %v", err)
+	}
+	previous := base.Swap(logger)
+	t.Cleanup(func() { base.Store(previous) })
+	return &buf
+}
+
+// configureWithStdout 표준 출력을 임시 파일로 돌린 채 Configure 호출 (테스트가 끝나면 기록기, 로그 파일, 표준 출력 복원)
+// 돌려준 경로의 파일에 Configure 이후 표준 출력으로 나간 로그가 남는다.
+func configureWithStdout(t *testing.T, opts LogOptions) string {
+	t.Helper()
+	stdoutPath := filepath.Join(t.TempDir(), "stdout.log")
+	stdout, err := os.Create(stdoutPath)
+	if err != nil {
+		t.Fatalf("표준 출력 파일 생성 실패: %v", err)
+	}
+
+	previousStdout, previousBase := os.Stdout, base.Load()
+	os.Stdout = stdout
+	t.Cleanup(func() {
+		os.Stdout = previousStdout
+		base.Store(previousBase)
+		if file := logFile.Swap(nil); file != nil {
+			file.Close()
+		}
+		stdout.Close()
+	})
+
+	if err := Configure(opts); err != nil {
+		t.Fatalf("Configure 실패: %v", err)
+	}
+	return stdoutPath
+}
+
+// readLogFile 로그 파일 내용
+func readLogFile(t *testing.T, path string) *bytes.Buffer {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("로그 파일 읽기 실패: %v", err)
+	}
+	return bytes.NewBuffer(data)
+}
+
+// jsonLines JSON 로그를 줄 단위로 디코딩
+func jsonLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("JSON 로그 디코딩 실패: %v (%s)", err, line)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestJSONLogContainsLevelAndFields(t *testing.T) {
+	buf := captureLogs(t, LogOptions{Format: LogFormatJSON})
+
+	logger := NewLogger("executor")
+	logger.WithField("market", "KRW-BTC").WithField("volume", 0.5).Info("주문 전송:", "bid")
+	logger.Error("주문 실패")
+
+	entries := jsonLines(t, buf)
+	if len(entries) != 2 {
+		t.Fatalf("로그 %d줄, want 2 (%s)", len(entries), buf)
+	}
+	first := entries[0]
+	for _, key := range []string{"time", "level", "logger", "caller", "msg", "market", "volume"} {
+		if _, ok := first[key]; !ok {
+			t.Fatalf("JSON 로그에 %s 키 없음: %v", key, first)
+		}
+	}
+	if first["level"] != "info" || first["logger"] != "executor" || first["msg"] != "주문 전송: bid" {
+		t.Fatalf("JSON 로그 = %v, want info executor \"주문 전송: bid\"", first)
+	}
+	if first["market"] != "KRW-BTC" || first["volume"] != 0.5 {
+		t.Fatalf("필드 = %v %v, want KRW-BTC 0.5", first["market"], first["volume"])
+	}
+
+	// WithField는 원래 로거에 필드를 남기지 않는다
+	second := entries[1]
+	if second["level"] != "error" || second["market"] != nil {
+		t.Fatalf("두 번째 로그 = %v, want error, market 없음", second)
+	}
+}
+
+func TestTextLogIsDefault(t *testing.T) {
+	buf := captureLogs(t, LogOptions{})
+
+	NewLogger("api").WithField("market", "KRW-ETH").Info("API 서버 시작")
+
+	line := buf.String()
+	if strings.HasPrefix(line, "{") {
+		t.Fatalf("기본 형식이 JSON임: %s", line)
+	}
+	for _, want := range []string{"INFO", "api", "API 서버 시작", `"market": "KRW-ETH"`} {
+		if !strings.Contains(line, want) {
+			t.Fatalf("텍스트 로그에 %q 없음: %s", want, line)
+		}
+	}
+}
+
+func TestExistingLoggerFollowsReconfiguration(t *testing.T) {
+	logger := NewLogger("collector")
+	captureLogs(t, LogOptions{})
+	logger.Info("텍스트")
+
+	buf := captureLogs(t, LogOptions{Format: LogFormatJSON})
+	logger.Info("JSON")
+	if entries := jsonLines(t, buf); len(entries) != 1 || entries[0]["msg"] != "JSON" {
+		t.Fatalf("재설정 후 로그 = %v, want JSON 한 줄", entries)
+	}
+}
+
+func TestConfigureRejectsUnknownFormat(t *testing.T) {
+	if err := Configure(LogOptions{Format: "xml"}); err == nil || !strings.Contains(err.Error(), "xml") {
+		t.Fatalf("Configure 오류 = %v, want 지원하지 않는 로그 형식", err)
+	}
+}
+
+func TestWarnLevelSuppressesInfoAndDebug(t *testing.T) {
+	buf := captureLogs(t, LogOptions{Format: LogFormatJSON, Level: "warn"})
+
+	logger := NewLogger("ws")
+	logger.Debug("메시지 수신")
+	logger.Info("웹소켓 연결")
+	logger.WithField("market", "KRW-BTC").Info("구독")
+	if buf.Len() != 0 {
+		t.Fatalf("warn 수준에서 Debug/Info 출력: %s", buf)
+	}
+
+	logger.Warn("퐁 응답 없음")
+	logger.Error("연결 실패")
+	entries := jsonLines(t, buf)
+	if len(entries) != 2 || entries[0]["level"] != "warn" || entries[1]["level"] != "error" {
+		t.Fatalf("warn 수준 로그 = %v, want warn, error", entries)
+	}
+}
+
+func TestLogLevels(t *testing.T) {
+	cases := []struct {
+		level string
+		want  []string // Debug, Info, Warn, Error 순으로 호출했을 때 남는 수준
+	}{
+		{"debug", []string{"debug", "info", "warn", "error"}},
+		{"", []string{"info", "warn", "error"}},
+		{"info", []string{"info", "warn", "error"}},
+		{"error", []string{"error"}},
+	}
+	for _, tc := range cases {
+		buf := captureLogs(t, LogOptions{Format: LogFormatJSON, Level: tc.level})
+		logger := NewLogger("test")
+		logger.Debug("d")
+		logger.Info("i")
+		logger.Warn("w")
+		logger.Error("e")
+
+		var got []string
+		for _, entry := range jsonLines(t, buf) {
+			got = append(got, entry["level"].(string))
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Fatalf("수준 %q 로그 = %v, want %v", tc.level, got, tc.want)
+		}
+	}
+}
+
+func TestConfigureRejectsUnknownLevel(t *testing.T) {
+	if err := Configure(LogOptions{Level: "trace"}); err == nil || !strings.Contains(err.Error(), "trace") {
+		t.Fatalf("Configure 오류 = %v, want 지원하지 않는 로그 수준", err)
+	}
+}
+
+func TestConfigureWritesToStdoutAndFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "bot.log")
+	stdout := configureWithStdout(t, LogOptions{Format: LogFormatJSON, File: file})
+
+	NewLogger("executor").WithField("market", "KRW-BTC").Info("주문 전송")
+
+	for name, path := range map[string]string{"표준 출력": stdout, "로그 파일": file} {
+		entries := jsonLines(t, readLogFile(t, path))
+		if len(entries) != 1 || entries[0]["msg"] != "주문 전송" || entries[0]["market"] != "KRW-BTC" {
+			t.Fatalf("%s 로그 = %v, want 주문 전송 한 줄", name, entries)
+		}
+	}
+}
+
+func TestConfigurePassesRotationSettings(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "bot.log")
+	configureWithStdout(t, LogOptions{File: file, MaxSize: 50, MaxBackups: 7, MaxAge: 14, Compress: true})
+
+	got := logFile.Load()
+	if got == nil {
+		t.Fatal("로그 파일 설정 없음")
+	}
+	if got.Filename != file || got.MaxSize != 50 || got.MaxBackups != 7 || got.MaxAge != 14 || !got.Compress || !got.LocalTime {
+		t.Fatalf("교체 설정 = %+v, want %s 50MB 7개 14일 압축, 현지 시각", got, file)
+	}
+
+	// 파일 없이 다시 설정하면 이전 파일 설정을 버린다
+	if err := Configure(LogOptions{}); err != nil {
+		t.Fatalf("Configure 실패: %v", err)
+	}
+	if logFile.Load() != nil {
+		t.Fatal("파일 없이 재설정했는데 로그 파일 설정이 남아 있음")
+	}
+}
+
+func TestConfigureWhileLogging(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "bot.log")
+	opts := LogOptions{Format: LogFormatJSON, File: file}
+	configureWithStdout(t, opts)
+
+	const writers, lines = 4, 200
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger := NewLogger("collector")
+			for j := 0; j < lines; j++ {
+				logger.Info("캔들 저장")
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		if err := Configure(opts); err != nil {
+			t.Fatalf("Configure 실패: %v", err)
+		}
+	}
+	wg.Wait()
+
+	// 교체 전 기록기로 쓴 줄도 같은 파일에 이어서 남고 줄끼리 섞이지 않는다
+	if entries := jsonLines(t, readLogFile(t, file)); len(entries) != writers*lines {
+		t.Fatalf("로그 파일 %d줄, want %d", len(entries), writers*lines)
+	}
+}